@@ -0,0 +1,899 @@
+package consulapi
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ACLBindType is the kind of identity a binding rule grants to a token
+// created by logging in with an auth method.
+type ACLBindType string
+
+const (
+	ACLBindService ACLBindType = "service"
+	ACLBindNode    ACLBindType = "node"
+	ACLBindRole    ACLBindType = "role"
+)
+
+// ACLTokenLocality restricts the tokens created by an auth method to the
+// local datacenter, or allows them to be global.
+type ACLTokenLocality string
+
+const (
+	ACLTokenLocal  ACLTokenLocality = "local"
+	ACLTokenGlobal ACLTokenLocality = "global"
+)
+
+// An ACLLink refers to a policy or role by ID or by name. When linking a
+// policy or role to a token or role, only one of ID or Name needs to be set.
+type ACLLink struct {
+	ID   string `json:"ID,omitempty"`
+	Name string `json:"Name,omitempty"`
+}
+
+// An ACLServiceIdentity grants the permissions needed by a service, and its
+// sidecar proxy, to register and discover services.
+type ACLServiceIdentity struct {
+	ServiceName string   `json:"ServiceName"`
+	Datacenters []string `json:"Datacenters,omitempty"`
+}
+
+// An ACLNodeIdentity grants the permissions needed by the agent of a node.
+type ACLNodeIdentity struct {
+	NodeName   string `json:"NodeName"`
+	Datacenter string `json:"Datacenter"`
+}
+
+// An ACLToken is used to authenticate requests to consul, and is granted
+// permissions by its policies, roles, and identities.
+type ACLToken struct {
+	// AccessorID is the public identifier of the token. It is set by consul
+	// when the token is created, unless provided.
+	AccessorID string `json:"AccessorID,omitempty"`
+
+	// SecretID is the value used to authenticate requests. It is generated by
+	// consul when the token is created, unless provided. It is not set on the
+	// tokens returned by ListACLTokens.
+	SecretID string `json:"SecretID,omitempty"`
+
+	Description       string               `json:"Description,omitempty"`
+	Policies          []ACLLink            `json:"Policies,omitempty"`
+	Roles             []ACLLink            `json:"Roles,omitempty"`
+	ServiceIdentities []ACLServiceIdentity `json:"ServiceIdentities,omitempty"`
+	NodeIdentities    []ACLNodeIdentity    `json:"NodeIdentities,omitempty"`
+
+	// Local tokens are only valid in the datacenter they are created in.
+	Local bool `json:"Local,omitempty"`
+
+	// AuthMethod is the name of the auth method which created the token by
+	// logging in, if any.
+	AuthMethod string `json:"AuthMethod,omitempty"`
+
+	// ExpirationTTL (optional) makes the token expire once the duration has
+	// elapsed since its creation. Only used when creating a token.
+	ExpirationTTL time.Duration `json:"ExpirationTTL,omitempty"`
+
+	// ExpirationTime is when the token expires, if ever.
+	ExpirationTime *time.Time `json:"ExpirationTime,omitempty"`
+
+	// CreateTime is when the token was created. It is set by consul, and
+	// ignored when creating or updating a token.
+	CreateTime *time.Time `json:"CreateTime,omitempty"`
+
+	// Namespace (optional, enterprise) is the namespace of the token.
+	Namespace string `json:"Namespace,omitempty"`
+
+	CreateIndex uint64 `json:"CreateIndex,omitempty"`
+	ModifyIndex uint64 `json:"ModifyIndex,omitempty"`
+}
+
+// aclTokenFormat is how a token is written to consul, which expects the
+// ExpirationTTL as a duration string, e.g. "1h0m0s".
+type aclTokenFormat struct {
+	ACLToken
+	ExpirationTTL string `json:"ExpirationTTL,omitempty"`
+}
+
+func internalizeACLToken(token ACLToken) aclTokenFormat {
+	format := aclTokenFormat{ACLToken: token}
+	if token.ExpirationTTL > 0 {
+		format.ExpirationTTL = token.ExpirationTTL.String()
+	}
+	return format
+}
+
+// An ACLPolicy is a named set of rules, which are granted to tokens and roles
+// linked to the policy.
+type ACLPolicy struct {
+	ID          string `json:"ID,omitempty"`
+	Name        string `json:"Name"`
+	Description string `json:"Description,omitempty"`
+
+	// Rules are the rules of the policy, in HCL or JSON.
+	//
+	// https://www.consul.io/docs/security/acl/acl-rules
+	Rules string `json:"Rules,omitempty"`
+
+	// Datacenters (optional) limits the datacenters the policy is valid in.
+	Datacenters []string `json:"Datacenters,omitempty"`
+
+	// Namespace (optional, enterprise) is the namespace of the policy.
+	Namespace string `json:"Namespace,omitempty"`
+
+	CreateIndex uint64 `json:"CreateIndex,omitempty"`
+	ModifyIndex uint64 `json:"ModifyIndex,omitempty"`
+}
+
+// An ACLRole is a named set of policies and identities, which are granted to
+// tokens linked to the role.
+type ACLRole struct {
+	ID                string               `json:"ID,omitempty"`
+	Name              string               `json:"Name"`
+	Description       string               `json:"Description,omitempty"`
+	Policies          []ACLLink            `json:"Policies,omitempty"`
+	ServiceIdentities []ACLServiceIdentity `json:"ServiceIdentities,omitempty"`
+	NodeIdentities    []ACLNodeIdentity    `json:"NodeIdentities,omitempty"`
+
+	// Namespace (optional, enterprise) is the namespace of the role.
+	Namespace string `json:"Namespace,omitempty"`
+
+	CreateIndex uint64 `json:"CreateIndex,omitempty"`
+	ModifyIndex uint64 `json:"ModifyIndex,omitempty"`
+}
+
+// An ACLBindingRule decides which identity is granted to a token created by
+// logging in with an auth method.
+type ACLBindingRule struct {
+	ID          string `json:"ID,omitempty"`
+	Description string `json:"Description,omitempty"`
+
+	// AuthMethod is the name of the auth method the rule applies to.
+	AuthMethod string `json:"AuthMethod"`
+
+	// Selector (optional) is an expression matching the verified identity
+	// attributes of a login, to which the rule applies. If not set, the rule
+	// applies to every login.
+	Selector string `json:"Selector,omitempty"`
+
+	// BindType is the kind of identity granted by the rule.
+	BindType ACLBindType `json:"BindType"`
+
+	// BindName is the name of the identity granted by the rule, which may be
+	// interpolated from the identity attributes, e.g. ${serviceaccount.name}.
+	BindName string `json:"BindName"`
+
+	// Namespace (optional, enterprise) is the namespace of the rule.
+	Namespace string `json:"Namespace,omitempty"`
+
+	CreateIndex uint64 `json:"CreateIndex,omitempty"`
+	ModifyIndex uint64 `json:"ModifyIndex,omitempty"`
+}
+
+// An ACLAuthMethod verifies the credentials of a login, e.g. a kubernetes
+// service account token or a JWT.
+type ACLAuthMethod struct {
+	Name        string
+	Type        string
+	DisplayName string
+	Description string
+
+	// MaxTokenTTL (optional) is the lifetime of the tokens created by logging
+	// in with the auth method.
+	MaxTokenTTL time.Duration
+
+	// TokenLocality (optional) decides whether the tokens created by logging
+	// in with the auth method are local or global.
+	TokenLocality ACLTokenLocality
+
+	// Config is the configuration specific to the Type of the auth method.
+	//
+	// https://www.consul.io/docs/security/acl/auth-methods
+	Config map[string]interface{}
+
+	// Namespace (optional, enterprise) is the namespace of the auth method.
+	Namespace string
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+type aclAuthMethodFormat struct {
+	Name          string                 `json:"Name"`
+	Type          string                 `json:"Type"`
+	DisplayName   string                 `json:"DisplayName,omitempty"`
+	Description   string                 `json:"Description,omitempty"`
+	MaxTokenTTL   string                 `json:"MaxTokenTTL,omitempty"`
+	TokenLocality string                 `json:"TokenLocality,omitempty"`
+	Config        map[string]interface{} `json:"Config,omitempty"`
+	Namespace     string                 `json:"Namespace,omitempty"`
+	CreateIndex   uint64                 `json:"CreateIndex,omitempty"`
+	ModifyIndex   uint64                 `json:"ModifyIndex,omitempty"`
+}
+
+func internalizeACLAuthMethod(method ACLAuthMethod) aclAuthMethodFormat {
+	format := aclAuthMethodFormat{
+		Name:          method.Name,
+		Type:          method.Type,
+		DisplayName:   method.DisplayName,
+		Description:   method.Description,
+		TokenLocality: string(method.TokenLocality),
+		Config:        method.Config,
+		Namespace:     method.Namespace,
+	}
+	if method.MaxTokenTTL > 0 {
+		format.MaxTokenTTL = method.MaxTokenTTL.String()
+	}
+	return format
+}
+
+func (format aclAuthMethodFormat) authMethod() (ACLAuthMethod, error) {
+	var ttl time.Duration
+	if format.MaxTokenTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(format.MaxTokenTTL); err != nil {
+			return ACLAuthMethod{}, errors.Wrap(err, "failed to parse auth method max token ttl")
+		}
+	}
+
+	return ACLAuthMethod{
+		Name:          format.Name,
+		Type:          format.Type,
+		DisplayName:   format.DisplayName,
+		Description:   format.Description,
+		MaxTokenTTL:   ttl,
+		TokenLocality: ACLTokenLocality(format.TokenLocality),
+		Config:        format.Config,
+		Namespace:     format.Namespace,
+		CreateIndex:   format.CreateIndex,
+		ModifyIndex:   format.ModifyIndex,
+	}, nil
+}
+
+// ACLLogin are the credentials used to log in with an auth method.
+type ACLLogin struct {
+	// AuthMethod is the name of the auth method to log in with.
+	AuthMethod string `json:"AuthMethod"`
+
+	// BearerToken is the credential verified by the auth method, e.g. a
+	// kubernetes service account token.
+	BearerToken string `json:"BearerToken"`
+
+	// Meta (optional) is set as the metadata of the created token.
+	Meta map[string]string `json:"Meta,omitempty"`
+}
+
+// ACLReplicationStatus describes the replication of ACLs from the primary
+// datacenter to the datacenter of the queried agent.
+type ACLReplicationStatus struct {
+	Enabled              bool      `json:"Enabled"`
+	Running              bool      `json:"Running"`
+	SourceDatacenter     string    `json:"SourceDatacenter"`
+	ReplicationType      string    `json:"ReplicationType"`
+	ReplicatedIndex      uint64    `json:"ReplicatedIndex"`
+	ReplicatedRoleIndex  uint64    `json:"ReplicatedRoleIndex"`
+	ReplicatedTokenIndex uint64    `json:"ReplicatedTokenIndex"`
+	LastSuccess          time.Time `json:"LastSuccess"`
+	LastError            time.Time `json:"LastError"`
+	LastErrorMessage     string    `json:"LastErrorMessage"`
+}
+
+// ACLQuery is used to define values for each of the optional parameters of
+// the ACL endpoints.
+type ACLQuery struct {
+	// DC indicates which dc to query.
+	//
+	// If blank, this will default to the dc that the queried agent is in.
+	DC string
+
+	// Token (optional) is used to authenticate the request, instead of the
+	// token of the client.
+	Token string
+}
+
+// ACLTokenFilter limits the tokens listed by ListACLTokens. At most one of
+// Policy, Role, or AuthMethod may be set.
+type ACLTokenFilter struct {
+	// Policy (optional) only lists tokens linked to the policy of this ID.
+	Policy string
+
+	// Role (optional) only lists tokens linked to the role of this ID.
+	Role string
+
+	// AuthMethod (optional) only lists tokens created by the auth method of
+	// this name.
+	AuthMethod string
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i ACL -s _mock.go
+
+// An ACL is able to manage the tokens, policies, roles, binding rules, and
+// auth methods of the ACL system of consul. Most methods require a token with
+// the acl = "write" or acl = "read" permission.
+//
+// https://www.consul.io/api-docs/acl
+type ACL interface {
+
+	// ACLBootstrap creates the initial management token of a cluster which
+	// has ACLs enabled, and returns it. It may only be used once.
+	//
+	// https://www.consul.io/api-docs/acl#bootstrap-acls
+	ACLBootstrap(Ctx, ACLQuery) (ACLToken, error)
+
+	// ACLReplication returns the status of ACL replication in dc.
+	//
+	// https://www.consul.io/api-docs/acl#check-acl-replication
+	ACLReplication(Ctx, ACLQuery) (ACLReplicationStatus, error)
+
+	// ACLLogin exchanges the credentials of an auth method for a newly created
+	// token, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl#login-to-auth-method
+	ACLLogin(Ctx, ACLLogin, ACLQuery) (ACLToken, error)
+
+	// ACLLogout destroys the token of the query, which must have been created
+	// by ACLLogin.
+	//
+	// https://www.consul.io/api-docs/acl#logout-from-auth-method
+	ACLLogout(Ctx, ACLQuery) error
+
+	// CreateACLToken creates a new token, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/tokens#create-a-token
+	CreateACLToken(Ctx, ACLToken, ACLQuery) (ACLToken, error)
+
+	// ReadACLToken returns the token of accessorID.
+	//
+	// https://www.consul.io/api-docs/acl/tokens#read-a-token
+	ReadACLToken(ctx Ctx, accessorID string, query ACLQuery) (ACLToken, error)
+
+	// ReadSelfACLToken returns the token used to make the request.
+	//
+	// https://www.consul.io/api-docs/acl/tokens#read-self-token
+	ReadSelfACLToken(Ctx, ACLQuery) (ACLToken, error)
+
+	// UpdateACLToken replaces the token of the AccessorID of token, and
+	// returns it.
+	//
+	// https://www.consul.io/api-docs/acl/tokens#update-a-token
+	UpdateACLToken(Ctx, ACLToken, ACLQuery) (ACLToken, error)
+
+	// CloneACLToken creates a new token with the same policies, roles, and
+	// identities as the token of accessorID, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/tokens#clone-a-token
+	CloneACLToken(ctx Ctx, accessorID, description string, query ACLQuery) (ACLToken, error)
+
+	// DeleteACLToken deletes the token of accessorID.
+	//
+	// https://www.consul.io/api-docs/acl/tokens#delete-a-token
+	DeleteACLToken(ctx Ctx, accessorID string, query ACLQuery) error
+
+	// ListACLTokens lists the tokens matching filter, without their SecretID.
+	//
+	// https://www.consul.io/api-docs/acl/tokens#list-tokens
+	ListACLTokens(Ctx, ACLTokenFilter, ACLQuery) ([]ACLToken, error)
+
+	// CreateACLPolicy creates a new policy, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/policies#create-a-policy
+	CreateACLPolicy(Ctx, ACLPolicy, ACLQuery) (ACLPolicy, error)
+
+	// ReadACLPolicy returns the policy of id.
+	//
+	// https://www.consul.io/api-docs/acl/policies#read-a-policy
+	ReadACLPolicy(ctx Ctx, id string, query ACLQuery) (ACLPolicy, error)
+
+	// ReadACLPolicyByName returns the policy of name.
+	//
+	// https://www.consul.io/api-docs/acl/policies#read-a-policy-by-name
+	ReadACLPolicyByName(ctx Ctx, name string, query ACLQuery) (ACLPolicy, error)
+
+	// UpdateACLPolicy replaces the policy of the ID of policy, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/policies#update-a-policy
+	UpdateACLPolicy(Ctx, ACLPolicy, ACLQuery) (ACLPolicy, error)
+
+	// DeleteACLPolicy deletes the policy of id.
+	//
+	// https://www.consul.io/api-docs/acl/policies#delete-a-policy
+	DeleteACLPolicy(ctx Ctx, id string, query ACLQuery) error
+
+	// ListACLPolicies lists every policy, without their Rules.
+	//
+	// https://www.consul.io/api-docs/acl/policies#list-policies
+	ListACLPolicies(Ctx, ACLQuery) ([]ACLPolicy, error)
+
+	// CreateACLRole creates a new role, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/roles#create-a-role
+	CreateACLRole(Ctx, ACLRole, ACLQuery) (ACLRole, error)
+
+	// ReadACLRole returns the role of id.
+	//
+	// https://www.consul.io/api-docs/acl/roles#read-a-role
+	ReadACLRole(ctx Ctx, id string, query ACLQuery) (ACLRole, error)
+
+	// ReadACLRoleByName returns the role of name.
+	//
+	// https://www.consul.io/api-docs/acl/roles#read-a-role-by-name
+	ReadACLRoleByName(ctx Ctx, name string, query ACLQuery) (ACLRole, error)
+
+	// UpdateACLRole replaces the role of the ID of role, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/roles#update-a-role
+	UpdateACLRole(Ctx, ACLRole, ACLQuery) (ACLRole, error)
+
+	// DeleteACLRole deletes the role of id.
+	//
+	// https://www.consul.io/api-docs/acl/roles#delete-a-role
+	DeleteACLRole(ctx Ctx, id string, query ACLQuery) error
+
+	// ListACLRoles lists every role, or only those linked to the policy of
+	// policyID if set.
+	//
+	// https://www.consul.io/api-docs/acl/roles#list-roles
+	ListACLRoles(ctx Ctx, policyID string, query ACLQuery) ([]ACLRole, error)
+
+	// CreateACLBindingRule creates a new binding rule, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/binding-rules#create-a-binding-rule
+	CreateACLBindingRule(Ctx, ACLBindingRule, ACLQuery) (ACLBindingRule, error)
+
+	// ReadACLBindingRule returns the binding rule of id.
+	//
+	// https://www.consul.io/api-docs/acl/binding-rules#read-a-binding-rule
+	ReadACLBindingRule(ctx Ctx, id string, query ACLQuery) (ACLBindingRule, error)
+
+	// UpdateACLBindingRule replaces the binding rule of the ID of rule, and
+	// returns it.
+	//
+	// https://www.consul.io/api-docs/acl/binding-rules#update-a-binding-rule
+	UpdateACLBindingRule(Ctx, ACLBindingRule, ACLQuery) (ACLBindingRule, error)
+
+	// DeleteACLBindingRule deletes the binding rule of id.
+	//
+	// https://www.consul.io/api-docs/acl/binding-rules#delete-a-binding-rule
+	DeleteACLBindingRule(ctx Ctx, id string, query ACLQuery) error
+
+	// ListACLBindingRules lists every binding rule, or only those of the auth
+	// method of authMethod if set.
+	//
+	// https://www.consul.io/api-docs/acl/binding-rules#list-binding-rules
+	ListACLBindingRules(ctx Ctx, authMethod string, query ACLQuery) ([]ACLBindingRule, error)
+
+	// CreateACLAuthMethod creates a new auth method, and returns it.
+	//
+	// https://www.consul.io/api-docs/acl/auth-methods#create-an-auth-method
+	CreateACLAuthMethod(Ctx, ACLAuthMethod, ACLQuery) (ACLAuthMethod, error)
+
+	// ReadACLAuthMethod returns the auth method of name.
+	//
+	// https://www.consul.io/api-docs/acl/auth-methods#read-an-auth-method
+	ReadACLAuthMethod(ctx Ctx, name string, query ACLQuery) (ACLAuthMethod, error)
+
+	// UpdateACLAuthMethod replaces the auth method of the Name of method, and
+	// returns it.
+	//
+	// https://www.consul.io/api-docs/acl/auth-methods#update-an-auth-method
+	UpdateACLAuthMethod(Ctx, ACLAuthMethod, ACLQuery) (ACLAuthMethod, error)
+
+	// DeleteACLAuthMethod deletes the auth method of name, along with its
+	// binding rules and the tokens created by logging in with it.
+	//
+	// https://www.consul.io/api-docs/acl/auth-methods#delete-an-auth-method
+	DeleteACLAuthMethod(ctx Ctx, name string, query ACLQuery) error
+
+	// ListACLAuthMethods lists every auth method, without their Config.
+	//
+	// https://www.consul.io/api-docs/acl/auth-methods#list-auth-methods
+	ListACLAuthMethods(Ctx, ACLQuery) ([]ACLAuthMethod, error)
+}
+
+// An assertion that client satisfies ACL
+var _ ACL = (*client)(nil)
+
+// aclPath creates the path of an ACL endpoint, with the parameters of query.
+func aclPath(path string, query ACLQuery, params ...[2]string) string {
+	return fixup("/v1/acl", path, append(params, param("dc", query.DC))...)
+}
+
+// aclPut sends v as the body of a PUT request to path, decoding the response
+// into i.
+func (c *client) aclPut(ctx Ctx, path string, query ACLQuery, v, i interface{}) error {
+	body := ""
+	if v != nil {
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		body = string(bs)
+	}
+
+	return c.put(withToken(ctx, query.Token), path, body, i)
+}
+
+func (c *client) aclGet(ctx Ctx, path string, query ACLQuery, i interface{}) error {
+	return c.get(withToken(ctx, query.Token), path, i)
+}
+
+func (c *client) aclDelete(ctx Ctx, path string, query ACLQuery) error {
+	return c.delete(withToken(ctx, query.Token), path)
+}
+
+func (c *client) ACLBootstrap(ctx Ctx, query ACLQuery) (ACLToken, error) {
+	var token ACLToken
+	if err := c.aclPut(ctx, aclPath("bootstrap", query), query, nil, &token); err != nil {
+		return ACLToken{}, errors.Wrap(err, "failed to bootstrap acls")
+	}
+	return token, nil
+}
+
+func (c *client) ACLReplication(ctx Ctx, query ACLQuery) (ACLReplicationStatus, error) {
+	var status ACLReplicationStatus
+	if err := c.aclGet(ctx, aclPath("replication", query), query, &status); err != nil {
+		return ACLReplicationStatus{}, errors.Wrap(err, "failed to read acl replication status")
+	}
+	return status, nil
+}
+
+func (c *client) ACLLogin(ctx Ctx, login ACLLogin, query ACLQuery) (ACLToken, error) {
+	if login.AuthMethod == "" {
+		return ACLToken{}, errors.New("acl login auth method required")
+	}
+
+	bs, err := json.Marshal(login)
+	if err != nil {
+		return ACLToken{}, err
+	}
+
+	var token ACLToken
+	if err := c.post(withToken(ctx, query.Token), aclPath("login", query), string(bs), &token); err != nil {
+		return ACLToken{}, errors.Wrap(err, "failed to login")
+	}
+	return token, nil
+}
+
+func (c *client) ACLLogout(ctx Ctx, query ACLQuery) error {
+	if query.Token == "" {
+		return errors.New("acl logout token required")
+	}
+
+	if err := c.post(withToken(ctx, query.Token), aclPath("logout", query), "", nil); err != nil {
+		return errors.Wrap(err, "failed to logout")
+	}
+	return nil
+}
+
+func (c *client) CreateACLToken(ctx Ctx, token ACLToken, query ACLQuery) (ACLToken, error) {
+	var created ACLToken
+	if err := c.aclPut(ctx, aclPath("token", query), query, internalizeACLToken(token), &created); err != nil {
+		return ACLToken{}, errors.Wrap(err, "failed to create acl token")
+	}
+	return created, nil
+}
+
+func (c *client) ReadACLToken(ctx Ctx, accessorID string, query ACLQuery) (ACLToken, error) {
+	if accessorID == "" {
+		return ACLToken{}, errors.New("acl token accessor id required")
+	}
+
+	var token ACLToken
+	if err := c.aclGet(ctx, aclPath("token/"+accessorID, query), query, &token); err != nil {
+		return ACLToken{}, errors.Wrap(err, "failed to read acl token")
+	}
+	return token, nil
+}
+
+func (c *client) ReadSelfACLToken(ctx Ctx, query ACLQuery) (ACLToken, error) {
+	var token ACLToken
+	if err := c.aclGet(ctx, aclPath("token/self", query), query, &token); err != nil {
+		return ACLToken{}, errors.Wrap(err, "failed to read acl token")
+	}
+	return token, nil
+}
+
+func (c *client) UpdateACLToken(ctx Ctx, token ACLToken, query ACLQuery) (ACLToken, error) {
+	if token.AccessorID == "" {
+		return ACLToken{}, errors.New("acl token accessor id required")
+	}
+
+	var updated ACLToken
+	if err := c.aclPut(ctx, aclPath("token/"+token.AccessorID, query), query, internalizeACLToken(token), &updated); err != nil {
+		return ACLToken{}, errors.Wrap(err, "failed to update acl token")
+	}
+	return updated, nil
+}
+
+func (c *client) CloneACLToken(ctx Ctx, accessorID, description string, query ACLQuery) (ACLToken, error) {
+	if accessorID == "" {
+		return ACLToken{}, errors.New("acl token accessor id required")
+	}
+
+	clone := ACLToken{Description: description}
+
+	var cloned ACLToken
+	if err := c.aclPut(ctx, aclPath("token/"+accessorID+"/clone", query), query, internalizeACLToken(clone), &cloned); err != nil {
+		return ACLToken{}, errors.Wrap(err, "failed to clone acl token")
+	}
+	return cloned, nil
+}
+
+func (c *client) DeleteACLToken(ctx Ctx, accessorID string, query ACLQuery) error {
+	if accessorID == "" {
+		return errors.New("acl token accessor id required")
+	}
+
+	if err := c.aclDelete(ctx, aclPath("token/"+accessorID, query), query); err != nil {
+		return errors.Wrap(err, "failed to delete acl token")
+	}
+	return nil
+}
+
+func (c *client) ListACLTokens(ctx Ctx, filter ACLTokenFilter, query ACLQuery) ([]ACLToken, error) {
+	path := aclPath("tokens", query,
+		param("policy", filter.Policy),
+		param("role", filter.Role),
+		param("authmethod", filter.AuthMethod),
+	)
+
+	tokens := make([]ACLToken, 0, 10)
+	if err := c.aclGet(ctx, path, query, &tokens); err != nil {
+		return nil, errors.Wrap(err, "failed to list acl tokens")
+	}
+	return tokens, nil
+}
+
+func (c *client) CreateACLPolicy(ctx Ctx, policy ACLPolicy, query ACLQuery) (ACLPolicy, error) {
+	if policy.Name == "" {
+		return ACLPolicy{}, errors.New("acl policy name required")
+	}
+
+	var created ACLPolicy
+	if err := c.aclPut(ctx, aclPath("policy", query), query, policy, &created); err != nil {
+		return ACLPolicy{}, errors.Wrap(err, "failed to create acl policy")
+	}
+	return created, nil
+}
+
+func (c *client) ReadACLPolicy(ctx Ctx, id string, query ACLQuery) (ACLPolicy, error) {
+	if id == "" {
+		return ACLPolicy{}, errors.New("acl policy id required")
+	}
+
+	var policy ACLPolicy
+	if err := c.aclGet(ctx, aclPath("policy/"+id, query), query, &policy); err != nil {
+		return ACLPolicy{}, errors.Wrap(err, "failed to read acl policy")
+	}
+	return policy, nil
+}
+
+func (c *client) ReadACLPolicyByName(ctx Ctx, name string, query ACLQuery) (ACLPolicy, error) {
+	if name == "" {
+		return ACLPolicy{}, errors.New("acl policy name required")
+	}
+
+	var policy ACLPolicy
+	if err := c.aclGet(ctx, aclPath("policy/name/"+name, query), query, &policy); err != nil {
+		return ACLPolicy{}, errors.Wrap(err, "failed to read acl policy")
+	}
+	return policy, nil
+}
+
+func (c *client) UpdateACLPolicy(ctx Ctx, policy ACLPolicy, query ACLQuery) (ACLPolicy, error) {
+	if policy.ID == "" {
+		return ACLPolicy{}, errors.New("acl policy id required")
+	}
+
+	var updated ACLPolicy
+	if err := c.aclPut(ctx, aclPath("policy/"+policy.ID, query), query, policy, &updated); err != nil {
+		return ACLPolicy{}, errors.Wrap(err, "failed to update acl policy")
+	}
+	return updated, nil
+}
+
+func (c *client) DeleteACLPolicy(ctx Ctx, id string, query ACLQuery) error {
+	if id == "" {
+		return errors.New("acl policy id required")
+	}
+
+	if err := c.aclDelete(ctx, aclPath("policy/"+id, query), query); err != nil {
+		return errors.Wrap(err, "failed to delete acl policy")
+	}
+	return nil
+}
+
+func (c *client) ListACLPolicies(ctx Ctx, query ACLQuery) ([]ACLPolicy, error) {
+	policies := make([]ACLPolicy, 0, 10)
+	if err := c.aclGet(ctx, aclPath("policies", query), query, &policies); err != nil {
+		return nil, errors.Wrap(err, "failed to list acl policies")
+	}
+	return policies, nil
+}
+
+func (c *client) CreateACLRole(ctx Ctx, role ACLRole, query ACLQuery) (ACLRole, error) {
+	if role.Name == "" {
+		return ACLRole{}, errors.New("acl role name required")
+	}
+
+	var created ACLRole
+	if err := c.aclPut(ctx, aclPath("role", query), query, role, &created); err != nil {
+		return ACLRole{}, errors.Wrap(err, "failed to create acl role")
+	}
+	return created, nil
+}
+
+func (c *client) ReadACLRole(ctx Ctx, id string, query ACLQuery) (ACLRole, error) {
+	if id == "" {
+		return ACLRole{}, errors.New("acl role id required")
+	}
+
+	var role ACLRole
+	if err := c.aclGet(ctx, aclPath("role/"+id, query), query, &role); err != nil {
+		return ACLRole{}, errors.Wrap(err, "failed to read acl role")
+	}
+	return role, nil
+}
+
+func (c *client) ReadACLRoleByName(ctx Ctx, name string, query ACLQuery) (ACLRole, error) {
+	if name == "" {
+		return ACLRole{}, errors.New("acl role name required")
+	}
+
+	var role ACLRole
+	if err := c.aclGet(ctx, aclPath("role/name/"+name, query), query, &role); err != nil {
+		return ACLRole{}, errors.Wrap(err, "failed to read acl role")
+	}
+	return role, nil
+}
+
+func (c *client) UpdateACLRole(ctx Ctx, role ACLRole, query ACLQuery) (ACLRole, error) {
+	if role.ID == "" {
+		return ACLRole{}, errors.New("acl role id required")
+	}
+
+	var updated ACLRole
+	if err := c.aclPut(ctx, aclPath("role/"+role.ID, query), query, role, &updated); err != nil {
+		return ACLRole{}, errors.Wrap(err, "failed to update acl role")
+	}
+	return updated, nil
+}
+
+func (c *client) DeleteACLRole(ctx Ctx, id string, query ACLQuery) error {
+	if id == "" {
+		return errors.New("acl role id required")
+	}
+
+	if err := c.aclDelete(ctx, aclPath("role/"+id, query), query); err != nil {
+		return errors.Wrap(err, "failed to delete acl role")
+	}
+	return nil
+}
+
+func (c *client) ListACLRoles(ctx Ctx, policyID string, query ACLQuery) ([]ACLRole, error) {
+	roles := make([]ACLRole, 0, 10)
+	if err := c.aclGet(ctx, aclPath("roles", query, param("policy", policyID)), query, &roles); err != nil {
+		return nil, errors.Wrap(err, "failed to list acl roles")
+	}
+	return roles, nil
+}
+
+func (c *client) CreateACLBindingRule(ctx Ctx, rule ACLBindingRule, query ACLQuery) (ACLBindingRule, error) {
+	if rule.AuthMethod == "" {
+		return ACLBindingRule{}, errors.New("acl binding rule auth method required")
+	}
+
+	var created ACLBindingRule
+	if err := c.aclPut(ctx, aclPath("binding-rule", query), query, rule, &created); err != nil {
+		return ACLBindingRule{}, errors.Wrap(err, "failed to create acl binding rule")
+	}
+	return created, nil
+}
+
+func (c *client) ReadACLBindingRule(ctx Ctx, id string, query ACLQuery) (ACLBindingRule, error) {
+	if id == "" {
+		return ACLBindingRule{}, errors.New("acl binding rule id required")
+	}
+
+	var rule ACLBindingRule
+	if err := c.aclGet(ctx, aclPath("binding-rule/"+id, query), query, &rule); err != nil {
+		return ACLBindingRule{}, errors.Wrap(err, "failed to read acl binding rule")
+	}
+	return rule, nil
+}
+
+func (c *client) UpdateACLBindingRule(ctx Ctx, rule ACLBindingRule, query ACLQuery) (ACLBindingRule, error) {
+	if rule.ID == "" {
+		return ACLBindingRule{}, errors.New("acl binding rule id required")
+	}
+
+	var updated ACLBindingRule
+	if err := c.aclPut(ctx, aclPath("binding-rule/"+rule.ID, query), query, rule, &updated); err != nil {
+		return ACLBindingRule{}, errors.Wrap(err, "failed to update acl binding rule")
+	}
+	return updated, nil
+}
+
+func (c *client) DeleteACLBindingRule(ctx Ctx, id string, query ACLQuery) error {
+	if id == "" {
+		return errors.New("acl binding rule id required")
+	}
+
+	if err := c.aclDelete(ctx, aclPath("binding-rule/"+id, query), query); err != nil {
+		return errors.Wrap(err, "failed to delete acl binding rule")
+	}
+	return nil
+}
+
+func (c *client) ListACLBindingRules(ctx Ctx, authMethod string, query ACLQuery) ([]ACLBindingRule, error) {
+	rules := make([]ACLBindingRule, 0, 10)
+	if err := c.aclGet(ctx, aclPath("binding-rules", query, param("authmethod", authMethod)), query, &rules); err != nil {
+		return nil, errors.Wrap(err, "failed to list acl binding rules")
+	}
+	return rules, nil
+}
+
+func (c *client) CreateACLAuthMethod(ctx Ctx, method ACLAuthMethod, query ACLQuery) (ACLAuthMethod, error) {
+	if method.Name == "" {
+		return ACLAuthMethod{}, errors.New("acl auth method name required")
+	}
+
+	var created aclAuthMethodFormat
+	if err := c.aclPut(ctx, aclPath("auth-method", query), query, internalizeACLAuthMethod(method), &created); err != nil {
+		return ACLAuthMethod{}, errors.Wrap(err, "failed to create acl auth method")
+	}
+	return created.authMethod()
+}
+
+func (c *client) ReadACLAuthMethod(ctx Ctx, name string, query ACLQuery) (ACLAuthMethod, error) {
+	if name == "" {
+		return ACLAuthMethod{}, errors.New("acl auth method name required")
+	}
+
+	var method aclAuthMethodFormat
+	if err := c.aclGet(ctx, aclPath("auth-method/"+name, query), query, &method); err != nil {
+		return ACLAuthMethod{}, errors.Wrap(err, "failed to read acl auth method")
+	}
+	return method.authMethod()
+}
+
+func (c *client) UpdateACLAuthMethod(ctx Ctx, method ACLAuthMethod, query ACLQuery) (ACLAuthMethod, error) {
+	if method.Name == "" {
+		return ACLAuthMethod{}, errors.New("acl auth method name required")
+	}
+
+	var updated aclAuthMethodFormat
+	if err := c.aclPut(ctx, aclPath("auth-method/"+method.Name, query), query, internalizeACLAuthMethod(method), &updated); err != nil {
+		return ACLAuthMethod{}, errors.Wrap(err, "failed to update acl auth method")
+	}
+	return updated.authMethod()
+}
+
+func (c *client) DeleteACLAuthMethod(ctx Ctx, name string, query ACLQuery) error {
+	if name == "" {
+		return errors.New("acl auth method name required")
+	}
+
+	if err := c.aclDelete(ctx, aclPath("auth-method/"+name, query), query); err != nil {
+		return errors.Wrap(err, "failed to delete acl auth method")
+	}
+	return nil
+}
+
+func (c *client) ListACLAuthMethods(ctx Ctx, query ACLQuery) ([]ACLAuthMethod, error) {
+	var formats []aclAuthMethodFormat
+	if err := c.aclGet(ctx, aclPath("auth-methods", query), query, &formats); err != nil {
+		return nil, errors.Wrap(err, "failed to list acl auth methods")
+	}
+
+	methods := make([]ACLAuthMethod, 0, len(formats))
+	for _, format := range formats {
+		method, err := format.authMethod()
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, method)
+	}
+	return methods, nil
+}
@@ -0,0 +1,409 @@
+package consulapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ACL_ACLBootstrap(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_token.json"),
+		hasPath:   "/v1/acl/bootstrap",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	token, err := client.ACLBootstrap(ctx, ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, "45a3bd52-07c7-47a4-52fd-0745e0cfe967", token.SecretID)
+}
+
+func Test_ACL_ACLReplication(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_replication.json"),
+		hasPath:   "/v1/acl/replication",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc": {"dc2"},
+		},
+	})
+	defer ts.Close()
+
+	status, err := client.ACLReplication(ctx, ACLQuery{DC: "dc2"})
+	require.NoError(t, err)
+	require.Equal(t, ACLReplicationStatus{
+		Enabled:              true,
+		Running:              true,
+		SourceDatacenter:     "dc1",
+		ReplicationType:      "tokens",
+		ReplicatedIndex:      1976,
+		ReplicatedRoleIndex:  1,
+		ReplicatedTokenIndex: 2018,
+		LastSuccess:          time.Date(2018, 11, 3, 6, 28, 58, 0, time.UTC),
+		LastError:            time.Date(2016, 11, 3, 6, 28, 28, 0, time.UTC),
+		LastErrorMessage:     "failed to sync tokens",
+	}, status)
+}
+
+func Test_ACL_ACLLogin(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_token.json"),
+		hasPath:   "/v1/acl/login",
+		hasMethod: http.MethodPost,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{"AuthMethod":"minikube","BearerToken":"eyJhbGciOi","Meta":{"pod":"web-1"}}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/acl/logout",
+		hasMethod: http.MethodPost,
+		hasQuery:  map[string][]string{},
+		hasHeaders: map[string]string{
+			consulTokenHeader: "45a3bd52-07c7-47a4-52fd-0745e0cfe967",
+		},
+	}}})
+	defer ts.Close()
+
+	token, err := client.ACLLogin(ctx, ACLLogin{
+		AuthMethod:  "minikube",
+		BearerToken: "eyJhbGciOi",
+		Meta:        map[string]string{"pod": "web-1"},
+	}, ACLQuery{})
+	require.NoError(t, err)
+
+	err = client.ACLLogout(ctx, ACLQuery{Token: token.SecretID})
+	require.NoError(t, err)
+
+	err = client.ACLLogout(ctx, ACLQuery{})
+	require.EqualError(t, err, "acl logout token required")
+}
+
+func Test_ACL_tokens(t *testing.T) {
+	created := time.Date(2018, 10, 24, 16, 25, 6, 921933000, time.UTC)
+	expToken := ACLToken{
+		AccessorID:        "6a1253d2-1785-24fd-91c2-f8e78c745511",
+		SecretID:          "45a3bd52-07c7-47a4-52fd-0745e0cfe967",
+		Description:       "Agent token for 'node1'",
+		Policies:          []ACLLink{{ID: "165d4317-e379-f732-ce70-86278c4558f7", Name: "node1-write"}},
+		ServiceIdentities: []ACLServiceIdentity{{ServiceName: "web", Datacenters: []string{"dc1"}}},
+		NodeIdentities:    []ACLNodeIdentity{{NodeName: "node1", Datacenter: "dc1"}},
+		CreateTime:        &created,
+		CreateIndex:       59,
+		ModifyIndex:       59,
+	}
+
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_token.json"),
+		hasPath:   "/v1/acl/token",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"Description":"Agent token for 'node1'",` +
+			`"Policies":[{"Name":"node1-write"}],` +
+			`"ServiceIdentities":[{"ServiceName":"web","Datacenters":["dc1"]}],` +
+			`"NodeIdentities":[{"NodeName":"node1","Datacenter":"dc1"}],` +
+			`"ExpirationTTL":"1h0m0s"}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_token.json"),
+		hasPath:   "/v1/acl/token/6a1253d2-1785-24fd-91c2-f8e78c745511",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc": {"dc2"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_token.json"),
+		hasPath:   "/v1/acl/token/self",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+		hasHeaders: map[string]string{
+			consulTokenHeader: "45a3bd52-07c7-47a4-52fd-0745e0cfe967",
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_token.json"),
+		hasPath:   "/v1/acl/token/6a1253d2-1785-24fd-91c2-f8e78c745511/clone",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{"Description":"clone of node1"}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/acl/token/6a1253d2-1785-24fd-91c2-f8e78c745511",
+		hasMethod: http.MethodDelete,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_tokens.json"),
+		hasPath:   "/v1/acl/tokens",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"policy": {"165d4317-e379-f732-ce70-86278c4558f7"},
+		},
+	}}})
+	defer ts.Close()
+
+	token, err := client.CreateACLToken(ctx, ACLToken{
+		Description:       "Agent token for 'node1'",
+		Policies:          []ACLLink{{Name: "node1-write"}},
+		ServiceIdentities: []ACLServiceIdentity{{ServiceName: "web", Datacenters: []string{"dc1"}}},
+		NodeIdentities:    []ACLNodeIdentity{{NodeName: "node1", Datacenter: "dc1"}},
+		ExpirationTTL:     time.Hour,
+	}, ACLQuery{})
+	require.NoError(t, err)
+	createTime := token.CreateTime.UTC()
+	token.CreateTime = &createTime
+	require.Equal(t, expToken, token)
+
+	token, err = client.ReadACLToken(ctx, token.AccessorID, ACLQuery{DC: "dc2"})
+	require.NoError(t, err)
+	require.Equal(t, expToken.AccessorID, token.AccessorID)
+
+	token, err = client.ReadSelfACLToken(ctx, ACLQuery{Token: token.SecretID})
+	require.NoError(t, err)
+	require.Equal(t, expToken.AccessorID, token.AccessorID)
+
+	_, err = client.CloneACLToken(ctx, token.AccessorID, "clone of node1", ACLQuery{})
+	require.NoError(t, err)
+
+	err = client.DeleteACLToken(ctx, token.AccessorID, ACLQuery{})
+	require.NoError(t, err)
+
+	tokens, err := client.ListACLTokens(ctx, ACLTokenFilter{Policy: "165d4317-e379-f732-ce70-86278c4558f7"}, ACLQuery{})
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, "Anonymous Token", tokens[1].Description)
+	require.Empty(t, tokens[0].SecretID)
+
+	_, err = client.UpdateACLToken(ctx, ACLToken{}, ACLQuery{})
+	require.EqualError(t, err, "acl token accessor id required")
+}
+
+func Test_ACL_UpdateACLToken(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_token.json"),
+		hasPath:   "/v1/acl/token/6a1253d2-1785-24fd-91c2-f8e78c745511",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"AccessorID":"6a1253d2-1785-24fd-91c2-f8e78c745511",` +
+			`"Description":"Agent token for 'node1'","ExpirationTTL":"1h30m0s"}`,
+	})
+	defer ts.Close()
+
+	token, err := client.UpdateACLToken(ctx, ACLToken{
+		AccessorID:    "6a1253d2-1785-24fd-91c2-f8e78c745511",
+		Description:   "Agent token for 'node1'",
+		ExpirationTTL: 90 * time.Minute,
+	}, ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, "6a1253d2-1785-24fd-91c2-f8e78c745511", token.AccessorID)
+}
+
+func Test_ACL_ReadACLToken_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusForbidden,
+		body:      "ACL not found",
+		hasPath:   "/v1/acl/token/abc123",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	_, err := client.ReadACLToken(ctx, "abc123", ACLQuery{})
+	require.EqualError(t, err, "failed to read acl token: GET /v1/acl/token/abc123: status code (403): ACL not found")
+	require.True(t, IsPermissionDenied(err))
+}
+
+func Test_ACL_policies(t *testing.T) {
+	expPolicy := ACLPolicy{
+		ID:          "e359bd81-baca-903e-7e64-1ccd9fdc78f5",
+		Name:        "node-read",
+		Description: "Grants read access to all node information",
+		Rules:       `node_prefix "" { policy = "read"}`,
+		Datacenters: []string{"dc1"},
+		CreateIndex: 14,
+		ModifyIndex: 14,
+	}
+
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_policy.json"),
+		hasPath:   "/v1/acl/policy",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"Name":"node-read","Description":"Grants read access to all node information",` +
+			`"Rules":"node_prefix \"\" { policy = \"read\"}","Datacenters":["dc1"]}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_policy.json"),
+		hasPath:   "/v1/acl/policy/name/node-read",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_policy.json"),
+		hasPath:   "/v1/acl/policy/e359bd81-baca-903e-7e64-1ccd9fdc78f5",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"ID":"e359bd81-baca-903e-7e64-1ccd9fdc78f5","Name":"node-read",` +
+			`"Description":"Grants read access to all node information",` +
+			`"Rules":"node_prefix \"\" { policy = \"read\"}","Datacenters":["dc1"],` +
+			`"CreateIndex":14,"ModifyIndex":14}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/acl/policy/e359bd81-baca-903e-7e64-1ccd9fdc78f5",
+		hasMethod: http.MethodDelete,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	policy, err := client.CreateACLPolicy(ctx, ACLPolicy{
+		Name:        "node-read",
+		Description: "Grants read access to all node information",
+		Rules:       `node_prefix "" { policy = "read"}`,
+		Datacenters: []string{"dc1"},
+	}, ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, expPolicy, policy)
+
+	policy, err = client.ReadACLPolicyByName(ctx, "node-read", ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, expPolicy, policy)
+
+	_, err = client.UpdateACLPolicy(ctx, policy, ACLQuery{})
+	require.NoError(t, err)
+
+	err = client.DeleteACLPolicy(ctx, policy.ID, ACLQuery{})
+	require.NoError(t, err)
+
+	_, err = client.CreateACLPolicy(ctx, ACLPolicy{}, ACLQuery{})
+	require.EqualError(t, err, "acl policy name required")
+}
+
+func Test_ACL_ListACLRoles(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_roles.json"),
+		hasPath:   "/v1/acl/roles",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"policy": {"783beef3-783f-f41f-7422-7087dc272765"},
+		},
+	})
+	defer ts.Close()
+
+	roles, err := client.ListACLRoles(ctx, "783beef3-783f-f41f-7422-7087dc272765", ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, []ACLRole{{
+		ID:          "5e52a099-4c90-c067-5478-980f06be9af5",
+		Name:        "node-read",
+		Policies:    []ACLLink{{ID: "783beef3-783f-f41f-7422-7087dc272765", Name: "node-read"}},
+		CreateIndex: 57,
+		ModifyIndex: 57,
+	}, {
+		ID:          "aa770e5b-8b0b-7fcf-e5a1-8535fcc388b4",
+		Name:        "example-role",
+		Description: "Showcases all input parameters",
+		Policies:    []ACLLink{{ID: "783beef3-783f-f41f-7422-7087dc272765", Name: "node-read"}},
+		ServiceIdentities: []ACLServiceIdentity{
+			{ServiceName: "web"},
+			{ServiceName: "db", Datacenters: []string{"dc1"}},
+		},
+		NodeIdentities: []ACLNodeIdentity{{NodeName: "node-1", Datacenter: "dc2"}},
+		CreateIndex:    58,
+		ModifyIndex:    58,
+	}}, roles)
+}
+
+func Test_ACL_CreateACLBindingRule(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_binding-rule.json"),
+		hasPath:   "/v1/acl/binding-rule",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"Description":"example rule","AuthMethod":"minikube",` +
+			`"Selector":"serviceaccount.namespace==default","BindType":"service",` +
+			`"BindName":"{{ serviceaccount.name }}"}`,
+	})
+	defer ts.Close()
+
+	rule, err := client.CreateACLBindingRule(ctx, ACLBindingRule{
+		Description: "example rule",
+		AuthMethod:  "minikube",
+		Selector:    "serviceaccount.namespace==default",
+		BindType:    ACLBindService,
+		BindName:    "{{ serviceaccount.name }}",
+	}, ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, "000ed53c-e2d3-e7e6-31a5-c19bc406a2b3", rule.ID)
+	require.Equal(t, ACLBindService, rule.BindType)
+}
+
+func Test_ACL_auth_methods(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_acl_auth-method.json"),
+		hasPath:   "/v1/acl/auth-method",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"Name":"minikube","Type":"kubernetes","Description":"dev minikube cluster",` +
+			`"MaxTokenTTL":"1h0m0s","TokenLocality":"global",` +
+			`"Config":{"Host":"https://192.0.2.42:8443"}}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "[" + load(t, "v1_acl_auth-method.json") + "]",
+		hasPath:   "/v1/acl/auth-methods",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	method, err := client.CreateACLAuthMethod(ctx, ACLAuthMethod{
+		Name:          "minikube",
+		Type:          "kubernetes",
+		Description:   "dev minikube cluster",
+		MaxTokenTTL:   time.Hour,
+		TokenLocality: ACLTokenGlobal,
+		Config:        map[string]interface{}{"Host": "https://192.0.2.42:8443"},
+	}, ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, method.MaxTokenTTL)
+	require.Equal(t, ACLTokenGlobal, method.TokenLocality)
+	require.Equal(t, "https://192.0.2.42:8443", method.Config["Host"])
+	require.Equal(t, uint64(15), method.CreateIndex)
+
+	methods, err := client.ListACLAuthMethods(ctx, ACLQuery{})
+	require.NoError(t, err)
+	require.Equal(t, []ACLAuthMethod{method}, methods)
+}
@@ -56,10 +56,67 @@ type Agent interface {
 	// https://www.consul.io/api/agent.html#force-leave-and-shutdown
 	ForceLeave(ctx Ctx, node string) error
 
-	// SetACLToken will set the given kind of token to the value.
+	// SetACLToken will set the given kind of token of the agent to the value.
+	// Agents which predate a kind of token are updated using the older name
+	// of the kind, if any.
 	//
 	// https://www.consul.io/api/agent.html#update-acl-tokens
-	SetACLToken(ctx Ctx, kind, token string) error
+	SetACLToken(ctx Ctx, kind AgentTokenKind, token string) error
+
+	// RegisterService adds a new service to the agent, or updates an existing
+	// service with the same ID. If replaceChecks is set, any checks of the
+	// existing service that are not part of the registration are removed.
+	//
+	// https://www.consul.io/api/agent/service.html#register-service
+	RegisterService(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool) error
+
+	// DeregisterService removes the service of serviceID from the agent,
+	// along with all of its checks.
+	//
+	// https://www.consul.io/api/agent/service.html#deregister-service
+	DeregisterService(ctx Ctx, serviceID string) error
+
+	// ServiceMaintenanceMode puts the service of serviceID into a mode where
+	// it is marked unavailable, and will not be present in DNS or API queries.
+	//
+	// https://www.consul.io/api/agent/service.html#enable-maintenance-mode
+	ServiceMaintenanceMode(ctx Ctx, serviceID string, enabled bool, reason string) error
+
+	// RegisterCheck adds a new check to the agent, or updates an existing
+	// check with the same ID. Set ServiceID to associate the check with a
+	// service registered on the agent.
+	//
+	// https://www.consul.io/api/agent/check.html#register-check
+	RegisterCheck(ctx Ctx, check AgentCheckRegistration) error
+
+	// DeregisterCheck removes the check of checkID from the agent.
+	//
+	// https://www.consul.io/api/agent/check.html#deregister-check
+	DeregisterCheck(ctx Ctx, checkID string) error
+
+	// PassTTL sets the TTL check of checkID to the passing state, and resets
+	// the TTL clock. The note is optional, and becomes the output of the check.
+	//
+	// https://www.consul.io/api/agent/check.html#ttl-check-pass
+	PassTTL(ctx Ctx, checkID, note string) error
+
+	// WarnTTL sets the TTL check of checkID to the warning state, and resets
+	// the TTL clock. The note is optional, and becomes the output of the check.
+	//
+	// https://www.consul.io/api/agent/check.html#ttl-check-warn
+	WarnTTL(ctx Ctx, checkID, note string) error
+
+	// FailTTL sets the TTL check of checkID to the critical state, and resets
+	// the TTL clock. The note is optional, and becomes the output of the check.
+	//
+	// https://www.consul.io/api/agent/check.html#ttl-check-fail
+	FailTTL(ctx Ctx, checkID, note string) error
+
+	// UpdateTTL sets the TTL check of checkID to status, and resets the TTL
+	// clock. The output may be used to describe the reason for the status.
+	//
+	// https://www.consul.io/api/agent/check.html#ttl-check-update
+	UpdateTTL(ctx Ctx, checkID, output string, status CheckStatus) error
 
 	// Monitor(loglevel string) // log stream, maybe someday
 }
@@ -186,24 +243,79 @@ type setToken struct {
 	Token string `json:"Token"`
 }
 
-func (c *client) SetACLToken(ctx Ctx, kind, token string) error {
+// AgentTokenKind is the kind of a token used by the agent itself.
+type AgentTokenKind string
+
+const (
+	// AgentTokenDefault is used for requests to the agent which have no token.
+	AgentTokenDefault AgentTokenKind = "default"
+
+	// AgentTokenAgent is used for the internal operations of the agent.
+	AgentTokenAgent AgentTokenKind = "agent"
 
+	// AgentTokenAgentRecovery is used to access the agent when the servers
+	// are unavailable. It was named agent_master before consul 1.11.
+	AgentTokenAgentRecovery AgentTokenKind = "agent_recovery"
+
+	// AgentTokenAgentMaster is the name of AgentTokenAgentRecovery before
+	// consul 1.11.
+	//
+	// Deprecated: use AgentTokenAgentRecovery, which also works with older
+	// agents.
+	AgentTokenAgentMaster AgentTokenKind = "agent_master"
+
+	// AgentTokenReplication is used by servers to replicate ACLs from the
+	// primary datacenter.
+	AgentTokenReplication AgentTokenKind = "replication"
+
+	// AgentTokenConfigFileServiceRegistration is used to register services
+	// and checks defined in the configuration files of the agent.
+	AgentTokenConfigFileServiceRegistration AgentTokenKind = "config_file_service_registration"
+
+	// AgentTokenDNS is used by the DNS interface of the agent.
+	AgentTokenDNS AgentTokenKind = "dns"
+)
+
+// paths returns the names of the kind of token in the update token endpoint,
+// from the newest version of consul to the oldest. Before consul 1.4.3, the
+// names were prefixed with acl_.
+func (kind AgentTokenKind) paths() []string {
 	switch kind {
-	case "default", "agent", "agent_master", "replication":
-	default:
-		return errors.Errorf("unrecognized kind of token %q", kind)
+	case AgentTokenDefault:
+		return []string{"default", "acl_token"}
+	case AgentTokenAgent:
+		return []string{"agent", "acl_agent_token"}
+	case AgentTokenAgentRecovery, AgentTokenAgentMaster:
+		return []string{"agent_recovery", "agent_master", "acl_agent_master_token"}
+	case AgentTokenReplication:
+		return []string{"replication", "acl_replication_token"}
+	case AgentTokenConfigFileServiceRegistration:
+		return []string{"config_file_service_registration"}
+	case AgentTokenDNS:
+		return []string{"dns"}
 	}
+	return nil
+}
 
-	rPath := fixup("/v1/agent/token", kind)
+func (c *client) SetACLToken(ctx Ctx, kind AgentTokenKind, token string) error {
+	paths := kind.paths()
+	if len(paths) == 0 {
+		return errors.Errorf("unrecognized kind of token %q", kind)
+	}
 
 	bs, err := json.Marshal(setToken{Token: token})
 	if err != nil {
 		return errors.Wrap(err, "unable to create token payload")
 	}
 
-	if err := c.put(ctx, rPath, string(bs), nil); err != nil {
-		return err
+	// older agents do not recognize newer names of the kind of token
+	for i, path := range paths {
+		err = c.put(ctx, fixup("/v1/agent/token", path), string(bs), nil)
+		if err == nil || !IsNotFound(err) || i == len(paths)-1 {
+			break
+		}
+		c.log.Tracef("agent does not recognize token %q, try %q", path, paths[i+1])
 	}
 
-	return nil
+	return err
 }
@@ -14,6 +14,24 @@ import (
 type AgentMock struct {
 	t minimock.Tester
 
+	funcDeregisterCheck          func(ctx Ctx, checkID string) (err error)
+	inspectFuncDeregisterCheck   func(ctx Ctx, checkID string)
+	afterDeregisterCheckCounter  uint64
+	beforeDeregisterCheckCounter uint64
+	DeregisterCheckMock          mAgentMockDeregisterCheck
+
+	funcDeregisterService          func(ctx Ctx, serviceID string) (err error)
+	inspectFuncDeregisterService   func(ctx Ctx, serviceID string)
+	afterDeregisterServiceCounter  uint64
+	beforeDeregisterServiceCounter uint64
+	DeregisterServiceMock          mAgentMockDeregisterService
+
+	funcFailTTL          func(ctx Ctx, checkID string, note string) (err error)
+	inspectFuncFailTTL   func(ctx Ctx, checkID string, note string)
+	afterFailTTLCounter  uint64
+	beforeFailTTLCounter uint64
+	FailTTLMock          mAgentMockFailTTL
+
 	funcForceLeave          func(ctx Ctx, node string) (err error)
 	inspectFuncForceLeave   func(ctx Ctx, node string)
 	afterForceLeaveCounter  uint64
@@ -50,6 +68,24 @@ type AgentMock struct {
 	beforeMetricsCounter uint64
 	MetricsMock          mAgentMockMetrics
 
+	funcPassTTL          func(ctx Ctx, checkID string, note string) (err error)
+	inspectFuncPassTTL   func(ctx Ctx, checkID string, note string)
+	afterPassTTLCounter  uint64
+	beforePassTTLCounter uint64
+	PassTTLMock          mAgentMockPassTTL
+
+	funcRegisterCheck          func(ctx Ctx, check AgentCheckRegistration) (err error)
+	inspectFuncRegisterCheck   func(ctx Ctx, check AgentCheckRegistration)
+	afterRegisterCheckCounter  uint64
+	beforeRegisterCheckCounter uint64
+	RegisterCheckMock          mAgentMockRegisterCheck
+
+	funcRegisterService          func(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool) (err error)
+	inspectFuncRegisterService   func(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool)
+	afterRegisterServiceCounter  uint64
+	beforeRegisterServiceCounter uint64
+	RegisterServiceMock          mAgentMockRegisterService
+
 	funcReload          func(ctx Ctx) (err error)
 	inspectFuncReload   func(ctx Ctx)
 	afterReloadCounter  uint64
@@ -62,11 +98,29 @@ type AgentMock struct {
 	beforeSelfCounter uint64
 	SelfMock          mAgentMockSelf
 
-	funcSetACLToken          func(ctx Ctx, kind string, token string) (err error)
-	inspectFuncSetACLToken   func(ctx Ctx, kind string, token string)
+	funcServiceMaintenanceMode          func(ctx Ctx, serviceID string, enabled bool, reason string) (err error)
+	inspectFuncServiceMaintenanceMode   func(ctx Ctx, serviceID string, enabled bool, reason string)
+	afterServiceMaintenanceModeCounter  uint64
+	beforeServiceMaintenanceModeCounter uint64
+	ServiceMaintenanceModeMock          mAgentMockServiceMaintenanceMode
+
+	funcSetACLToken          func(ctx Ctx, kind AgentTokenKind, token string) (err error)
+	inspectFuncSetACLToken   func(ctx Ctx, kind AgentTokenKind, token string)
 	afterSetACLTokenCounter  uint64
 	beforeSetACLTokenCounter uint64
 	SetACLTokenMock          mAgentMockSetACLToken
+
+	funcUpdateTTL          func(ctx Ctx, checkID string, output string, status CheckStatus) (err error)
+	inspectFuncUpdateTTL   func(ctx Ctx, checkID string, output string, status CheckStatus)
+	afterUpdateTTLCounter  uint64
+	beforeUpdateTTLCounter uint64
+	UpdateTTLMock          mAgentMockUpdateTTL
+
+	funcWarnTTL          func(ctx Ctx, checkID string, note string) (err error)
+	inspectFuncWarnTTL   func(ctx Ctx, checkID string, note string)
+	afterWarnTTLCounter  uint64
+	beforeWarnTTLCounter uint64
+	WarnTTLMock          mAgentMockWarnTTL
 }
 
 // NewAgentMock returns a mock for Agent
@@ -76,6 +130,15 @@ func NewAgentMock(t minimock.Tester) *AgentMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.DeregisterCheckMock = mAgentMockDeregisterCheck{mock: m}
+	m.DeregisterCheckMock.callArgs = []*AgentMockDeregisterCheckParams{}
+
+	m.DeregisterServiceMock = mAgentMockDeregisterService{mock: m}
+	m.DeregisterServiceMock.callArgs = []*AgentMockDeregisterServiceParams{}
+
+	m.FailTTLMock = mAgentMockFailTTL{mock: m}
+	m.FailTTLMock.callArgs = []*AgentMockFailTTLParams{}
+
 	m.ForceLeaveMock = mAgentMockForceLeave{mock: m}
 	m.ForceLeaveMock.callArgs = []*AgentMockForceLeaveParams{}
 
@@ -94,1967 +157,3943 @@ func NewAgentMock(t minimock.Tester) *AgentMock {
 	m.MetricsMock = mAgentMockMetrics{mock: m}
 	m.MetricsMock.callArgs = []*AgentMockMetricsParams{}
 
+	m.PassTTLMock = mAgentMockPassTTL{mock: m}
+	m.PassTTLMock.callArgs = []*AgentMockPassTTLParams{}
+
+	m.RegisterCheckMock = mAgentMockRegisterCheck{mock: m}
+	m.RegisterCheckMock.callArgs = []*AgentMockRegisterCheckParams{}
+
+	m.RegisterServiceMock = mAgentMockRegisterService{mock: m}
+	m.RegisterServiceMock.callArgs = []*AgentMockRegisterServiceParams{}
+
 	m.ReloadMock = mAgentMockReload{mock: m}
 	m.ReloadMock.callArgs = []*AgentMockReloadParams{}
 
 	m.SelfMock = mAgentMockSelf{mock: m}
 	m.SelfMock.callArgs = []*AgentMockSelfParams{}
 
+	m.ServiceMaintenanceModeMock = mAgentMockServiceMaintenanceMode{mock: m}
+	m.ServiceMaintenanceModeMock.callArgs = []*AgentMockServiceMaintenanceModeParams{}
+
 	m.SetACLTokenMock = mAgentMockSetACLToken{mock: m}
 	m.SetACLTokenMock.callArgs = []*AgentMockSetACLTokenParams{}
 
+	m.UpdateTTLMock = mAgentMockUpdateTTL{mock: m}
+	m.UpdateTTLMock.callArgs = []*AgentMockUpdateTTLParams{}
+
+	m.WarnTTLMock = mAgentMockWarnTTL{mock: m}
+	m.WarnTTLMock.callArgs = []*AgentMockWarnTTLParams{}
+
 	return m
 }
 
-type mAgentMockForceLeave struct {
+type mAgentMockDeregisterCheck struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockForceLeaveExpectation
-	expectations       []*AgentMockForceLeaveExpectation
+	defaultExpectation *AgentMockDeregisterCheckExpectation
+	expectations       []*AgentMockDeregisterCheckExpectation
 
-	callArgs []*AgentMockForceLeaveParams
+	callArgs []*AgentMockDeregisterCheckParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockForceLeaveExpectation specifies expectation struct of the Agent.ForceLeave
-type AgentMockForceLeaveExpectation struct {
+// AgentMockDeregisterCheckExpectation specifies expectation struct of the Agent.DeregisterCheck
+type AgentMockDeregisterCheckExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockForceLeaveParams
-	results *AgentMockForceLeaveResults
+	params  *AgentMockDeregisterCheckParams
+	results *AgentMockDeregisterCheckResults
 	Counter uint64
 }
 
-// AgentMockForceLeaveParams contains parameters of the Agent.ForceLeave
-type AgentMockForceLeaveParams struct {
-	ctx  Ctx
-	node string
+// AgentMockDeregisterCheckParams contains parameters of the Agent.DeregisterCheck
+type AgentMockDeregisterCheckParams struct {
+	ctx     Ctx
+	checkID string
 }
 
-// AgentMockForceLeaveResults contains results of the Agent.ForceLeave
-type AgentMockForceLeaveResults struct {
+// AgentMockDeregisterCheckResults contains results of the Agent.DeregisterCheck
+type AgentMockDeregisterCheckResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.ForceLeave
-func (mmForceLeave *mAgentMockForceLeave) Expect(ctx Ctx, node string) *mAgentMockForceLeave {
-	if mmForceLeave.mock.funcForceLeave != nil {
-		mmForceLeave.mock.t.Fatalf("AgentMock.ForceLeave mock is already set by Set")
+// Expect sets up expected params for Agent.DeregisterCheck
+func (mmDeregisterCheck *mAgentMockDeregisterCheck) Expect(ctx Ctx, checkID string) *mAgentMockDeregisterCheck {
+	if mmDeregisterCheck.mock.funcDeregisterCheck != nil {
+		mmDeregisterCheck.mock.t.Fatalf("AgentMock.DeregisterCheck mock is already set by Set")
 	}
 
-	if mmForceLeave.defaultExpectation == nil {
-		mmForceLeave.defaultExpectation = &AgentMockForceLeaveExpectation{}
+	if mmDeregisterCheck.defaultExpectation == nil {
+		mmDeregisterCheck.defaultExpectation = &AgentMockDeregisterCheckExpectation{}
 	}
 
-	mmForceLeave.defaultExpectation.params = &AgentMockForceLeaveParams{ctx, node}
-	for _, e := range mmForceLeave.expectations {
-		if minimock.Equal(e.params, mmForceLeave.defaultExpectation.params) {
-			mmForceLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmForceLeave.defaultExpectation.params)
+	mmDeregisterCheck.defaultExpectation.params = &AgentMockDeregisterCheckParams{ctx, checkID}
+	for _, e := range mmDeregisterCheck.expectations {
+		if minimock.Equal(e.params, mmDeregisterCheck.defaultExpectation.params) {
+			mmDeregisterCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeregisterCheck.defaultExpectation.params)
 		}
 	}
 
-	return mmForceLeave
+	return mmDeregisterCheck
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.ForceLeave
-func (mmForceLeave *mAgentMockForceLeave) Inspect(f func(ctx Ctx, node string)) *mAgentMockForceLeave {
-	if mmForceLeave.mock.inspectFuncForceLeave != nil {
-		mmForceLeave.mock.t.Fatalf("Inspect function is already set for AgentMock.ForceLeave")
+// Inspect accepts an inspector function that has same arguments as the Agent.DeregisterCheck
+func (mmDeregisterCheck *mAgentMockDeregisterCheck) Inspect(f func(ctx Ctx, checkID string)) *mAgentMockDeregisterCheck {
+	if mmDeregisterCheck.mock.inspectFuncDeregisterCheck != nil {
+		mmDeregisterCheck.mock.t.Fatalf("Inspect function is already set for AgentMock.DeregisterCheck")
 	}
 
-	mmForceLeave.mock.inspectFuncForceLeave = f
+	mmDeregisterCheck.mock.inspectFuncDeregisterCheck = f
 
-	return mmForceLeave
+	return mmDeregisterCheck
 }
 
-// Return sets up results that will be returned by Agent.ForceLeave
-func (mmForceLeave *mAgentMockForceLeave) Return(err error) *AgentMock {
-	if mmForceLeave.mock.funcForceLeave != nil {
-		mmForceLeave.mock.t.Fatalf("AgentMock.ForceLeave mock is already set by Set")
+// Return sets up results that will be returned by Agent.DeregisterCheck
+func (mmDeregisterCheck *mAgentMockDeregisterCheck) Return(err error) *AgentMock {
+	if mmDeregisterCheck.mock.funcDeregisterCheck != nil {
+		mmDeregisterCheck.mock.t.Fatalf("AgentMock.DeregisterCheck mock is already set by Set")
 	}
 
-	if mmForceLeave.defaultExpectation == nil {
-		mmForceLeave.defaultExpectation = &AgentMockForceLeaveExpectation{mock: mmForceLeave.mock}
+	if mmDeregisterCheck.defaultExpectation == nil {
+		mmDeregisterCheck.defaultExpectation = &AgentMockDeregisterCheckExpectation{mock: mmDeregisterCheck.mock}
 	}
-	mmForceLeave.defaultExpectation.results = &AgentMockForceLeaveResults{err}
-	return mmForceLeave.mock
+	mmDeregisterCheck.defaultExpectation.results = &AgentMockDeregisterCheckResults{err}
+	return mmDeregisterCheck.mock
 }
 
-//Set uses given function f to mock the Agent.ForceLeave method
-func (mmForceLeave *mAgentMockForceLeave) Set(f func(ctx Ctx, node string) (err error)) *AgentMock {
-	if mmForceLeave.defaultExpectation != nil {
-		mmForceLeave.mock.t.Fatalf("Default expectation is already set for the Agent.ForceLeave method")
+//Set uses given function f to mock the Agent.DeregisterCheck method
+func (mmDeregisterCheck *mAgentMockDeregisterCheck) Set(f func(ctx Ctx, checkID string) (err error)) *AgentMock {
+	if mmDeregisterCheck.defaultExpectation != nil {
+		mmDeregisterCheck.mock.t.Fatalf("Default expectation is already set for the Agent.DeregisterCheck method")
 	}
 
-	if len(mmForceLeave.expectations) > 0 {
-		mmForceLeave.mock.t.Fatalf("Some expectations are already set for the Agent.ForceLeave method")
+	if len(mmDeregisterCheck.expectations) > 0 {
+		mmDeregisterCheck.mock.t.Fatalf("Some expectations are already set for the Agent.DeregisterCheck method")
 	}
 
-	mmForceLeave.mock.funcForceLeave = f
-	return mmForceLeave.mock
+	mmDeregisterCheck.mock.funcDeregisterCheck = f
+	return mmDeregisterCheck.mock
 }
 
-// When sets expectation for the Agent.ForceLeave which will trigger the result defined by the following
+// When sets expectation for the Agent.DeregisterCheck which will trigger the result defined by the following
 // Then helper
-func (mmForceLeave *mAgentMockForceLeave) When(ctx Ctx, node string) *AgentMockForceLeaveExpectation {
-	if mmForceLeave.mock.funcForceLeave != nil {
-		mmForceLeave.mock.t.Fatalf("AgentMock.ForceLeave mock is already set by Set")
+func (mmDeregisterCheck *mAgentMockDeregisterCheck) When(ctx Ctx, checkID string) *AgentMockDeregisterCheckExpectation {
+	if mmDeregisterCheck.mock.funcDeregisterCheck != nil {
+		mmDeregisterCheck.mock.t.Fatalf("AgentMock.DeregisterCheck mock is already set by Set")
 	}
 
-	expectation := &AgentMockForceLeaveExpectation{
-		mock:   mmForceLeave.mock,
-		params: &AgentMockForceLeaveParams{ctx, node},
+	expectation := &AgentMockDeregisterCheckExpectation{
+		mock:   mmDeregisterCheck.mock,
+		params: &AgentMockDeregisterCheckParams{ctx, checkID},
 	}
-	mmForceLeave.expectations = append(mmForceLeave.expectations, expectation)
+	mmDeregisterCheck.expectations = append(mmDeregisterCheck.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.ForceLeave return parameters for the expectation previously defined by the When method
-func (e *AgentMockForceLeaveExpectation) Then(err error) *AgentMock {
-	e.results = &AgentMockForceLeaveResults{err}
+// Then sets up Agent.DeregisterCheck return parameters for the expectation previously defined by the When method
+func (e *AgentMockDeregisterCheckExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockDeregisterCheckResults{err}
 	return e.mock
 }
 
-// ForceLeave implements Agent
-func (mmForceLeave *AgentMock) ForceLeave(ctx Ctx, node string) (err error) {
-	mm_atomic.AddUint64(&mmForceLeave.beforeForceLeaveCounter, 1)
-	defer mm_atomic.AddUint64(&mmForceLeave.afterForceLeaveCounter, 1)
+// DeregisterCheck implements Agent
+func (mmDeregisterCheck *AgentMock) DeregisterCheck(ctx Ctx, checkID string) (err error) {
+	mm_atomic.AddUint64(&mmDeregisterCheck.beforeDeregisterCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeregisterCheck.afterDeregisterCheckCounter, 1)
 
-	if mmForceLeave.inspectFuncForceLeave != nil {
-		mmForceLeave.inspectFuncForceLeave(ctx, node)
+	if mmDeregisterCheck.inspectFuncDeregisterCheck != nil {
+		mmDeregisterCheck.inspectFuncDeregisterCheck(ctx, checkID)
 	}
 
-	mm_params := &AgentMockForceLeaveParams{ctx, node}
+	mm_params := &AgentMockDeregisterCheckParams{ctx, checkID}
 
 	// Record call args
-	mmForceLeave.ForceLeaveMock.mutex.Lock()
-	mmForceLeave.ForceLeaveMock.callArgs = append(mmForceLeave.ForceLeaveMock.callArgs, mm_params)
-	mmForceLeave.ForceLeaveMock.mutex.Unlock()
+	mmDeregisterCheck.DeregisterCheckMock.mutex.Lock()
+	mmDeregisterCheck.DeregisterCheckMock.callArgs = append(mmDeregisterCheck.DeregisterCheckMock.callArgs, mm_params)
+	mmDeregisterCheck.DeregisterCheckMock.mutex.Unlock()
 
-	for _, e := range mmForceLeave.ForceLeaveMock.expectations {
+	for _, e := range mmDeregisterCheck.DeregisterCheckMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmForceLeave.ForceLeaveMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmForceLeave.ForceLeaveMock.defaultExpectation.Counter, 1)
-		mm_want := mmForceLeave.ForceLeaveMock.defaultExpectation.params
-		mm_got := AgentMockForceLeaveParams{ctx, node}
+	if mmDeregisterCheck.DeregisterCheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeregisterCheck.DeregisterCheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeregisterCheck.DeregisterCheckMock.defaultExpectation.params
+		mm_got := AgentMockDeregisterCheckParams{ctx, checkID}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmForceLeave.t.Errorf("AgentMock.ForceLeave got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeregisterCheck.t.Errorf("AgentMock.DeregisterCheck got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmForceLeave.ForceLeaveMock.defaultExpectation.results
+		mm_results := mmDeregisterCheck.DeregisterCheckMock.defaultExpectation.results
 		if mm_results == nil {
-			mmForceLeave.t.Fatal("No results are set for the AgentMock.ForceLeave")
+			mmDeregisterCheck.t.Fatal("No results are set for the AgentMock.DeregisterCheck")
 		}
 		return (*mm_results).err
 	}
-	if mmForceLeave.funcForceLeave != nil {
-		return mmForceLeave.funcForceLeave(ctx, node)
+	if mmDeregisterCheck.funcDeregisterCheck != nil {
+		return mmDeregisterCheck.funcDeregisterCheck(ctx, checkID)
 	}
-	mmForceLeave.t.Fatalf("Unexpected call to AgentMock.ForceLeave. %v %v", ctx, node)
+	mmDeregisterCheck.t.Fatalf("Unexpected call to AgentMock.DeregisterCheck. %v %v", ctx, checkID)
 	return
 }
 
-// ForceLeaveAfterCounter returns a count of finished AgentMock.ForceLeave invocations
-func (mmForceLeave *AgentMock) ForceLeaveAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmForceLeave.afterForceLeaveCounter)
+// DeregisterCheckAfterCounter returns a count of finished AgentMock.DeregisterCheck invocations
+func (mmDeregisterCheck *AgentMock) DeregisterCheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeregisterCheck.afterDeregisterCheckCounter)
 }
 
-// ForceLeaveBeforeCounter returns a count of AgentMock.ForceLeave invocations
-func (mmForceLeave *AgentMock) ForceLeaveBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmForceLeave.beforeForceLeaveCounter)
+// DeregisterCheckBeforeCounter returns a count of AgentMock.DeregisterCheck invocations
+func (mmDeregisterCheck *AgentMock) DeregisterCheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeregisterCheck.beforeDeregisterCheckCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.ForceLeave.
+// Calls returns a list of arguments used in each call to AgentMock.DeregisterCheck.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmForceLeave *mAgentMockForceLeave) Calls() []*AgentMockForceLeaveParams {
-	mmForceLeave.mutex.RLock()
+func (mmDeregisterCheck *mAgentMockDeregisterCheck) Calls() []*AgentMockDeregisterCheckParams {
+	mmDeregisterCheck.mutex.RLock()
 
-	argCopy := make([]*AgentMockForceLeaveParams, len(mmForceLeave.callArgs))
-	copy(argCopy, mmForceLeave.callArgs)
+	argCopy := make([]*AgentMockDeregisterCheckParams, len(mmDeregisterCheck.callArgs))
+	copy(argCopy, mmDeregisterCheck.callArgs)
 
-	mmForceLeave.mutex.RUnlock()
+	mmDeregisterCheck.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockForceLeaveDone returns true if the count of the ForceLeave invocations corresponds
+// MinimockDeregisterCheckDone returns true if the count of the DeregisterCheck invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockForceLeaveDone() bool {
-	for _, e := range m.ForceLeaveMock.expectations {
+func (m *AgentMock) MinimockDeregisterCheckDone() bool {
+	for _, e := range m.DeregisterCheckMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ForceLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
+	if m.DeregisterCheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeregisterCheckCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcForceLeave != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
+	if m.funcDeregisterCheck != nil && mm_atomic.LoadUint64(&m.afterDeregisterCheckCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockForceLeaveInspect logs each unmet expectation
-func (m *AgentMock) MinimockForceLeaveInspect() {
-	for _, e := range m.ForceLeaveMock.expectations {
+// MinimockDeregisterCheckInspect logs each unmet expectation
+func (m *AgentMock) MinimockDeregisterCheckInspect() {
+	for _, e := range m.DeregisterCheckMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.ForceLeave with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.DeregisterCheck with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ForceLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
-		if m.ForceLeaveMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.ForceLeave")
+	if m.DeregisterCheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeregisterCheckCounter) < 1 {
+		if m.DeregisterCheckMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.DeregisterCheck")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.ForceLeave with params: %#v", *m.ForceLeaveMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.DeregisterCheck with params: %#v", *m.DeregisterCheckMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcForceLeave != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.ForceLeave")
+	if m.funcDeregisterCheck != nil && mm_atomic.LoadUint64(&m.afterDeregisterCheckCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.DeregisterCheck")
 	}
 }
 
-type mAgentMockJoin struct {
+type mAgentMockDeregisterService struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockJoinExpectation
-	expectations       []*AgentMockJoinExpectation
+	defaultExpectation *AgentMockDeregisterServiceExpectation
+	expectations       []*AgentMockDeregisterServiceExpectation
 
-	callArgs []*AgentMockJoinParams
+	callArgs []*AgentMockDeregisterServiceParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockJoinExpectation specifies expectation struct of the Agent.Join
-type AgentMockJoinExpectation struct {
+// AgentMockDeregisterServiceExpectation specifies expectation struct of the Agent.DeregisterService
+type AgentMockDeregisterServiceExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockJoinParams
-	results *AgentMockJoinResults
+	params  *AgentMockDeregisterServiceParams
+	results *AgentMockDeregisterServiceResults
 	Counter uint64
 }
 
-// AgentMockJoinParams contains parameters of the Agent.Join
-type AgentMockJoinParams struct {
-	ctx     Ctx
-	address string
-	wan     bool
+// AgentMockDeregisterServiceParams contains parameters of the Agent.DeregisterService
+type AgentMockDeregisterServiceParams struct {
+	ctx       Ctx
+	serviceID string
 }
 
-// AgentMockJoinResults contains results of the Agent.Join
-type AgentMockJoinResults struct {
+// AgentMockDeregisterServiceResults contains results of the Agent.DeregisterService
+type AgentMockDeregisterServiceResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.Join
-func (mmJoin *mAgentMockJoin) Expect(ctx Ctx, address string, wan bool) *mAgentMockJoin {
-	if mmJoin.mock.funcJoin != nil {
-		mmJoin.mock.t.Fatalf("AgentMock.Join mock is already set by Set")
+// Expect sets up expected params for Agent.DeregisterService
+func (mmDeregisterService *mAgentMockDeregisterService) Expect(ctx Ctx, serviceID string) *mAgentMockDeregisterService {
+	if mmDeregisterService.mock.funcDeregisterService != nil {
+		mmDeregisterService.mock.t.Fatalf("AgentMock.DeregisterService mock is already set by Set")
 	}
 
-	if mmJoin.defaultExpectation == nil {
-		mmJoin.defaultExpectation = &AgentMockJoinExpectation{}
+	if mmDeregisterService.defaultExpectation == nil {
+		mmDeregisterService.defaultExpectation = &AgentMockDeregisterServiceExpectation{}
 	}
 
-	mmJoin.defaultExpectation.params = &AgentMockJoinParams{ctx, address, wan}
-	for _, e := range mmJoin.expectations {
-		if minimock.Equal(e.params, mmJoin.defaultExpectation.params) {
-			mmJoin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmJoin.defaultExpectation.params)
+	mmDeregisterService.defaultExpectation.params = &AgentMockDeregisterServiceParams{ctx, serviceID}
+	for _, e := range mmDeregisterService.expectations {
+		if minimock.Equal(e.params, mmDeregisterService.defaultExpectation.params) {
+			mmDeregisterService.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeregisterService.defaultExpectation.params)
 		}
 	}
 
-	return mmJoin
+	return mmDeregisterService
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.Join
-func (mmJoin *mAgentMockJoin) Inspect(f func(ctx Ctx, address string, wan bool)) *mAgentMockJoin {
-	if mmJoin.mock.inspectFuncJoin != nil {
-		mmJoin.mock.t.Fatalf("Inspect function is already set for AgentMock.Join")
+// Inspect accepts an inspector function that has same arguments as the Agent.DeregisterService
+func (mmDeregisterService *mAgentMockDeregisterService) Inspect(f func(ctx Ctx, serviceID string)) *mAgentMockDeregisterService {
+	if mmDeregisterService.mock.inspectFuncDeregisterService != nil {
+		mmDeregisterService.mock.t.Fatalf("Inspect function is already set for AgentMock.DeregisterService")
 	}
 
-	mmJoin.mock.inspectFuncJoin = f
+	mmDeregisterService.mock.inspectFuncDeregisterService = f
 
-	return mmJoin
+	return mmDeregisterService
 }
 
-// Return sets up results that will be returned by Agent.Join
-func (mmJoin *mAgentMockJoin) Return(err error) *AgentMock {
-	if mmJoin.mock.funcJoin != nil {
-		mmJoin.mock.t.Fatalf("AgentMock.Join mock is already set by Set")
+// Return sets up results that will be returned by Agent.DeregisterService
+func (mmDeregisterService *mAgentMockDeregisterService) Return(err error) *AgentMock {
+	if mmDeregisterService.mock.funcDeregisterService != nil {
+		mmDeregisterService.mock.t.Fatalf("AgentMock.DeregisterService mock is already set by Set")
 	}
 
-	if mmJoin.defaultExpectation == nil {
-		mmJoin.defaultExpectation = &AgentMockJoinExpectation{mock: mmJoin.mock}
+	if mmDeregisterService.defaultExpectation == nil {
+		mmDeregisterService.defaultExpectation = &AgentMockDeregisterServiceExpectation{mock: mmDeregisterService.mock}
 	}
-	mmJoin.defaultExpectation.results = &AgentMockJoinResults{err}
-	return mmJoin.mock
+	mmDeregisterService.defaultExpectation.results = &AgentMockDeregisterServiceResults{err}
+	return mmDeregisterService.mock
 }
 
-//Set uses given function f to mock the Agent.Join method
-func (mmJoin *mAgentMockJoin) Set(f func(ctx Ctx, address string, wan bool) (err error)) *AgentMock {
-	if mmJoin.defaultExpectation != nil {
-		mmJoin.mock.t.Fatalf("Default expectation is already set for the Agent.Join method")
+//Set uses given function f to mock the Agent.DeregisterService method
+func (mmDeregisterService *mAgentMockDeregisterService) Set(f func(ctx Ctx, serviceID string) (err error)) *AgentMock {
+	if mmDeregisterService.defaultExpectation != nil {
+		mmDeregisterService.mock.t.Fatalf("Default expectation is already set for the Agent.DeregisterService method")
 	}
 
-	if len(mmJoin.expectations) > 0 {
-		mmJoin.mock.t.Fatalf("Some expectations are already set for the Agent.Join method")
+	if len(mmDeregisterService.expectations) > 0 {
+		mmDeregisterService.mock.t.Fatalf("Some expectations are already set for the Agent.DeregisterService method")
 	}
 
-	mmJoin.mock.funcJoin = f
-	return mmJoin.mock
+	mmDeregisterService.mock.funcDeregisterService = f
+	return mmDeregisterService.mock
 }
 
-// When sets expectation for the Agent.Join which will trigger the result defined by the following
+// When sets expectation for the Agent.DeregisterService which will trigger the result defined by the following
 // Then helper
-func (mmJoin *mAgentMockJoin) When(ctx Ctx, address string, wan bool) *AgentMockJoinExpectation {
-	if mmJoin.mock.funcJoin != nil {
-		mmJoin.mock.t.Fatalf("AgentMock.Join mock is already set by Set")
+func (mmDeregisterService *mAgentMockDeregisterService) When(ctx Ctx, serviceID string) *AgentMockDeregisterServiceExpectation {
+	if mmDeregisterService.mock.funcDeregisterService != nil {
+		mmDeregisterService.mock.t.Fatalf("AgentMock.DeregisterService mock is already set by Set")
 	}
 
-	expectation := &AgentMockJoinExpectation{
-		mock:   mmJoin.mock,
-		params: &AgentMockJoinParams{ctx, address, wan},
+	expectation := &AgentMockDeregisterServiceExpectation{
+		mock:   mmDeregisterService.mock,
+		params: &AgentMockDeregisterServiceParams{ctx, serviceID},
 	}
-	mmJoin.expectations = append(mmJoin.expectations, expectation)
+	mmDeregisterService.expectations = append(mmDeregisterService.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.Join return parameters for the expectation previously defined by the When method
-func (e *AgentMockJoinExpectation) Then(err error) *AgentMock {
-	e.results = &AgentMockJoinResults{err}
+// Then sets up Agent.DeregisterService return parameters for the expectation previously defined by the When method
+func (e *AgentMockDeregisterServiceExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockDeregisterServiceResults{err}
 	return e.mock
 }
 
-// Join implements Agent
-func (mmJoin *AgentMock) Join(ctx Ctx, address string, wan bool) (err error) {
-	mm_atomic.AddUint64(&mmJoin.beforeJoinCounter, 1)
-	defer mm_atomic.AddUint64(&mmJoin.afterJoinCounter, 1)
+// DeregisterService implements Agent
+func (mmDeregisterService *AgentMock) DeregisterService(ctx Ctx, serviceID string) (err error) {
+	mm_atomic.AddUint64(&mmDeregisterService.beforeDeregisterServiceCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeregisterService.afterDeregisterServiceCounter, 1)
 
-	if mmJoin.inspectFuncJoin != nil {
-		mmJoin.inspectFuncJoin(ctx, address, wan)
+	if mmDeregisterService.inspectFuncDeregisterService != nil {
+		mmDeregisterService.inspectFuncDeregisterService(ctx, serviceID)
 	}
 
-	mm_params := &AgentMockJoinParams{ctx, address, wan}
+	mm_params := &AgentMockDeregisterServiceParams{ctx, serviceID}
 
 	// Record call args
-	mmJoin.JoinMock.mutex.Lock()
-	mmJoin.JoinMock.callArgs = append(mmJoin.JoinMock.callArgs, mm_params)
-	mmJoin.JoinMock.mutex.Unlock()
+	mmDeregisterService.DeregisterServiceMock.mutex.Lock()
+	mmDeregisterService.DeregisterServiceMock.callArgs = append(mmDeregisterService.DeregisterServiceMock.callArgs, mm_params)
+	mmDeregisterService.DeregisterServiceMock.mutex.Unlock()
 
-	for _, e := range mmJoin.JoinMock.expectations {
+	for _, e := range mmDeregisterService.DeregisterServiceMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmJoin.JoinMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmJoin.JoinMock.defaultExpectation.Counter, 1)
-		mm_want := mmJoin.JoinMock.defaultExpectation.params
-		mm_got := AgentMockJoinParams{ctx, address, wan}
+	if mmDeregisterService.DeregisterServiceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeregisterService.DeregisterServiceMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeregisterService.DeregisterServiceMock.defaultExpectation.params
+		mm_got := AgentMockDeregisterServiceParams{ctx, serviceID}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmJoin.t.Errorf("AgentMock.Join got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeregisterService.t.Errorf("AgentMock.DeregisterService got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmJoin.JoinMock.defaultExpectation.results
+		mm_results := mmDeregisterService.DeregisterServiceMock.defaultExpectation.results
 		if mm_results == nil {
-			mmJoin.t.Fatal("No results are set for the AgentMock.Join")
+			mmDeregisterService.t.Fatal("No results are set for the AgentMock.DeregisterService")
 		}
 		return (*mm_results).err
 	}
-	if mmJoin.funcJoin != nil {
-		return mmJoin.funcJoin(ctx, address, wan)
+	if mmDeregisterService.funcDeregisterService != nil {
+		return mmDeregisterService.funcDeregisterService(ctx, serviceID)
 	}
-	mmJoin.t.Fatalf("Unexpected call to AgentMock.Join. %v %v %v", ctx, address, wan)
+	mmDeregisterService.t.Fatalf("Unexpected call to AgentMock.DeregisterService. %v %v", ctx, serviceID)
 	return
 }
 
-// JoinAfterCounter returns a count of finished AgentMock.Join invocations
-func (mmJoin *AgentMock) JoinAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmJoin.afterJoinCounter)
+// DeregisterServiceAfterCounter returns a count of finished AgentMock.DeregisterService invocations
+func (mmDeregisterService *AgentMock) DeregisterServiceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeregisterService.afterDeregisterServiceCounter)
 }
 
-// JoinBeforeCounter returns a count of AgentMock.Join invocations
-func (mmJoin *AgentMock) JoinBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmJoin.beforeJoinCounter)
+// DeregisterServiceBeforeCounter returns a count of AgentMock.DeregisterService invocations
+func (mmDeregisterService *AgentMock) DeregisterServiceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeregisterService.beforeDeregisterServiceCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.Join.
+// Calls returns a list of arguments used in each call to AgentMock.DeregisterService.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmJoin *mAgentMockJoin) Calls() []*AgentMockJoinParams {
-	mmJoin.mutex.RLock()
+func (mmDeregisterService *mAgentMockDeregisterService) Calls() []*AgentMockDeregisterServiceParams {
+	mmDeregisterService.mutex.RLock()
 
-	argCopy := make([]*AgentMockJoinParams, len(mmJoin.callArgs))
-	copy(argCopy, mmJoin.callArgs)
+	argCopy := make([]*AgentMockDeregisterServiceParams, len(mmDeregisterService.callArgs))
+	copy(argCopy, mmDeregisterService.callArgs)
 
-	mmJoin.mutex.RUnlock()
+	mmDeregisterService.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockJoinDone returns true if the count of the Join invocations corresponds
+// MinimockDeregisterServiceDone returns true if the count of the DeregisterService invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockJoinDone() bool {
-	for _, e := range m.JoinMock.expectations {
+func (m *AgentMock) MinimockDeregisterServiceDone() bool {
+	for _, e := range m.DeregisterServiceMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.JoinMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
+	if m.DeregisterServiceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeregisterServiceCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcJoin != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
+	if m.funcDeregisterService != nil && mm_atomic.LoadUint64(&m.afterDeregisterServiceCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockJoinInspect logs each unmet expectation
-func (m *AgentMock) MinimockJoinInspect() {
-	for _, e := range m.JoinMock.expectations {
+// MinimockDeregisterServiceInspect logs each unmet expectation
+func (m *AgentMock) MinimockDeregisterServiceInspect() {
+	for _, e := range m.DeregisterServiceMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.Join with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.DeregisterService with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.JoinMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
-		if m.JoinMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.Join")
+	if m.DeregisterServiceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeregisterServiceCounter) < 1 {
+		if m.DeregisterServiceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.DeregisterService")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.Join with params: %#v", *m.JoinMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.DeregisterService with params: %#v", *m.DeregisterServiceMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcJoin != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.Join")
+	if m.funcDeregisterService != nil && mm_atomic.LoadUint64(&m.afterDeregisterServiceCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.DeregisterService")
 	}
 }
 
-type mAgentMockLeave struct {
+type mAgentMockFailTTL struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockLeaveExpectation
-	expectations       []*AgentMockLeaveExpectation
+	defaultExpectation *AgentMockFailTTLExpectation
+	expectations       []*AgentMockFailTTLExpectation
 
-	callArgs []*AgentMockLeaveParams
+	callArgs []*AgentMockFailTTLParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockLeaveExpectation specifies expectation struct of the Agent.Leave
-type AgentMockLeaveExpectation struct {
+// AgentMockFailTTLExpectation specifies expectation struct of the Agent.FailTTL
+type AgentMockFailTTLExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockLeaveParams
-	results *AgentMockLeaveResults
+	params  *AgentMockFailTTLParams
+	results *AgentMockFailTTLResults
 	Counter uint64
 }
 
-// AgentMockLeaveParams contains parameters of the Agent.Leave
-type AgentMockLeaveParams struct {
-	ctx Ctx
+// AgentMockFailTTLParams contains parameters of the Agent.FailTTL
+type AgentMockFailTTLParams struct {
+	ctx     Ctx
+	checkID string
+	note    string
 }
 
-// AgentMockLeaveResults contains results of the Agent.Leave
-type AgentMockLeaveResults struct {
+// AgentMockFailTTLResults contains results of the Agent.FailTTL
+type AgentMockFailTTLResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.Leave
-func (mmLeave *mAgentMockLeave) Expect(ctx Ctx) *mAgentMockLeave {
-	if mmLeave.mock.funcLeave != nil {
-		mmLeave.mock.t.Fatalf("AgentMock.Leave mock is already set by Set")
+// Expect sets up expected params for Agent.FailTTL
+func (mmFailTTL *mAgentMockFailTTL) Expect(ctx Ctx, checkID string, note string) *mAgentMockFailTTL {
+	if mmFailTTL.mock.funcFailTTL != nil {
+		mmFailTTL.mock.t.Fatalf("AgentMock.FailTTL mock is already set by Set")
 	}
 
-	if mmLeave.defaultExpectation == nil {
-		mmLeave.defaultExpectation = &AgentMockLeaveExpectation{}
+	if mmFailTTL.defaultExpectation == nil {
+		mmFailTTL.defaultExpectation = &AgentMockFailTTLExpectation{}
 	}
 
-	mmLeave.defaultExpectation.params = &AgentMockLeaveParams{ctx}
-	for _, e := range mmLeave.expectations {
-		if minimock.Equal(e.params, mmLeave.defaultExpectation.params) {
-			mmLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeave.defaultExpectation.params)
+	mmFailTTL.defaultExpectation.params = &AgentMockFailTTLParams{ctx, checkID, note}
+	for _, e := range mmFailTTL.expectations {
+		if minimock.Equal(e.params, mmFailTTL.defaultExpectation.params) {
+			mmFailTTL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFailTTL.defaultExpectation.params)
 		}
 	}
 
-	return mmLeave
+	return mmFailTTL
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.Leave
-func (mmLeave *mAgentMockLeave) Inspect(f func(ctx Ctx)) *mAgentMockLeave {
-	if mmLeave.mock.inspectFuncLeave != nil {
-		mmLeave.mock.t.Fatalf("Inspect function is already set for AgentMock.Leave")
+// Inspect accepts an inspector function that has same arguments as the Agent.FailTTL
+func (mmFailTTL *mAgentMockFailTTL) Inspect(f func(ctx Ctx, checkID string, note string)) *mAgentMockFailTTL {
+	if mmFailTTL.mock.inspectFuncFailTTL != nil {
+		mmFailTTL.mock.t.Fatalf("Inspect function is already set for AgentMock.FailTTL")
 	}
 
-	mmLeave.mock.inspectFuncLeave = f
+	mmFailTTL.mock.inspectFuncFailTTL = f
 
-	return mmLeave
+	return mmFailTTL
 }
 
-// Return sets up results that will be returned by Agent.Leave
-func (mmLeave *mAgentMockLeave) Return(err error) *AgentMock {
-	if mmLeave.mock.funcLeave != nil {
-		mmLeave.mock.t.Fatalf("AgentMock.Leave mock is already set by Set")
+// Return sets up results that will be returned by Agent.FailTTL
+func (mmFailTTL *mAgentMockFailTTL) Return(err error) *AgentMock {
+	if mmFailTTL.mock.funcFailTTL != nil {
+		mmFailTTL.mock.t.Fatalf("AgentMock.FailTTL mock is already set by Set")
 	}
 
-	if mmLeave.defaultExpectation == nil {
-		mmLeave.defaultExpectation = &AgentMockLeaveExpectation{mock: mmLeave.mock}
+	if mmFailTTL.defaultExpectation == nil {
+		mmFailTTL.defaultExpectation = &AgentMockFailTTLExpectation{mock: mmFailTTL.mock}
 	}
-	mmLeave.defaultExpectation.results = &AgentMockLeaveResults{err}
-	return mmLeave.mock
+	mmFailTTL.defaultExpectation.results = &AgentMockFailTTLResults{err}
+	return mmFailTTL.mock
 }
 
-//Set uses given function f to mock the Agent.Leave method
-func (mmLeave *mAgentMockLeave) Set(f func(ctx Ctx) (err error)) *AgentMock {
-	if mmLeave.defaultExpectation != nil {
-		mmLeave.mock.t.Fatalf("Default expectation is already set for the Agent.Leave method")
+//Set uses given function f to mock the Agent.FailTTL method
+func (mmFailTTL *mAgentMockFailTTL) Set(f func(ctx Ctx, checkID string, note string) (err error)) *AgentMock {
+	if mmFailTTL.defaultExpectation != nil {
+		mmFailTTL.mock.t.Fatalf("Default expectation is already set for the Agent.FailTTL method")
 	}
 
-	if len(mmLeave.expectations) > 0 {
-		mmLeave.mock.t.Fatalf("Some expectations are already set for the Agent.Leave method")
+	if len(mmFailTTL.expectations) > 0 {
+		mmFailTTL.mock.t.Fatalf("Some expectations are already set for the Agent.FailTTL method")
 	}
 
-	mmLeave.mock.funcLeave = f
-	return mmLeave.mock
+	mmFailTTL.mock.funcFailTTL = f
+	return mmFailTTL.mock
 }
 
-// When sets expectation for the Agent.Leave which will trigger the result defined by the following
+// When sets expectation for the Agent.FailTTL which will trigger the result defined by the following
 // Then helper
-func (mmLeave *mAgentMockLeave) When(ctx Ctx) *AgentMockLeaveExpectation {
-	if mmLeave.mock.funcLeave != nil {
-		mmLeave.mock.t.Fatalf("AgentMock.Leave mock is already set by Set")
+func (mmFailTTL *mAgentMockFailTTL) When(ctx Ctx, checkID string, note string) *AgentMockFailTTLExpectation {
+	if mmFailTTL.mock.funcFailTTL != nil {
+		mmFailTTL.mock.t.Fatalf("AgentMock.FailTTL mock is already set by Set")
 	}
 
-	expectation := &AgentMockLeaveExpectation{
-		mock:   mmLeave.mock,
-		params: &AgentMockLeaveParams{ctx},
+	expectation := &AgentMockFailTTLExpectation{
+		mock:   mmFailTTL.mock,
+		params: &AgentMockFailTTLParams{ctx, checkID, note},
 	}
-	mmLeave.expectations = append(mmLeave.expectations, expectation)
+	mmFailTTL.expectations = append(mmFailTTL.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.Leave return parameters for the expectation previously defined by the When method
-func (e *AgentMockLeaveExpectation) Then(err error) *AgentMock {
-	e.results = &AgentMockLeaveResults{err}
+// Then sets up Agent.FailTTL return parameters for the expectation previously defined by the When method
+func (e *AgentMockFailTTLExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockFailTTLResults{err}
 	return e.mock
 }
 
-// Leave implements Agent
-func (mmLeave *AgentMock) Leave(ctx Ctx) (err error) {
-	mm_atomic.AddUint64(&mmLeave.beforeLeaveCounter, 1)
-	defer mm_atomic.AddUint64(&mmLeave.afterLeaveCounter, 1)
+// FailTTL implements Agent
+func (mmFailTTL *AgentMock) FailTTL(ctx Ctx, checkID string, note string) (err error) {
+	mm_atomic.AddUint64(&mmFailTTL.beforeFailTTLCounter, 1)
+	defer mm_atomic.AddUint64(&mmFailTTL.afterFailTTLCounter, 1)
 
-	if mmLeave.inspectFuncLeave != nil {
-		mmLeave.inspectFuncLeave(ctx)
+	if mmFailTTL.inspectFuncFailTTL != nil {
+		mmFailTTL.inspectFuncFailTTL(ctx, checkID, note)
 	}
 
-	mm_params := &AgentMockLeaveParams{ctx}
+	mm_params := &AgentMockFailTTLParams{ctx, checkID, note}
 
 	// Record call args
-	mmLeave.LeaveMock.mutex.Lock()
-	mmLeave.LeaveMock.callArgs = append(mmLeave.LeaveMock.callArgs, mm_params)
-	mmLeave.LeaveMock.mutex.Unlock()
+	mmFailTTL.FailTTLMock.mutex.Lock()
+	mmFailTTL.FailTTLMock.callArgs = append(mmFailTTL.FailTTLMock.callArgs, mm_params)
+	mmFailTTL.FailTTLMock.mutex.Unlock()
 
-	for _, e := range mmLeave.LeaveMock.expectations {
+	for _, e := range mmFailTTL.FailTTLMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmLeave.LeaveMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLeave.LeaveMock.defaultExpectation.Counter, 1)
-		mm_want := mmLeave.LeaveMock.defaultExpectation.params
-		mm_got := AgentMockLeaveParams{ctx}
+	if mmFailTTL.FailTTLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFailTTL.FailTTLMock.defaultExpectation.Counter, 1)
+		mm_want := mmFailTTL.FailTTLMock.defaultExpectation.params
+		mm_got := AgentMockFailTTLParams{ctx, checkID, note}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLeave.t.Errorf("AgentMock.Leave got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmFailTTL.t.Errorf("AgentMock.FailTTL got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLeave.LeaveMock.defaultExpectation.results
+		mm_results := mmFailTTL.FailTTLMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLeave.t.Fatal("No results are set for the AgentMock.Leave")
+			mmFailTTL.t.Fatal("No results are set for the AgentMock.FailTTL")
 		}
 		return (*mm_results).err
 	}
-	if mmLeave.funcLeave != nil {
-		return mmLeave.funcLeave(ctx)
+	if mmFailTTL.funcFailTTL != nil {
+		return mmFailTTL.funcFailTTL(ctx, checkID, note)
 	}
-	mmLeave.t.Fatalf("Unexpected call to AgentMock.Leave. %v", ctx)
+	mmFailTTL.t.Fatalf("Unexpected call to AgentMock.FailTTL. %v %v %v", ctx, checkID, note)
 	return
 }
 
-// LeaveAfterCounter returns a count of finished AgentMock.Leave invocations
-func (mmLeave *AgentMock) LeaveAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLeave.afterLeaveCounter)
+// FailTTLAfterCounter returns a count of finished AgentMock.FailTTL invocations
+func (mmFailTTL *AgentMock) FailTTLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFailTTL.afterFailTTLCounter)
 }
 
-// LeaveBeforeCounter returns a count of AgentMock.Leave invocations
-func (mmLeave *AgentMock) LeaveBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLeave.beforeLeaveCounter)
+// FailTTLBeforeCounter returns a count of AgentMock.FailTTL invocations
+func (mmFailTTL *AgentMock) FailTTLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFailTTL.beforeFailTTLCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.Leave.
+// Calls returns a list of arguments used in each call to AgentMock.FailTTL.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLeave *mAgentMockLeave) Calls() []*AgentMockLeaveParams {
-	mmLeave.mutex.RLock()
+func (mmFailTTL *mAgentMockFailTTL) Calls() []*AgentMockFailTTLParams {
+	mmFailTTL.mutex.RLock()
 
-	argCopy := make([]*AgentMockLeaveParams, len(mmLeave.callArgs))
-	copy(argCopy, mmLeave.callArgs)
+	argCopy := make([]*AgentMockFailTTLParams, len(mmFailTTL.callArgs))
+	copy(argCopy, mmFailTTL.callArgs)
 
-	mmLeave.mutex.RUnlock()
+	mmFailTTL.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLeaveDone returns true if the count of the Leave invocations corresponds
+// MinimockFailTTLDone returns true if the count of the FailTTL invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockLeaveDone() bool {
-	for _, e := range m.LeaveMock.expectations {
+func (m *AgentMock) MinimockFailTTLDone() bool {
+	for _, e := range m.FailTTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
+	if m.FailTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFailTTLCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLeave != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
+	if m.funcFailTTL != nil && mm_atomic.LoadUint64(&m.afterFailTTLCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockLeaveInspect logs each unmet expectation
-func (m *AgentMock) MinimockLeaveInspect() {
-	for _, e := range m.LeaveMock.expectations {
+// MinimockFailTTLInspect logs each unmet expectation
+func (m *AgentMock) MinimockFailTTLInspect() {
+	for _, e := range m.FailTTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.Leave with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.FailTTL with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
-		if m.LeaveMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.Leave")
+	if m.FailTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterFailTTLCounter) < 1 {
+		if m.FailTTLMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.FailTTL")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.Leave with params: %#v", *m.LeaveMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.FailTTL with params: %#v", *m.FailTTLMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLeave != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.Leave")
+	if m.funcFailTTL != nil && mm_atomic.LoadUint64(&m.afterFailTTLCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.FailTTL")
 	}
 }
 
-type mAgentMockMaintenanceMode struct {
+type mAgentMockForceLeave struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockMaintenanceModeExpectation
-	expectations       []*AgentMockMaintenanceModeExpectation
+	defaultExpectation *AgentMockForceLeaveExpectation
+	expectations       []*AgentMockForceLeaveExpectation
 
-	callArgs []*AgentMockMaintenanceModeParams
+	callArgs []*AgentMockForceLeaveParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockMaintenanceModeExpectation specifies expectation struct of the Agent.MaintenanceMode
-type AgentMockMaintenanceModeExpectation struct {
+// AgentMockForceLeaveExpectation specifies expectation struct of the Agent.ForceLeave
+type AgentMockForceLeaveExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockMaintenanceModeParams
-	results *AgentMockMaintenanceModeResults
+	params  *AgentMockForceLeaveParams
+	results *AgentMockForceLeaveResults
 	Counter uint64
 }
 
-// AgentMockMaintenanceModeParams contains parameters of the Agent.MaintenanceMode
-type AgentMockMaintenanceModeParams struct {
-	ctx     Ctx
-	enabled bool
-	reason  string
+// AgentMockForceLeaveParams contains parameters of the Agent.ForceLeave
+type AgentMockForceLeaveParams struct {
+	ctx  Ctx
+	node string
 }
 
-// AgentMockMaintenanceModeResults contains results of the Agent.MaintenanceMode
-type AgentMockMaintenanceModeResults struct {
+// AgentMockForceLeaveResults contains results of the Agent.ForceLeave
+type AgentMockForceLeaveResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.MaintenanceMode
-func (mmMaintenanceMode *mAgentMockMaintenanceMode) Expect(ctx Ctx, enabled bool, reason string) *mAgentMockMaintenanceMode {
-	if mmMaintenanceMode.mock.funcMaintenanceMode != nil {
-		mmMaintenanceMode.mock.t.Fatalf("AgentMock.MaintenanceMode mock is already set by Set")
+// Expect sets up expected params for Agent.ForceLeave
+func (mmForceLeave *mAgentMockForceLeave) Expect(ctx Ctx, node string) *mAgentMockForceLeave {
+	if mmForceLeave.mock.funcForceLeave != nil {
+		mmForceLeave.mock.t.Fatalf("AgentMock.ForceLeave mock is already set by Set")
 	}
 
-	if mmMaintenanceMode.defaultExpectation == nil {
-		mmMaintenanceMode.defaultExpectation = &AgentMockMaintenanceModeExpectation{}
+	if mmForceLeave.defaultExpectation == nil {
+		mmForceLeave.defaultExpectation = &AgentMockForceLeaveExpectation{}
 	}
 
-	mmMaintenanceMode.defaultExpectation.params = &AgentMockMaintenanceModeParams{ctx, enabled, reason}
-	for _, e := range mmMaintenanceMode.expectations {
-		if minimock.Equal(e.params, mmMaintenanceMode.defaultExpectation.params) {
-			mmMaintenanceMode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMaintenanceMode.defaultExpectation.params)
+	mmForceLeave.defaultExpectation.params = &AgentMockForceLeaveParams{ctx, node}
+	for _, e := range mmForceLeave.expectations {
+		if minimock.Equal(e.params, mmForceLeave.defaultExpectation.params) {
+			mmForceLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmForceLeave.defaultExpectation.params)
 		}
 	}
 
-	return mmMaintenanceMode
+	return mmForceLeave
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.MaintenanceMode
-func (mmMaintenanceMode *mAgentMockMaintenanceMode) Inspect(f func(ctx Ctx, enabled bool, reason string)) *mAgentMockMaintenanceMode {
-	if mmMaintenanceMode.mock.inspectFuncMaintenanceMode != nil {
-		mmMaintenanceMode.mock.t.Fatalf("Inspect function is already set for AgentMock.MaintenanceMode")
+// Inspect accepts an inspector function that has same arguments as the Agent.ForceLeave
+func (mmForceLeave *mAgentMockForceLeave) Inspect(f func(ctx Ctx, node string)) *mAgentMockForceLeave {
+	if mmForceLeave.mock.inspectFuncForceLeave != nil {
+		mmForceLeave.mock.t.Fatalf("Inspect function is already set for AgentMock.ForceLeave")
 	}
 
-	mmMaintenanceMode.mock.inspectFuncMaintenanceMode = f
+	mmForceLeave.mock.inspectFuncForceLeave = f
 
-	return mmMaintenanceMode
+	return mmForceLeave
 }
 
-// Return sets up results that will be returned by Agent.MaintenanceMode
-func (mmMaintenanceMode *mAgentMockMaintenanceMode) Return(err error) *AgentMock {
-	if mmMaintenanceMode.mock.funcMaintenanceMode != nil {
-		mmMaintenanceMode.mock.t.Fatalf("AgentMock.MaintenanceMode mock is already set by Set")
+// Return sets up results that will be returned by Agent.ForceLeave
+func (mmForceLeave *mAgentMockForceLeave) Return(err error) *AgentMock {
+	if mmForceLeave.mock.funcForceLeave != nil {
+		mmForceLeave.mock.t.Fatalf("AgentMock.ForceLeave mock is already set by Set")
 	}
 
-	if mmMaintenanceMode.defaultExpectation == nil {
-		mmMaintenanceMode.defaultExpectation = &AgentMockMaintenanceModeExpectation{mock: mmMaintenanceMode.mock}
+	if mmForceLeave.defaultExpectation == nil {
+		mmForceLeave.defaultExpectation = &AgentMockForceLeaveExpectation{mock: mmForceLeave.mock}
 	}
-	mmMaintenanceMode.defaultExpectation.results = &AgentMockMaintenanceModeResults{err}
-	return mmMaintenanceMode.mock
+	mmForceLeave.defaultExpectation.results = &AgentMockForceLeaveResults{err}
+	return mmForceLeave.mock
 }
 
-//Set uses given function f to mock the Agent.MaintenanceMode method
-func (mmMaintenanceMode *mAgentMockMaintenanceMode) Set(f func(ctx Ctx, enabled bool, reason string) (err error)) *AgentMock {
-	if mmMaintenanceMode.defaultExpectation != nil {
-		mmMaintenanceMode.mock.t.Fatalf("Default expectation is already set for the Agent.MaintenanceMode method")
+//Set uses given function f to mock the Agent.ForceLeave method
+func (mmForceLeave *mAgentMockForceLeave) Set(f func(ctx Ctx, node string) (err error)) *AgentMock {
+	if mmForceLeave.defaultExpectation != nil {
+		mmForceLeave.mock.t.Fatalf("Default expectation is already set for the Agent.ForceLeave method")
 	}
 
-	if len(mmMaintenanceMode.expectations) > 0 {
-		mmMaintenanceMode.mock.t.Fatalf("Some expectations are already set for the Agent.MaintenanceMode method")
+	if len(mmForceLeave.expectations) > 0 {
+		mmForceLeave.mock.t.Fatalf("Some expectations are already set for the Agent.ForceLeave method")
 	}
 
-	mmMaintenanceMode.mock.funcMaintenanceMode = f
-	return mmMaintenanceMode.mock
+	mmForceLeave.mock.funcForceLeave = f
+	return mmForceLeave.mock
 }
 
-// When sets expectation for the Agent.MaintenanceMode which will trigger the result defined by the following
+// When sets expectation for the Agent.ForceLeave which will trigger the result defined by the following
 // Then helper
-func (mmMaintenanceMode *mAgentMockMaintenanceMode) When(ctx Ctx, enabled bool, reason string) *AgentMockMaintenanceModeExpectation {
-	if mmMaintenanceMode.mock.funcMaintenanceMode != nil {
-		mmMaintenanceMode.mock.t.Fatalf("AgentMock.MaintenanceMode mock is already set by Set")
+func (mmForceLeave *mAgentMockForceLeave) When(ctx Ctx, node string) *AgentMockForceLeaveExpectation {
+	if mmForceLeave.mock.funcForceLeave != nil {
+		mmForceLeave.mock.t.Fatalf("AgentMock.ForceLeave mock is already set by Set")
 	}
 
-	expectation := &AgentMockMaintenanceModeExpectation{
-		mock:   mmMaintenanceMode.mock,
-		params: &AgentMockMaintenanceModeParams{ctx, enabled, reason},
+	expectation := &AgentMockForceLeaveExpectation{
+		mock:   mmForceLeave.mock,
+		params: &AgentMockForceLeaveParams{ctx, node},
 	}
-	mmMaintenanceMode.expectations = append(mmMaintenanceMode.expectations, expectation)
+	mmForceLeave.expectations = append(mmForceLeave.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.MaintenanceMode return parameters for the expectation previously defined by the When method
-func (e *AgentMockMaintenanceModeExpectation) Then(err error) *AgentMock {
-	e.results = &AgentMockMaintenanceModeResults{err}
+// Then sets up Agent.ForceLeave return parameters for the expectation previously defined by the When method
+func (e *AgentMockForceLeaveExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockForceLeaveResults{err}
 	return e.mock
 }
 
-// MaintenanceMode implements Agent
-func (mmMaintenanceMode *AgentMock) MaintenanceMode(ctx Ctx, enabled bool, reason string) (err error) {
-	mm_atomic.AddUint64(&mmMaintenanceMode.beforeMaintenanceModeCounter, 1)
-	defer mm_atomic.AddUint64(&mmMaintenanceMode.afterMaintenanceModeCounter, 1)
+// ForceLeave implements Agent
+func (mmForceLeave *AgentMock) ForceLeave(ctx Ctx, node string) (err error) {
+	mm_atomic.AddUint64(&mmForceLeave.beforeForceLeaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmForceLeave.afterForceLeaveCounter, 1)
 
-	if mmMaintenanceMode.inspectFuncMaintenanceMode != nil {
-		mmMaintenanceMode.inspectFuncMaintenanceMode(ctx, enabled, reason)
+	if mmForceLeave.inspectFuncForceLeave != nil {
+		mmForceLeave.inspectFuncForceLeave(ctx, node)
 	}
 
-	mm_params := &AgentMockMaintenanceModeParams{ctx, enabled, reason}
+	mm_params := &AgentMockForceLeaveParams{ctx, node}
 
 	// Record call args
-	mmMaintenanceMode.MaintenanceModeMock.mutex.Lock()
-	mmMaintenanceMode.MaintenanceModeMock.callArgs = append(mmMaintenanceMode.MaintenanceModeMock.callArgs, mm_params)
-	mmMaintenanceMode.MaintenanceModeMock.mutex.Unlock()
+	mmForceLeave.ForceLeaveMock.mutex.Lock()
+	mmForceLeave.ForceLeaveMock.callArgs = append(mmForceLeave.ForceLeaveMock.callArgs, mm_params)
+	mmForceLeave.ForceLeaveMock.mutex.Unlock()
 
-	for _, e := range mmMaintenanceMode.MaintenanceModeMock.expectations {
+	for _, e := range mmForceLeave.ForceLeaveMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmMaintenanceMode.MaintenanceModeMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmMaintenanceMode.MaintenanceModeMock.defaultExpectation.Counter, 1)
-		mm_want := mmMaintenanceMode.MaintenanceModeMock.defaultExpectation.params
-		mm_got := AgentMockMaintenanceModeParams{ctx, enabled, reason}
+	if mmForceLeave.ForceLeaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmForceLeave.ForceLeaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmForceLeave.ForceLeaveMock.defaultExpectation.params
+		mm_got := AgentMockForceLeaveParams{ctx, node}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmMaintenanceMode.t.Errorf("AgentMock.MaintenanceMode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmForceLeave.t.Errorf("AgentMock.ForceLeave got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmMaintenanceMode.MaintenanceModeMock.defaultExpectation.results
+		mm_results := mmForceLeave.ForceLeaveMock.defaultExpectation.results
 		if mm_results == nil {
-			mmMaintenanceMode.t.Fatal("No results are set for the AgentMock.MaintenanceMode")
+			mmForceLeave.t.Fatal("No results are set for the AgentMock.ForceLeave")
 		}
 		return (*mm_results).err
 	}
-	if mmMaintenanceMode.funcMaintenanceMode != nil {
-		return mmMaintenanceMode.funcMaintenanceMode(ctx, enabled, reason)
+	if mmForceLeave.funcForceLeave != nil {
+		return mmForceLeave.funcForceLeave(ctx, node)
 	}
-	mmMaintenanceMode.t.Fatalf("Unexpected call to AgentMock.MaintenanceMode. %v %v %v", ctx, enabled, reason)
+	mmForceLeave.t.Fatalf("Unexpected call to AgentMock.ForceLeave. %v %v", ctx, node)
 	return
 }
 
-// MaintenanceModeAfterCounter returns a count of finished AgentMock.MaintenanceMode invocations
-func (mmMaintenanceMode *AgentMock) MaintenanceModeAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMaintenanceMode.afterMaintenanceModeCounter)
+// ForceLeaveAfterCounter returns a count of finished AgentMock.ForceLeave invocations
+func (mmForceLeave *AgentMock) ForceLeaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForceLeave.afterForceLeaveCounter)
 }
 
-// MaintenanceModeBeforeCounter returns a count of AgentMock.MaintenanceMode invocations
-func (mmMaintenanceMode *AgentMock) MaintenanceModeBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMaintenanceMode.beforeMaintenanceModeCounter)
+// ForceLeaveBeforeCounter returns a count of AgentMock.ForceLeave invocations
+func (mmForceLeave *AgentMock) ForceLeaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForceLeave.beforeForceLeaveCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.MaintenanceMode.
+// Calls returns a list of arguments used in each call to AgentMock.ForceLeave.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmMaintenanceMode *mAgentMockMaintenanceMode) Calls() []*AgentMockMaintenanceModeParams {
-	mmMaintenanceMode.mutex.RLock()
-
-	argCopy := make([]*AgentMockMaintenanceModeParams, len(mmMaintenanceMode.callArgs))
-	copy(argCopy, mmMaintenanceMode.callArgs)
+func (mmForceLeave *mAgentMockForceLeave) Calls() []*AgentMockForceLeaveParams {
+	mmForceLeave.mutex.RLock()
 
-	mmMaintenanceMode.mutex.RUnlock()
+	argCopy := make([]*AgentMockForceLeaveParams, len(mmForceLeave.callArgs))
+	copy(argCopy, mmForceLeave.callArgs)
+
+	mmForceLeave.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockMaintenanceModeDone returns true if the count of the MaintenanceMode invocations corresponds
+// MinimockForceLeaveDone returns true if the count of the ForceLeave invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockMaintenanceModeDone() bool {
-	for _, e := range m.MaintenanceModeMock.expectations {
+func (m *AgentMock) MinimockForceLeaveDone() bool {
+	for _, e := range m.ForceLeaveMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MaintenanceModeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
+	if m.ForceLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMaintenanceMode != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
+	if m.funcForceLeave != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockMaintenanceModeInspect logs each unmet expectation
-func (m *AgentMock) MinimockMaintenanceModeInspect() {
-	for _, e := range m.MaintenanceModeMock.expectations {
+// MinimockForceLeaveInspect logs each unmet expectation
+func (m *AgentMock) MinimockForceLeaveInspect() {
+	for _, e := range m.ForceLeaveMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.MaintenanceMode with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.ForceLeave with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MaintenanceModeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
-		if m.MaintenanceModeMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.MaintenanceMode")
+	if m.ForceLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
+		if m.ForceLeaveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.ForceLeave")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.MaintenanceMode with params: %#v", *m.MaintenanceModeMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.ForceLeave with params: %#v", *m.ForceLeaveMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMaintenanceMode != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.MaintenanceMode")
+	if m.funcForceLeave != nil && mm_atomic.LoadUint64(&m.afterForceLeaveCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.ForceLeave")
 	}
 }
 
-type mAgentMockMembers struct {
+type mAgentMockJoin struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockMembersExpectation
-	expectations       []*AgentMockMembersExpectation
+	defaultExpectation *AgentMockJoinExpectation
+	expectations       []*AgentMockJoinExpectation
 
-	callArgs []*AgentMockMembersParams
+	callArgs []*AgentMockJoinParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockMembersExpectation specifies expectation struct of the Agent.Members
-type AgentMockMembersExpectation struct {
+// AgentMockJoinExpectation specifies expectation struct of the Agent.Join
+type AgentMockJoinExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockMembersParams
-	results *AgentMockMembersResults
+	params  *AgentMockJoinParams
+	results *AgentMockJoinResults
 	Counter uint64
 }
 
-// AgentMockMembersParams contains parameters of the Agent.Members
-type AgentMockMembersParams struct {
-	ctx Ctx
-	wan bool
+// AgentMockJoinParams contains parameters of the Agent.Join
+type AgentMockJoinParams struct {
+	ctx     Ctx
+	address string
+	wan     bool
 }
 
-// AgentMockMembersResults contains results of the Agent.Members
-type AgentMockMembersResults struct {
-	aa1 []AgentInfo
+// AgentMockJoinResults contains results of the Agent.Join
+type AgentMockJoinResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.Members
-func (mmMembers *mAgentMockMembers) Expect(ctx Ctx, wan bool) *mAgentMockMembers {
-	if mmMembers.mock.funcMembers != nil {
-		mmMembers.mock.t.Fatalf("AgentMock.Members mock is already set by Set")
+// Expect sets up expected params for Agent.Join
+func (mmJoin *mAgentMockJoin) Expect(ctx Ctx, address string, wan bool) *mAgentMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("AgentMock.Join mock is already set by Set")
 	}
 
-	if mmMembers.defaultExpectation == nil {
-		mmMembers.defaultExpectation = &AgentMockMembersExpectation{}
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &AgentMockJoinExpectation{}
 	}
 
-	mmMembers.defaultExpectation.params = &AgentMockMembersParams{ctx, wan}
-	for _, e := range mmMembers.expectations {
-		if minimock.Equal(e.params, mmMembers.defaultExpectation.params) {
-			mmMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMembers.defaultExpectation.params)
+	mmJoin.defaultExpectation.params = &AgentMockJoinParams{ctx, address, wan}
+	for _, e := range mmJoin.expectations {
+		if minimock.Equal(e.params, mmJoin.defaultExpectation.params) {
+			mmJoin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmJoin.defaultExpectation.params)
 		}
 	}
 
-	return mmMembers
+	return mmJoin
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.Members
-func (mmMembers *mAgentMockMembers) Inspect(f func(ctx Ctx, wan bool)) *mAgentMockMembers {
-	if mmMembers.mock.inspectFuncMembers != nil {
-		mmMembers.mock.t.Fatalf("Inspect function is already set for AgentMock.Members")
+// Inspect accepts an inspector function that has same arguments as the Agent.Join
+func (mmJoin *mAgentMockJoin) Inspect(f func(ctx Ctx, address string, wan bool)) *mAgentMockJoin {
+	if mmJoin.mock.inspectFuncJoin != nil {
+		mmJoin.mock.t.Fatalf("Inspect function is already set for AgentMock.Join")
 	}
 
-	mmMembers.mock.inspectFuncMembers = f
+	mmJoin.mock.inspectFuncJoin = f
 
-	return mmMembers
+	return mmJoin
 }
 
-// Return sets up results that will be returned by Agent.Members
-func (mmMembers *mAgentMockMembers) Return(aa1 []AgentInfo, err error) *AgentMock {
-	if mmMembers.mock.funcMembers != nil {
-		mmMembers.mock.t.Fatalf("AgentMock.Members mock is already set by Set")
+// Return sets up results that will be returned by Agent.Join
+func (mmJoin *mAgentMockJoin) Return(err error) *AgentMock {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("AgentMock.Join mock is already set by Set")
 	}
 
-	if mmMembers.defaultExpectation == nil {
-		mmMembers.defaultExpectation = &AgentMockMembersExpectation{mock: mmMembers.mock}
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &AgentMockJoinExpectation{mock: mmJoin.mock}
 	}
-	mmMembers.defaultExpectation.results = &AgentMockMembersResults{aa1, err}
-	return mmMembers.mock
+	mmJoin.defaultExpectation.results = &AgentMockJoinResults{err}
+	return mmJoin.mock
 }
 
-//Set uses given function f to mock the Agent.Members method
-func (mmMembers *mAgentMockMembers) Set(f func(ctx Ctx, wan bool) (aa1 []AgentInfo, err error)) *AgentMock {
-	if mmMembers.defaultExpectation != nil {
-		mmMembers.mock.t.Fatalf("Default expectation is already set for the Agent.Members method")
+//Set uses given function f to mock the Agent.Join method
+func (mmJoin *mAgentMockJoin) Set(f func(ctx Ctx, address string, wan bool) (err error)) *AgentMock {
+	if mmJoin.defaultExpectation != nil {
+		mmJoin.mock.t.Fatalf("Default expectation is already set for the Agent.Join method")
 	}
 
-	if len(mmMembers.expectations) > 0 {
-		mmMembers.mock.t.Fatalf("Some expectations are already set for the Agent.Members method")
+	if len(mmJoin.expectations) > 0 {
+		mmJoin.mock.t.Fatalf("Some expectations are already set for the Agent.Join method")
 	}
 
-	mmMembers.mock.funcMembers = f
-	return mmMembers.mock
+	mmJoin.mock.funcJoin = f
+	return mmJoin.mock
 }
 
-// When sets expectation for the Agent.Members which will trigger the result defined by the following
+// When sets expectation for the Agent.Join which will trigger the result defined by the following
 // Then helper
-func (mmMembers *mAgentMockMembers) When(ctx Ctx, wan bool) *AgentMockMembersExpectation {
-	if mmMembers.mock.funcMembers != nil {
-		mmMembers.mock.t.Fatalf("AgentMock.Members mock is already set by Set")
+func (mmJoin *mAgentMockJoin) When(ctx Ctx, address string, wan bool) *AgentMockJoinExpectation {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("AgentMock.Join mock is already set by Set")
 	}
 
-	expectation := &AgentMockMembersExpectation{
-		mock:   mmMembers.mock,
-		params: &AgentMockMembersParams{ctx, wan},
+	expectation := &AgentMockJoinExpectation{
+		mock:   mmJoin.mock,
+		params: &AgentMockJoinParams{ctx, address, wan},
 	}
-	mmMembers.expectations = append(mmMembers.expectations, expectation)
+	mmJoin.expectations = append(mmJoin.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.Members return parameters for the expectation previously defined by the When method
-func (e *AgentMockMembersExpectation) Then(aa1 []AgentInfo, err error) *AgentMock {
-	e.results = &AgentMockMembersResults{aa1, err}
+// Then sets up Agent.Join return parameters for the expectation previously defined by the When method
+func (e *AgentMockJoinExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockJoinResults{err}
 	return e.mock
 }
 
-// Members implements Agent
-func (mmMembers *AgentMock) Members(ctx Ctx, wan bool) (aa1 []AgentInfo, err error) {
-	mm_atomic.AddUint64(&mmMembers.beforeMembersCounter, 1)
-	defer mm_atomic.AddUint64(&mmMembers.afterMembersCounter, 1)
+// Join implements Agent
+func (mmJoin *AgentMock) Join(ctx Ctx, address string, wan bool) (err error) {
+	mm_atomic.AddUint64(&mmJoin.beforeJoinCounter, 1)
+	defer mm_atomic.AddUint64(&mmJoin.afterJoinCounter, 1)
 
-	if mmMembers.inspectFuncMembers != nil {
-		mmMembers.inspectFuncMembers(ctx, wan)
+	if mmJoin.inspectFuncJoin != nil {
+		mmJoin.inspectFuncJoin(ctx, address, wan)
 	}
 
-	mm_params := &AgentMockMembersParams{ctx, wan}
+	mm_params := &AgentMockJoinParams{ctx, address, wan}
 
 	// Record call args
-	mmMembers.MembersMock.mutex.Lock()
-	mmMembers.MembersMock.callArgs = append(mmMembers.MembersMock.callArgs, mm_params)
-	mmMembers.MembersMock.mutex.Unlock()
+	mmJoin.JoinMock.mutex.Lock()
+	mmJoin.JoinMock.callArgs = append(mmJoin.JoinMock.callArgs, mm_params)
+	mmJoin.JoinMock.mutex.Unlock()
 
-	for _, e := range mmMembers.MembersMock.expectations {
+	for _, e := range mmJoin.JoinMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.aa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmMembers.MembersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmMembers.MembersMock.defaultExpectation.Counter, 1)
-		mm_want := mmMembers.MembersMock.defaultExpectation.params
-		mm_got := AgentMockMembersParams{ctx, wan}
+	if mmJoin.JoinMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmJoin.JoinMock.defaultExpectation.Counter, 1)
+		mm_want := mmJoin.JoinMock.defaultExpectation.params
+		mm_got := AgentMockJoinParams{ctx, address, wan}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmMembers.t.Errorf("AgentMock.Members got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmJoin.t.Errorf("AgentMock.Join got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmMembers.MembersMock.defaultExpectation.results
+		mm_results := mmJoin.JoinMock.defaultExpectation.results
 		if mm_results == nil {
-			mmMembers.t.Fatal("No results are set for the AgentMock.Members")
+			mmJoin.t.Fatal("No results are set for the AgentMock.Join")
 		}
-		return (*mm_results).aa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmMembers.funcMembers != nil {
-		return mmMembers.funcMembers(ctx, wan)
+	if mmJoin.funcJoin != nil {
+		return mmJoin.funcJoin(ctx, address, wan)
 	}
-	mmMembers.t.Fatalf("Unexpected call to AgentMock.Members. %v %v", ctx, wan)
+	mmJoin.t.Fatalf("Unexpected call to AgentMock.Join. %v %v %v", ctx, address, wan)
 	return
 }
 
-// MembersAfterCounter returns a count of finished AgentMock.Members invocations
-func (mmMembers *AgentMock) MembersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMembers.afterMembersCounter)
+// JoinAfterCounter returns a count of finished AgentMock.Join invocations
+func (mmJoin *AgentMock) JoinAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoin.afterJoinCounter)
 }
 
-// MembersBeforeCounter returns a count of AgentMock.Members invocations
-func (mmMembers *AgentMock) MembersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMembers.beforeMembersCounter)
+// JoinBeforeCounter returns a count of AgentMock.Join invocations
+func (mmJoin *AgentMock) JoinBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoin.beforeJoinCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.Members.
+// Calls returns a list of arguments used in each call to AgentMock.Join.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmMembers *mAgentMockMembers) Calls() []*AgentMockMembersParams {
-	mmMembers.mutex.RLock()
+func (mmJoin *mAgentMockJoin) Calls() []*AgentMockJoinParams {
+	mmJoin.mutex.RLock()
 
-	argCopy := make([]*AgentMockMembersParams, len(mmMembers.callArgs))
-	copy(argCopy, mmMembers.callArgs)
+	argCopy := make([]*AgentMockJoinParams, len(mmJoin.callArgs))
+	copy(argCopy, mmJoin.callArgs)
 
-	mmMembers.mutex.RUnlock()
+	mmJoin.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockMembersDone returns true if the count of the Members invocations corresponds
+// MinimockJoinDone returns true if the count of the Join invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockMembersDone() bool {
-	for _, e := range m.MembersMock.expectations {
+func (m *AgentMock) MinimockJoinDone() bool {
+	for _, e := range m.JoinMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MembersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
+	if m.JoinMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMembers != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
+	if m.funcJoin != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockMembersInspect logs each unmet expectation
-func (m *AgentMock) MinimockMembersInspect() {
-	for _, e := range m.MembersMock.expectations {
+// MinimockJoinInspect logs each unmet expectation
+func (m *AgentMock) MinimockJoinInspect() {
+	for _, e := range m.JoinMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.Members with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.Join with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MembersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
-		if m.MembersMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.Members")
+	if m.JoinMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
+		if m.JoinMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.Join")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.Members with params: %#v", *m.MembersMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.Join with params: %#v", *m.JoinMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMembers != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.Members")
-	}
+	if m.funcJoin != nil && mm_atomic.LoadUint64(&m.afterJoinCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.Join")
+	}
+}
+
+type mAgentMockLeave struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockLeaveExpectation
+	expectations       []*AgentMockLeaveExpectation
+
+	callArgs []*AgentMockLeaveParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockLeaveExpectation specifies expectation struct of the Agent.Leave
+type AgentMockLeaveExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockLeaveParams
+	results *AgentMockLeaveResults
+	Counter uint64
+}
+
+// AgentMockLeaveParams contains parameters of the Agent.Leave
+type AgentMockLeaveParams struct {
+	ctx Ctx
+}
+
+// AgentMockLeaveResults contains results of the Agent.Leave
+type AgentMockLeaveResults struct {
+	err error
+}
+
+// Expect sets up expected params for Agent.Leave
+func (mmLeave *mAgentMockLeave) Expect(ctx Ctx) *mAgentMockLeave {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("AgentMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &AgentMockLeaveExpectation{}
+	}
+
+	mmLeave.defaultExpectation.params = &AgentMockLeaveParams{ctx}
+	for _, e := range mmLeave.expectations {
+		if minimock.Equal(e.params, mmLeave.defaultExpectation.params) {
+			mmLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeave.defaultExpectation.params)
+		}
+	}
+
+	return mmLeave
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.Leave
+func (mmLeave *mAgentMockLeave) Inspect(f func(ctx Ctx)) *mAgentMockLeave {
+	if mmLeave.mock.inspectFuncLeave != nil {
+		mmLeave.mock.t.Fatalf("Inspect function is already set for AgentMock.Leave")
+	}
+
+	mmLeave.mock.inspectFuncLeave = f
+
+	return mmLeave
+}
+
+// Return sets up results that will be returned by Agent.Leave
+func (mmLeave *mAgentMockLeave) Return(err error) *AgentMock {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("AgentMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &AgentMockLeaveExpectation{mock: mmLeave.mock}
+	}
+	mmLeave.defaultExpectation.results = &AgentMockLeaveResults{err}
+	return mmLeave.mock
+}
+
+//Set uses given function f to mock the Agent.Leave method
+func (mmLeave *mAgentMockLeave) Set(f func(ctx Ctx) (err error)) *AgentMock {
+	if mmLeave.defaultExpectation != nil {
+		mmLeave.mock.t.Fatalf("Default expectation is already set for the Agent.Leave method")
+	}
+
+	if len(mmLeave.expectations) > 0 {
+		mmLeave.mock.t.Fatalf("Some expectations are already set for the Agent.Leave method")
+	}
+
+	mmLeave.mock.funcLeave = f
+	return mmLeave.mock
+}
+
+// When sets expectation for the Agent.Leave which will trigger the result defined by the following
+// Then helper
+func (mmLeave *mAgentMockLeave) When(ctx Ctx) *AgentMockLeaveExpectation {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("AgentMock.Leave mock is already set by Set")
+	}
+
+	expectation := &AgentMockLeaveExpectation{
+		mock:   mmLeave.mock,
+		params: &AgentMockLeaveParams{ctx},
+	}
+	mmLeave.expectations = append(mmLeave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.Leave return parameters for the expectation previously defined by the When method
+func (e *AgentMockLeaveExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockLeaveResults{err}
+	return e.mock
+}
+
+// Leave implements Agent
+func (mmLeave *AgentMock) Leave(ctx Ctx) (err error) {
+	mm_atomic.AddUint64(&mmLeave.beforeLeaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeave.afterLeaveCounter, 1)
+
+	if mmLeave.inspectFuncLeave != nil {
+		mmLeave.inspectFuncLeave(ctx)
+	}
+
+	mm_params := &AgentMockLeaveParams{ctx}
+
+	// Record call args
+	mmLeave.LeaveMock.mutex.Lock()
+	mmLeave.LeaveMock.callArgs = append(mmLeave.LeaveMock.callArgs, mm_params)
+	mmLeave.LeaveMock.mutex.Unlock()
+
+	for _, e := range mmLeave.LeaveMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLeave.LeaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeave.LeaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeave.LeaveMock.defaultExpectation.params
+		mm_got := AgentMockLeaveParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeave.t.Errorf("AgentMock.Leave got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLeave.LeaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeave.t.Fatal("No results are set for the AgentMock.Leave")
+		}
+		return (*mm_results).err
+	}
+	if mmLeave.funcLeave != nil {
+		return mmLeave.funcLeave(ctx)
+	}
+	mmLeave.t.Fatalf("Unexpected call to AgentMock.Leave. %v", ctx)
+	return
+}
+
+// LeaveAfterCounter returns a count of finished AgentMock.Leave invocations
+func (mmLeave *AgentMock) LeaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeave.afterLeaveCounter)
+}
+
+// LeaveBeforeCounter returns a count of AgentMock.Leave invocations
+func (mmLeave *AgentMock) LeaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeave.beforeLeaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.Leave.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeave *mAgentMockLeave) Calls() []*AgentMockLeaveParams {
+	mmLeave.mutex.RLock()
+
+	argCopy := make([]*AgentMockLeaveParams, len(mmLeave.callArgs))
+	copy(argCopy, mmLeave.callArgs)
+
+	mmLeave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveDone returns true if the count of the Leave invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockLeaveDone() bool {
+	for _, e := range m.LeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeave != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLeaveInspect logs each unmet expectation
+func (m *AgentMock) MinimockLeaveInspect() {
+	for _, e := range m.LeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.Leave with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
+		if m.LeaveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.Leave")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.Leave with params: %#v", *m.LeaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeave != nil && mm_atomic.LoadUint64(&m.afterLeaveCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.Leave")
+	}
+}
+
+type mAgentMockMaintenanceMode struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockMaintenanceModeExpectation
+	expectations       []*AgentMockMaintenanceModeExpectation
+
+	callArgs []*AgentMockMaintenanceModeParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockMaintenanceModeExpectation specifies expectation struct of the Agent.MaintenanceMode
+type AgentMockMaintenanceModeExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockMaintenanceModeParams
+	results *AgentMockMaintenanceModeResults
+	Counter uint64
+}
+
+// AgentMockMaintenanceModeParams contains parameters of the Agent.MaintenanceMode
+type AgentMockMaintenanceModeParams struct {
+	ctx     Ctx
+	enabled bool
+	reason  string
+}
+
+// AgentMockMaintenanceModeResults contains results of the Agent.MaintenanceMode
+type AgentMockMaintenanceModeResults struct {
+	err error
+}
+
+// Expect sets up expected params for Agent.MaintenanceMode
+func (mmMaintenanceMode *mAgentMockMaintenanceMode) Expect(ctx Ctx, enabled bool, reason string) *mAgentMockMaintenanceMode {
+	if mmMaintenanceMode.mock.funcMaintenanceMode != nil {
+		mmMaintenanceMode.mock.t.Fatalf("AgentMock.MaintenanceMode mock is already set by Set")
+	}
+
+	if mmMaintenanceMode.defaultExpectation == nil {
+		mmMaintenanceMode.defaultExpectation = &AgentMockMaintenanceModeExpectation{}
+	}
+
+	mmMaintenanceMode.defaultExpectation.params = &AgentMockMaintenanceModeParams{ctx, enabled, reason}
+	for _, e := range mmMaintenanceMode.expectations {
+		if minimock.Equal(e.params, mmMaintenanceMode.defaultExpectation.params) {
+			mmMaintenanceMode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMaintenanceMode.defaultExpectation.params)
+		}
+	}
+
+	return mmMaintenanceMode
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.MaintenanceMode
+func (mmMaintenanceMode *mAgentMockMaintenanceMode) Inspect(f func(ctx Ctx, enabled bool, reason string)) *mAgentMockMaintenanceMode {
+	if mmMaintenanceMode.mock.inspectFuncMaintenanceMode != nil {
+		mmMaintenanceMode.mock.t.Fatalf("Inspect function is already set for AgentMock.MaintenanceMode")
+	}
+
+	mmMaintenanceMode.mock.inspectFuncMaintenanceMode = f
+
+	return mmMaintenanceMode
+}
+
+// Return sets up results that will be returned by Agent.MaintenanceMode
+func (mmMaintenanceMode *mAgentMockMaintenanceMode) Return(err error) *AgentMock {
+	if mmMaintenanceMode.mock.funcMaintenanceMode != nil {
+		mmMaintenanceMode.mock.t.Fatalf("AgentMock.MaintenanceMode mock is already set by Set")
+	}
+
+	if mmMaintenanceMode.defaultExpectation == nil {
+		mmMaintenanceMode.defaultExpectation = &AgentMockMaintenanceModeExpectation{mock: mmMaintenanceMode.mock}
+	}
+	mmMaintenanceMode.defaultExpectation.results = &AgentMockMaintenanceModeResults{err}
+	return mmMaintenanceMode.mock
+}
+
+//Set uses given function f to mock the Agent.MaintenanceMode method
+func (mmMaintenanceMode *mAgentMockMaintenanceMode) Set(f func(ctx Ctx, enabled bool, reason string) (err error)) *AgentMock {
+	if mmMaintenanceMode.defaultExpectation != nil {
+		mmMaintenanceMode.mock.t.Fatalf("Default expectation is already set for the Agent.MaintenanceMode method")
+	}
+
+	if len(mmMaintenanceMode.expectations) > 0 {
+		mmMaintenanceMode.mock.t.Fatalf("Some expectations are already set for the Agent.MaintenanceMode method")
+	}
+
+	mmMaintenanceMode.mock.funcMaintenanceMode = f
+	return mmMaintenanceMode.mock
+}
+
+// When sets expectation for the Agent.MaintenanceMode which will trigger the result defined by the following
+// Then helper
+func (mmMaintenanceMode *mAgentMockMaintenanceMode) When(ctx Ctx, enabled bool, reason string) *AgentMockMaintenanceModeExpectation {
+	if mmMaintenanceMode.mock.funcMaintenanceMode != nil {
+		mmMaintenanceMode.mock.t.Fatalf("AgentMock.MaintenanceMode mock is already set by Set")
+	}
+
+	expectation := &AgentMockMaintenanceModeExpectation{
+		mock:   mmMaintenanceMode.mock,
+		params: &AgentMockMaintenanceModeParams{ctx, enabled, reason},
+	}
+	mmMaintenanceMode.expectations = append(mmMaintenanceMode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.MaintenanceMode return parameters for the expectation previously defined by the When method
+func (e *AgentMockMaintenanceModeExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockMaintenanceModeResults{err}
+	return e.mock
+}
+
+// MaintenanceMode implements Agent
+func (mmMaintenanceMode *AgentMock) MaintenanceMode(ctx Ctx, enabled bool, reason string) (err error) {
+	mm_atomic.AddUint64(&mmMaintenanceMode.beforeMaintenanceModeCounter, 1)
+	defer mm_atomic.AddUint64(&mmMaintenanceMode.afterMaintenanceModeCounter, 1)
+
+	if mmMaintenanceMode.inspectFuncMaintenanceMode != nil {
+		mmMaintenanceMode.inspectFuncMaintenanceMode(ctx, enabled, reason)
+	}
+
+	mm_params := &AgentMockMaintenanceModeParams{ctx, enabled, reason}
+
+	// Record call args
+	mmMaintenanceMode.MaintenanceModeMock.mutex.Lock()
+	mmMaintenanceMode.MaintenanceModeMock.callArgs = append(mmMaintenanceMode.MaintenanceModeMock.callArgs, mm_params)
+	mmMaintenanceMode.MaintenanceModeMock.mutex.Unlock()
+
+	for _, e := range mmMaintenanceMode.MaintenanceModeMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMaintenanceMode.MaintenanceModeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMaintenanceMode.MaintenanceModeMock.defaultExpectation.Counter, 1)
+		mm_want := mmMaintenanceMode.MaintenanceModeMock.defaultExpectation.params
+		mm_got := AgentMockMaintenanceModeParams{ctx, enabled, reason}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMaintenanceMode.t.Errorf("AgentMock.MaintenanceMode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMaintenanceMode.MaintenanceModeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMaintenanceMode.t.Fatal("No results are set for the AgentMock.MaintenanceMode")
+		}
+		return (*mm_results).err
+	}
+	if mmMaintenanceMode.funcMaintenanceMode != nil {
+		return mmMaintenanceMode.funcMaintenanceMode(ctx, enabled, reason)
+	}
+	mmMaintenanceMode.t.Fatalf("Unexpected call to AgentMock.MaintenanceMode. %v %v %v", ctx, enabled, reason)
+	return
+}
+
+// MaintenanceModeAfterCounter returns a count of finished AgentMock.MaintenanceMode invocations
+func (mmMaintenanceMode *AgentMock) MaintenanceModeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMaintenanceMode.afterMaintenanceModeCounter)
+}
+
+// MaintenanceModeBeforeCounter returns a count of AgentMock.MaintenanceMode invocations
+func (mmMaintenanceMode *AgentMock) MaintenanceModeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMaintenanceMode.beforeMaintenanceModeCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.MaintenanceMode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMaintenanceMode *mAgentMockMaintenanceMode) Calls() []*AgentMockMaintenanceModeParams {
+	mmMaintenanceMode.mutex.RLock()
+
+	argCopy := make([]*AgentMockMaintenanceModeParams, len(mmMaintenanceMode.callArgs))
+	copy(argCopy, mmMaintenanceMode.callArgs)
+
+	mmMaintenanceMode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMaintenanceModeDone returns true if the count of the MaintenanceMode invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockMaintenanceModeDone() bool {
+	for _, e := range m.MaintenanceModeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MaintenanceModeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMaintenanceMode != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMaintenanceModeInspect logs each unmet expectation
+func (m *AgentMock) MinimockMaintenanceModeInspect() {
+	for _, e := range m.MaintenanceModeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.MaintenanceMode with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MaintenanceModeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
+		if m.MaintenanceModeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.MaintenanceMode")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.MaintenanceMode with params: %#v", *m.MaintenanceModeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMaintenanceMode != nil && mm_atomic.LoadUint64(&m.afterMaintenanceModeCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.MaintenanceMode")
+	}
+}
+
+type mAgentMockMembers struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockMembersExpectation
+	expectations       []*AgentMockMembersExpectation
+
+	callArgs []*AgentMockMembersParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockMembersExpectation specifies expectation struct of the Agent.Members
+type AgentMockMembersExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockMembersParams
+	results *AgentMockMembersResults
+	Counter uint64
+}
+
+// AgentMockMembersParams contains parameters of the Agent.Members
+type AgentMockMembersParams struct {
+	ctx Ctx
+	wan bool
+}
+
+// AgentMockMembersResults contains results of the Agent.Members
+type AgentMockMembersResults struct {
+	aa1 []AgentInfo
+	err error
+}
+
+// Expect sets up expected params for Agent.Members
+func (mmMembers *mAgentMockMembers) Expect(ctx Ctx, wan bool) *mAgentMockMembers {
+	if mmMembers.mock.funcMembers != nil {
+		mmMembers.mock.t.Fatalf("AgentMock.Members mock is already set by Set")
+	}
+
+	if mmMembers.defaultExpectation == nil {
+		mmMembers.defaultExpectation = &AgentMockMembersExpectation{}
+	}
+
+	mmMembers.defaultExpectation.params = &AgentMockMembersParams{ctx, wan}
+	for _, e := range mmMembers.expectations {
+		if minimock.Equal(e.params, mmMembers.defaultExpectation.params) {
+			mmMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.Members
+func (mmMembers *mAgentMockMembers) Inspect(f func(ctx Ctx, wan bool)) *mAgentMockMembers {
+	if mmMembers.mock.inspectFuncMembers != nil {
+		mmMembers.mock.t.Fatalf("Inspect function is already set for AgentMock.Members")
+	}
+
+	mmMembers.mock.inspectFuncMembers = f
+
+	return mmMembers
+}
+
+// Return sets up results that will be returned by Agent.Members
+func (mmMembers *mAgentMockMembers) Return(aa1 []AgentInfo, err error) *AgentMock {
+	if mmMembers.mock.funcMembers != nil {
+		mmMembers.mock.t.Fatalf("AgentMock.Members mock is already set by Set")
+	}
+
+	if mmMembers.defaultExpectation == nil {
+		mmMembers.defaultExpectation = &AgentMockMembersExpectation{mock: mmMembers.mock}
+	}
+	mmMembers.defaultExpectation.results = &AgentMockMembersResults{aa1, err}
+	return mmMembers.mock
+}
+
+//Set uses given function f to mock the Agent.Members method
+func (mmMembers *mAgentMockMembers) Set(f func(ctx Ctx, wan bool) (aa1 []AgentInfo, err error)) *AgentMock {
+	if mmMembers.defaultExpectation != nil {
+		mmMembers.mock.t.Fatalf("Default expectation is already set for the Agent.Members method")
+	}
+
+	if len(mmMembers.expectations) > 0 {
+		mmMembers.mock.t.Fatalf("Some expectations are already set for the Agent.Members method")
+	}
+
+	mmMembers.mock.funcMembers = f
+	return mmMembers.mock
+}
+
+// When sets expectation for the Agent.Members which will trigger the result defined by the following
+// Then helper
+func (mmMembers *mAgentMockMembers) When(ctx Ctx, wan bool) *AgentMockMembersExpectation {
+	if mmMembers.mock.funcMembers != nil {
+		mmMembers.mock.t.Fatalf("AgentMock.Members mock is already set by Set")
+	}
+
+	expectation := &AgentMockMembersExpectation{
+		mock:   mmMembers.mock,
+		params: &AgentMockMembersParams{ctx, wan},
+	}
+	mmMembers.expectations = append(mmMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.Members return parameters for the expectation previously defined by the When method
+func (e *AgentMockMembersExpectation) Then(aa1 []AgentInfo, err error) *AgentMock {
+	e.results = &AgentMockMembersResults{aa1, err}
+	return e.mock
+}
+
+// Members implements Agent
+func (mmMembers *AgentMock) Members(ctx Ctx, wan bool) (aa1 []AgentInfo, err error) {
+	mm_atomic.AddUint64(&mmMembers.beforeMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmMembers.afterMembersCounter, 1)
+
+	if mmMembers.inspectFuncMembers != nil {
+		mmMembers.inspectFuncMembers(ctx, wan)
+	}
+
+	mm_params := &AgentMockMembersParams{ctx, wan}
+
+	// Record call args
+	mmMembers.MembersMock.mutex.Lock()
+	mmMembers.MembersMock.callArgs = append(mmMembers.MembersMock.callArgs, mm_params)
+	mmMembers.MembersMock.mutex.Unlock()
+
+	for _, e := range mmMembers.MembersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmMembers.MembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMembers.MembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmMembers.MembersMock.defaultExpectation.params
+		mm_got := AgentMockMembersParams{ctx, wan}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMembers.t.Errorf("AgentMock.Members got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMembers.MembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMembers.t.Fatal("No results are set for the AgentMock.Members")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmMembers.funcMembers != nil {
+		return mmMembers.funcMembers(ctx, wan)
+	}
+	mmMembers.t.Fatalf("Unexpected call to AgentMock.Members. %v %v", ctx, wan)
+	return
+}
+
+// MembersAfterCounter returns a count of finished AgentMock.Members invocations
+func (mmMembers *AgentMock) MembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMembers.afterMembersCounter)
+}
+
+// MembersBeforeCounter returns a count of AgentMock.Members invocations
+func (mmMembers *AgentMock) MembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMembers.beforeMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.Members.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMembers *mAgentMockMembers) Calls() []*AgentMockMembersParams {
+	mmMembers.mutex.RLock()
+
+	argCopy := make([]*AgentMockMembersParams, len(mmMembers.callArgs))
+	copy(argCopy, mmMembers.callArgs)
+
+	mmMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMembersDone returns true if the count of the Members invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockMembersDone() bool {
+	for _, e := range m.MembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MembersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMembers != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMembersInspect logs each unmet expectation
+func (m *AgentMock) MinimockMembersInspect() {
+	for _, e := range m.MembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.Members with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MembersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
+		if m.MembersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.Members")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.Members with params: %#v", *m.MembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMembers != nil && mm_atomic.LoadUint64(&m.afterMembersCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.Members")
+	}
+}
+
+type mAgentMockMetrics struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockMetricsExpectation
+	expectations       []*AgentMockMetricsExpectation
+
+	callArgs []*AgentMockMetricsParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockMetricsExpectation specifies expectation struct of the Agent.Metrics
+type AgentMockMetricsExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockMetricsParams
+	results *AgentMockMetricsResults
+	Counter uint64
+}
+
+// AgentMockMetricsParams contains parameters of the Agent.Metrics
+type AgentMockMetricsParams struct {
+	ctx Ctx
+}
+
+// AgentMockMetricsResults contains results of the Agent.Metrics
+type AgentMockMetricsResults struct {
+	m1  Metrics
+	err error
+}
+
+// Expect sets up expected params for Agent.Metrics
+func (mmMetrics *mAgentMockMetrics) Expect(ctx Ctx) *mAgentMockMetrics {
+	if mmMetrics.mock.funcMetrics != nil {
+		mmMetrics.mock.t.Fatalf("AgentMock.Metrics mock is already set by Set")
+	}
+
+	if mmMetrics.defaultExpectation == nil {
+		mmMetrics.defaultExpectation = &AgentMockMetricsExpectation{}
+	}
+
+	mmMetrics.defaultExpectation.params = &AgentMockMetricsParams{ctx}
+	for _, e := range mmMetrics.expectations {
+		if minimock.Equal(e.params, mmMetrics.defaultExpectation.params) {
+			mmMetrics.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMetrics.defaultExpectation.params)
+		}
+	}
+
+	return mmMetrics
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.Metrics
+func (mmMetrics *mAgentMockMetrics) Inspect(f func(ctx Ctx)) *mAgentMockMetrics {
+	if mmMetrics.mock.inspectFuncMetrics != nil {
+		mmMetrics.mock.t.Fatalf("Inspect function is already set for AgentMock.Metrics")
+	}
+
+	mmMetrics.mock.inspectFuncMetrics = f
+
+	return mmMetrics
+}
+
+// Return sets up results that will be returned by Agent.Metrics
+func (mmMetrics *mAgentMockMetrics) Return(m1 Metrics, err error) *AgentMock {
+	if mmMetrics.mock.funcMetrics != nil {
+		mmMetrics.mock.t.Fatalf("AgentMock.Metrics mock is already set by Set")
+	}
+
+	if mmMetrics.defaultExpectation == nil {
+		mmMetrics.defaultExpectation = &AgentMockMetricsExpectation{mock: mmMetrics.mock}
+	}
+	mmMetrics.defaultExpectation.results = &AgentMockMetricsResults{m1, err}
+	return mmMetrics.mock
+}
+
+//Set uses given function f to mock the Agent.Metrics method
+func (mmMetrics *mAgentMockMetrics) Set(f func(ctx Ctx) (m1 Metrics, err error)) *AgentMock {
+	if mmMetrics.defaultExpectation != nil {
+		mmMetrics.mock.t.Fatalf("Default expectation is already set for the Agent.Metrics method")
+	}
+
+	if len(mmMetrics.expectations) > 0 {
+		mmMetrics.mock.t.Fatalf("Some expectations are already set for the Agent.Metrics method")
+	}
+
+	mmMetrics.mock.funcMetrics = f
+	return mmMetrics.mock
+}
+
+// When sets expectation for the Agent.Metrics which will trigger the result defined by the following
+// Then helper
+func (mmMetrics *mAgentMockMetrics) When(ctx Ctx) *AgentMockMetricsExpectation {
+	if mmMetrics.mock.funcMetrics != nil {
+		mmMetrics.mock.t.Fatalf("AgentMock.Metrics mock is already set by Set")
+	}
+
+	expectation := &AgentMockMetricsExpectation{
+		mock:   mmMetrics.mock,
+		params: &AgentMockMetricsParams{ctx},
+	}
+	mmMetrics.expectations = append(mmMetrics.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.Metrics return parameters for the expectation previously defined by the When method
+func (e *AgentMockMetricsExpectation) Then(m1 Metrics, err error) *AgentMock {
+	e.results = &AgentMockMetricsResults{m1, err}
+	return e.mock
+}
+
+// Metrics implements Agent
+func (mmMetrics *AgentMock) Metrics(ctx Ctx) (m1 Metrics, err error) {
+	mm_atomic.AddUint64(&mmMetrics.beforeMetricsCounter, 1)
+	defer mm_atomic.AddUint64(&mmMetrics.afterMetricsCounter, 1)
+
+	if mmMetrics.inspectFuncMetrics != nil {
+		mmMetrics.inspectFuncMetrics(ctx)
+	}
+
+	mm_params := &AgentMockMetricsParams{ctx}
+
+	// Record call args
+	mmMetrics.MetricsMock.mutex.Lock()
+	mmMetrics.MetricsMock.callArgs = append(mmMetrics.MetricsMock.callArgs, mm_params)
+	mmMetrics.MetricsMock.mutex.Unlock()
+
+	for _, e := range mmMetrics.MetricsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmMetrics.MetricsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMetrics.MetricsMock.defaultExpectation.Counter, 1)
+		mm_want := mmMetrics.MetricsMock.defaultExpectation.params
+		mm_got := AgentMockMetricsParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMetrics.t.Errorf("AgentMock.Metrics got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMetrics.MetricsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMetrics.t.Fatal("No results are set for the AgentMock.Metrics")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmMetrics.funcMetrics != nil {
+		return mmMetrics.funcMetrics(ctx)
+	}
+	mmMetrics.t.Fatalf("Unexpected call to AgentMock.Metrics. %v", ctx)
+	return
+}
+
+// MetricsAfterCounter returns a count of finished AgentMock.Metrics invocations
+func (mmMetrics *AgentMock) MetricsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMetrics.afterMetricsCounter)
+}
+
+// MetricsBeforeCounter returns a count of AgentMock.Metrics invocations
+func (mmMetrics *AgentMock) MetricsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMetrics.beforeMetricsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.Metrics.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMetrics *mAgentMockMetrics) Calls() []*AgentMockMetricsParams {
+	mmMetrics.mutex.RLock()
+
+	argCopy := make([]*AgentMockMetricsParams, len(mmMetrics.callArgs))
+	copy(argCopy, mmMetrics.callArgs)
+
+	mmMetrics.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMetricsDone returns true if the count of the Metrics invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockMetricsDone() bool {
+	for _, e := range m.MetricsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MetricsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMetrics != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMetricsInspect logs each unmet expectation
+func (m *AgentMock) MinimockMetricsInspect() {
+	for _, e := range m.MetricsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.Metrics with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MetricsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
+		if m.MetricsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.Metrics")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.Metrics with params: %#v", *m.MetricsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMetrics != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.Metrics")
+	}
+}
+
+type mAgentMockPassTTL struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockPassTTLExpectation
+	expectations       []*AgentMockPassTTLExpectation
+
+	callArgs []*AgentMockPassTTLParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockPassTTLExpectation specifies expectation struct of the Agent.PassTTL
+type AgentMockPassTTLExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockPassTTLParams
+	results *AgentMockPassTTLResults
+	Counter uint64
+}
+
+// AgentMockPassTTLParams contains parameters of the Agent.PassTTL
+type AgentMockPassTTLParams struct {
+	ctx     Ctx
+	checkID string
+	note    string
+}
+
+// AgentMockPassTTLResults contains results of the Agent.PassTTL
+type AgentMockPassTTLResults struct {
+	err error
+}
+
+// Expect sets up expected params for Agent.PassTTL
+func (mmPassTTL *mAgentMockPassTTL) Expect(ctx Ctx, checkID string, note string) *mAgentMockPassTTL {
+	if mmPassTTL.mock.funcPassTTL != nil {
+		mmPassTTL.mock.t.Fatalf("AgentMock.PassTTL mock is already set by Set")
+	}
+
+	if mmPassTTL.defaultExpectation == nil {
+		mmPassTTL.defaultExpectation = &AgentMockPassTTLExpectation{}
+	}
+
+	mmPassTTL.defaultExpectation.params = &AgentMockPassTTLParams{ctx, checkID, note}
+	for _, e := range mmPassTTL.expectations {
+		if minimock.Equal(e.params, mmPassTTL.defaultExpectation.params) {
+			mmPassTTL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPassTTL.defaultExpectation.params)
+		}
+	}
+
+	return mmPassTTL
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.PassTTL
+func (mmPassTTL *mAgentMockPassTTL) Inspect(f func(ctx Ctx, checkID string, note string)) *mAgentMockPassTTL {
+	if mmPassTTL.mock.inspectFuncPassTTL != nil {
+		mmPassTTL.mock.t.Fatalf("Inspect function is already set for AgentMock.PassTTL")
+	}
+
+	mmPassTTL.mock.inspectFuncPassTTL = f
+
+	return mmPassTTL
+}
+
+// Return sets up results that will be returned by Agent.PassTTL
+func (mmPassTTL *mAgentMockPassTTL) Return(err error) *AgentMock {
+	if mmPassTTL.mock.funcPassTTL != nil {
+		mmPassTTL.mock.t.Fatalf("AgentMock.PassTTL mock is already set by Set")
+	}
+
+	if mmPassTTL.defaultExpectation == nil {
+		mmPassTTL.defaultExpectation = &AgentMockPassTTLExpectation{mock: mmPassTTL.mock}
+	}
+	mmPassTTL.defaultExpectation.results = &AgentMockPassTTLResults{err}
+	return mmPassTTL.mock
+}
+
+//Set uses given function f to mock the Agent.PassTTL method
+func (mmPassTTL *mAgentMockPassTTL) Set(f func(ctx Ctx, checkID string, note string) (err error)) *AgentMock {
+	if mmPassTTL.defaultExpectation != nil {
+		mmPassTTL.mock.t.Fatalf("Default expectation is already set for the Agent.PassTTL method")
+	}
+
+	if len(mmPassTTL.expectations) > 0 {
+		mmPassTTL.mock.t.Fatalf("Some expectations are already set for the Agent.PassTTL method")
+	}
+
+	mmPassTTL.mock.funcPassTTL = f
+	return mmPassTTL.mock
+}
+
+// When sets expectation for the Agent.PassTTL which will trigger the result defined by the following
+// Then helper
+func (mmPassTTL *mAgentMockPassTTL) When(ctx Ctx, checkID string, note string) *AgentMockPassTTLExpectation {
+	if mmPassTTL.mock.funcPassTTL != nil {
+		mmPassTTL.mock.t.Fatalf("AgentMock.PassTTL mock is already set by Set")
+	}
+
+	expectation := &AgentMockPassTTLExpectation{
+		mock:   mmPassTTL.mock,
+		params: &AgentMockPassTTLParams{ctx, checkID, note},
+	}
+	mmPassTTL.expectations = append(mmPassTTL.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.PassTTL return parameters for the expectation previously defined by the When method
+func (e *AgentMockPassTTLExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockPassTTLResults{err}
+	return e.mock
+}
+
+// PassTTL implements Agent
+func (mmPassTTL *AgentMock) PassTTL(ctx Ctx, checkID string, note string) (err error) {
+	mm_atomic.AddUint64(&mmPassTTL.beforePassTTLCounter, 1)
+	defer mm_atomic.AddUint64(&mmPassTTL.afterPassTTLCounter, 1)
+
+	if mmPassTTL.inspectFuncPassTTL != nil {
+		mmPassTTL.inspectFuncPassTTL(ctx, checkID, note)
+	}
+
+	mm_params := &AgentMockPassTTLParams{ctx, checkID, note}
+
+	// Record call args
+	mmPassTTL.PassTTLMock.mutex.Lock()
+	mmPassTTL.PassTTLMock.callArgs = append(mmPassTTL.PassTTLMock.callArgs, mm_params)
+	mmPassTTL.PassTTLMock.mutex.Unlock()
+
+	for _, e := range mmPassTTL.PassTTLMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPassTTL.PassTTLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPassTTL.PassTTLMock.defaultExpectation.Counter, 1)
+		mm_want := mmPassTTL.PassTTLMock.defaultExpectation.params
+		mm_got := AgentMockPassTTLParams{ctx, checkID, note}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPassTTL.t.Errorf("AgentMock.PassTTL got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPassTTL.PassTTLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPassTTL.t.Fatal("No results are set for the AgentMock.PassTTL")
+		}
+		return (*mm_results).err
+	}
+	if mmPassTTL.funcPassTTL != nil {
+		return mmPassTTL.funcPassTTL(ctx, checkID, note)
+	}
+	mmPassTTL.t.Fatalf("Unexpected call to AgentMock.PassTTL. %v %v %v", ctx, checkID, note)
+	return
+}
+
+// PassTTLAfterCounter returns a count of finished AgentMock.PassTTL invocations
+func (mmPassTTL *AgentMock) PassTTLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPassTTL.afterPassTTLCounter)
+}
+
+// PassTTLBeforeCounter returns a count of AgentMock.PassTTL invocations
+func (mmPassTTL *AgentMock) PassTTLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPassTTL.beforePassTTLCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.PassTTL.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPassTTL *mAgentMockPassTTL) Calls() []*AgentMockPassTTLParams {
+	mmPassTTL.mutex.RLock()
+
+	argCopy := make([]*AgentMockPassTTLParams, len(mmPassTTL.callArgs))
+	copy(argCopy, mmPassTTL.callArgs)
+
+	mmPassTTL.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPassTTLDone returns true if the count of the PassTTL invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockPassTTLDone() bool {
+	for _, e := range m.PassTTLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PassTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPassTTLCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPassTTL != nil && mm_atomic.LoadUint64(&m.afterPassTTLCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPassTTLInspect logs each unmet expectation
+func (m *AgentMock) MinimockPassTTLInspect() {
+	for _, e := range m.PassTTLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.PassTTL with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PassTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPassTTLCounter) < 1 {
+		if m.PassTTLMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.PassTTL")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.PassTTL with params: %#v", *m.PassTTLMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPassTTL != nil && mm_atomic.LoadUint64(&m.afterPassTTLCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.PassTTL")
+	}
+}
+
+type mAgentMockRegisterCheck struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockRegisterCheckExpectation
+	expectations       []*AgentMockRegisterCheckExpectation
+
+	callArgs []*AgentMockRegisterCheckParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockRegisterCheckExpectation specifies expectation struct of the Agent.RegisterCheck
+type AgentMockRegisterCheckExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockRegisterCheckParams
+	results *AgentMockRegisterCheckResults
+	Counter uint64
+}
+
+// AgentMockRegisterCheckParams contains parameters of the Agent.RegisterCheck
+type AgentMockRegisterCheckParams struct {
+	ctx   Ctx
+	check AgentCheckRegistration
+}
+
+// AgentMockRegisterCheckResults contains results of the Agent.RegisterCheck
+type AgentMockRegisterCheckResults struct {
+	err error
+}
+
+// Expect sets up expected params for Agent.RegisterCheck
+func (mmRegisterCheck *mAgentMockRegisterCheck) Expect(ctx Ctx, check AgentCheckRegistration) *mAgentMockRegisterCheck {
+	if mmRegisterCheck.mock.funcRegisterCheck != nil {
+		mmRegisterCheck.mock.t.Fatalf("AgentMock.RegisterCheck mock is already set by Set")
+	}
+
+	if mmRegisterCheck.defaultExpectation == nil {
+		mmRegisterCheck.defaultExpectation = &AgentMockRegisterCheckExpectation{}
+	}
+
+	mmRegisterCheck.defaultExpectation.params = &AgentMockRegisterCheckParams{ctx, check}
+	for _, e := range mmRegisterCheck.expectations {
+		if minimock.Equal(e.params, mmRegisterCheck.defaultExpectation.params) {
+			mmRegisterCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.RegisterCheck
+func (mmRegisterCheck *mAgentMockRegisterCheck) Inspect(f func(ctx Ctx, check AgentCheckRegistration)) *mAgentMockRegisterCheck {
+	if mmRegisterCheck.mock.inspectFuncRegisterCheck != nil {
+		mmRegisterCheck.mock.t.Fatalf("Inspect function is already set for AgentMock.RegisterCheck")
+	}
+
+	mmRegisterCheck.mock.inspectFuncRegisterCheck = f
+
+	return mmRegisterCheck
+}
+
+// Return sets up results that will be returned by Agent.RegisterCheck
+func (mmRegisterCheck *mAgentMockRegisterCheck) Return(err error) *AgentMock {
+	if mmRegisterCheck.mock.funcRegisterCheck != nil {
+		mmRegisterCheck.mock.t.Fatalf("AgentMock.RegisterCheck mock is already set by Set")
+	}
+
+	if mmRegisterCheck.defaultExpectation == nil {
+		mmRegisterCheck.defaultExpectation = &AgentMockRegisterCheckExpectation{mock: mmRegisterCheck.mock}
+	}
+	mmRegisterCheck.defaultExpectation.results = &AgentMockRegisterCheckResults{err}
+	return mmRegisterCheck.mock
+}
+
+//Set uses given function f to mock the Agent.RegisterCheck method
+func (mmRegisterCheck *mAgentMockRegisterCheck) Set(f func(ctx Ctx, check AgentCheckRegistration) (err error)) *AgentMock {
+	if mmRegisterCheck.defaultExpectation != nil {
+		mmRegisterCheck.mock.t.Fatalf("Default expectation is already set for the Agent.RegisterCheck method")
+	}
+
+	if len(mmRegisterCheck.expectations) > 0 {
+		mmRegisterCheck.mock.t.Fatalf("Some expectations are already set for the Agent.RegisterCheck method")
+	}
+
+	mmRegisterCheck.mock.funcRegisterCheck = f
+	return mmRegisterCheck.mock
+}
+
+// When sets expectation for the Agent.RegisterCheck which will trigger the result defined by the following
+// Then helper
+func (mmRegisterCheck *mAgentMockRegisterCheck) When(ctx Ctx, check AgentCheckRegistration) *AgentMockRegisterCheckExpectation {
+	if mmRegisterCheck.mock.funcRegisterCheck != nil {
+		mmRegisterCheck.mock.t.Fatalf("AgentMock.RegisterCheck mock is already set by Set")
+	}
+
+	expectation := &AgentMockRegisterCheckExpectation{
+		mock:   mmRegisterCheck.mock,
+		params: &AgentMockRegisterCheckParams{ctx, check},
+	}
+	mmRegisterCheck.expectations = append(mmRegisterCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.RegisterCheck return parameters for the expectation previously defined by the When method
+func (e *AgentMockRegisterCheckExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockRegisterCheckResults{err}
+	return e.mock
+}
+
+// RegisterCheck implements Agent
+func (mmRegisterCheck *AgentMock) RegisterCheck(ctx Ctx, check AgentCheckRegistration) (err error) {
+	mm_atomic.AddUint64(&mmRegisterCheck.beforeRegisterCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterCheck.afterRegisterCheckCounter, 1)
+
+	if mmRegisterCheck.inspectFuncRegisterCheck != nil {
+		mmRegisterCheck.inspectFuncRegisterCheck(ctx, check)
+	}
+
+	mm_params := &AgentMockRegisterCheckParams{ctx, check}
+
+	// Record call args
+	mmRegisterCheck.RegisterCheckMock.mutex.Lock()
+	mmRegisterCheck.RegisterCheckMock.callArgs = append(mmRegisterCheck.RegisterCheckMock.callArgs, mm_params)
+	mmRegisterCheck.RegisterCheckMock.mutex.Unlock()
+
+	for _, e := range mmRegisterCheck.RegisterCheckMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRegisterCheck.RegisterCheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterCheck.RegisterCheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterCheck.RegisterCheckMock.defaultExpectation.params
+		mm_got := AgentMockRegisterCheckParams{ctx, check}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterCheck.t.Errorf("AgentMock.RegisterCheck got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterCheck.RegisterCheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterCheck.t.Fatal("No results are set for the AgentMock.RegisterCheck")
+		}
+		return (*mm_results).err
+	}
+	if mmRegisterCheck.funcRegisterCheck != nil {
+		return mmRegisterCheck.funcRegisterCheck(ctx, check)
+	}
+	mmRegisterCheck.t.Fatalf("Unexpected call to AgentMock.RegisterCheck. %v %v", ctx, check)
+	return
+}
+
+// RegisterCheckAfterCounter returns a count of finished AgentMock.RegisterCheck invocations
+func (mmRegisterCheck *AgentMock) RegisterCheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterCheck.afterRegisterCheckCounter)
+}
+
+// RegisterCheckBeforeCounter returns a count of AgentMock.RegisterCheck invocations
+func (mmRegisterCheck *AgentMock) RegisterCheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterCheck.beforeRegisterCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.RegisterCheck.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterCheck *mAgentMockRegisterCheck) Calls() []*AgentMockRegisterCheckParams {
+	mmRegisterCheck.mutex.RLock()
+
+	argCopy := make([]*AgentMockRegisterCheckParams, len(mmRegisterCheck.callArgs))
+	copy(argCopy, mmRegisterCheck.callArgs)
+
+	mmRegisterCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterCheckDone returns true if the count of the RegisterCheck invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockRegisterCheckDone() bool {
+	for _, e := range m.RegisterCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterCheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRegisterCheckCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterCheck != nil && mm_atomic.LoadUint64(&m.afterRegisterCheckCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRegisterCheckInspect logs each unmet expectation
+func (m *AgentMock) MinimockRegisterCheckInspect() {
+	for _, e := range m.RegisterCheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.RegisterCheck with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterCheckMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRegisterCheckCounter) < 1 {
+		if m.RegisterCheckMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.RegisterCheck")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.RegisterCheck with params: %#v", *m.RegisterCheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterCheck != nil && mm_atomic.LoadUint64(&m.afterRegisterCheckCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.RegisterCheck")
+	}
+}
+
+type mAgentMockRegisterService struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockRegisterServiceExpectation
+	expectations       []*AgentMockRegisterServiceExpectation
+
+	callArgs []*AgentMockRegisterServiceParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockRegisterServiceExpectation specifies expectation struct of the Agent.RegisterService
+type AgentMockRegisterServiceExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockRegisterServiceParams
+	results *AgentMockRegisterServiceResults
+	Counter uint64
+}
+
+// AgentMockRegisterServiceParams contains parameters of the Agent.RegisterService
+type AgentMockRegisterServiceParams struct {
+	ctx           Ctx
+	registration  AgentServiceRegistration
+	replaceChecks bool
+}
+
+// AgentMockRegisterServiceResults contains results of the Agent.RegisterService
+type AgentMockRegisterServiceResults struct {
+	err error
+}
+
+// Expect sets up expected params for Agent.RegisterService
+func (mmRegisterService *mAgentMockRegisterService) Expect(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool) *mAgentMockRegisterService {
+	if mmRegisterService.mock.funcRegisterService != nil {
+		mmRegisterService.mock.t.Fatalf("AgentMock.RegisterService mock is already set by Set")
+	}
+
+	if mmRegisterService.defaultExpectation == nil {
+		mmRegisterService.defaultExpectation = &AgentMockRegisterServiceExpectation{}
+	}
+
+	mmRegisterService.defaultExpectation.params = &AgentMockRegisterServiceParams{ctx, registration, replaceChecks}
+	for _, e := range mmRegisterService.expectations {
+		if minimock.Equal(e.params, mmRegisterService.defaultExpectation.params) {
+			mmRegisterService.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterService.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterService
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.RegisterService
+func (mmRegisterService *mAgentMockRegisterService) Inspect(f func(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool)) *mAgentMockRegisterService {
+	if mmRegisterService.mock.inspectFuncRegisterService != nil {
+		mmRegisterService.mock.t.Fatalf("Inspect function is already set for AgentMock.RegisterService")
+	}
+
+	mmRegisterService.mock.inspectFuncRegisterService = f
+
+	return mmRegisterService
+}
+
+// Return sets up results that will be returned by Agent.RegisterService
+func (mmRegisterService *mAgentMockRegisterService) Return(err error) *AgentMock {
+	if mmRegisterService.mock.funcRegisterService != nil {
+		mmRegisterService.mock.t.Fatalf("AgentMock.RegisterService mock is already set by Set")
+	}
+
+	if mmRegisterService.defaultExpectation == nil {
+		mmRegisterService.defaultExpectation = &AgentMockRegisterServiceExpectation{mock: mmRegisterService.mock}
+	}
+	mmRegisterService.defaultExpectation.results = &AgentMockRegisterServiceResults{err}
+	return mmRegisterService.mock
+}
+
+//Set uses given function f to mock the Agent.RegisterService method
+func (mmRegisterService *mAgentMockRegisterService) Set(f func(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool) (err error)) *AgentMock {
+	if mmRegisterService.defaultExpectation != nil {
+		mmRegisterService.mock.t.Fatalf("Default expectation is already set for the Agent.RegisterService method")
+	}
+
+	if len(mmRegisterService.expectations) > 0 {
+		mmRegisterService.mock.t.Fatalf("Some expectations are already set for the Agent.RegisterService method")
+	}
+
+	mmRegisterService.mock.funcRegisterService = f
+	return mmRegisterService.mock
+}
+
+// When sets expectation for the Agent.RegisterService which will trigger the result defined by the following
+// Then helper
+func (mmRegisterService *mAgentMockRegisterService) When(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool) *AgentMockRegisterServiceExpectation {
+	if mmRegisterService.mock.funcRegisterService != nil {
+		mmRegisterService.mock.t.Fatalf("AgentMock.RegisterService mock is already set by Set")
+	}
+
+	expectation := &AgentMockRegisterServiceExpectation{
+		mock:   mmRegisterService.mock,
+		params: &AgentMockRegisterServiceParams{ctx, registration, replaceChecks},
+	}
+	mmRegisterService.expectations = append(mmRegisterService.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.RegisterService return parameters for the expectation previously defined by the When method
+func (e *AgentMockRegisterServiceExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockRegisterServiceResults{err}
+	return e.mock
+}
+
+// RegisterService implements Agent
+func (mmRegisterService *AgentMock) RegisterService(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool) (err error) {
+	mm_atomic.AddUint64(&mmRegisterService.beforeRegisterServiceCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterService.afterRegisterServiceCounter, 1)
+
+	if mmRegisterService.inspectFuncRegisterService != nil {
+		mmRegisterService.inspectFuncRegisterService(ctx, registration, replaceChecks)
+	}
+
+	mm_params := &AgentMockRegisterServiceParams{ctx, registration, replaceChecks}
+
+	// Record call args
+	mmRegisterService.RegisterServiceMock.mutex.Lock()
+	mmRegisterService.RegisterServiceMock.callArgs = append(mmRegisterService.RegisterServiceMock.callArgs, mm_params)
+	mmRegisterService.RegisterServiceMock.mutex.Unlock()
+
+	for _, e := range mmRegisterService.RegisterServiceMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRegisterService.RegisterServiceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterService.RegisterServiceMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterService.RegisterServiceMock.defaultExpectation.params
+		mm_got := AgentMockRegisterServiceParams{ctx, registration, replaceChecks}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterService.t.Errorf("AgentMock.RegisterService got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterService.RegisterServiceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterService.t.Fatal("No results are set for the AgentMock.RegisterService")
+		}
+		return (*mm_results).err
+	}
+	if mmRegisterService.funcRegisterService != nil {
+		return mmRegisterService.funcRegisterService(ctx, registration, replaceChecks)
+	}
+	mmRegisterService.t.Fatalf("Unexpected call to AgentMock.RegisterService. %v %v %v", ctx, registration, replaceChecks)
+	return
+}
+
+// RegisterServiceAfterCounter returns a count of finished AgentMock.RegisterService invocations
+func (mmRegisterService *AgentMock) RegisterServiceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterService.afterRegisterServiceCounter)
+}
+
+// RegisterServiceBeforeCounter returns a count of AgentMock.RegisterService invocations
+func (mmRegisterService *AgentMock) RegisterServiceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterService.beforeRegisterServiceCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.RegisterService.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterService *mAgentMockRegisterService) Calls() []*AgentMockRegisterServiceParams {
+	mmRegisterService.mutex.RLock()
+
+	argCopy := make([]*AgentMockRegisterServiceParams, len(mmRegisterService.callArgs))
+	copy(argCopy, mmRegisterService.callArgs)
+
+	mmRegisterService.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterServiceDone returns true if the count of the RegisterService invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockRegisterServiceDone() bool {
+	for _, e := range m.RegisterServiceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterServiceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRegisterServiceCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterService != nil && mm_atomic.LoadUint64(&m.afterRegisterServiceCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRegisterServiceInspect logs each unmet expectation
+func (m *AgentMock) MinimockRegisterServiceInspect() {
+	for _, e := range m.RegisterServiceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.RegisterService with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterServiceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRegisterServiceCounter) < 1 {
+		if m.RegisterServiceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.RegisterService")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.RegisterService with params: %#v", *m.RegisterServiceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterService != nil && mm_atomic.LoadUint64(&m.afterRegisterServiceCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.RegisterService")
+	}
+}
+
+type mAgentMockReload struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockReloadExpectation
+	expectations       []*AgentMockReloadExpectation
+
+	callArgs []*AgentMockReloadParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockReloadExpectation specifies expectation struct of the Agent.Reload
+type AgentMockReloadExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockReloadParams
+	results *AgentMockReloadResults
+	Counter uint64
+}
+
+// AgentMockReloadParams contains parameters of the Agent.Reload
+type AgentMockReloadParams struct {
+	ctx Ctx
+}
+
+// AgentMockReloadResults contains results of the Agent.Reload
+type AgentMockReloadResults struct {
+	err error
+}
+
+// Expect sets up expected params for Agent.Reload
+func (mmReload *mAgentMockReload) Expect(ctx Ctx) *mAgentMockReload {
+	if mmReload.mock.funcReload != nil {
+		mmReload.mock.t.Fatalf("AgentMock.Reload mock is already set by Set")
+	}
+
+	if mmReload.defaultExpectation == nil {
+		mmReload.defaultExpectation = &AgentMockReloadExpectation{}
+	}
+
+	mmReload.defaultExpectation.params = &AgentMockReloadParams{ctx}
+	for _, e := range mmReload.expectations {
+		if minimock.Equal(e.params, mmReload.defaultExpectation.params) {
+			mmReload.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReload.defaultExpectation.params)
+		}
+	}
+
+	return mmReload
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.Reload
+func (mmReload *mAgentMockReload) Inspect(f func(ctx Ctx)) *mAgentMockReload {
+	if mmReload.mock.inspectFuncReload != nil {
+		mmReload.mock.t.Fatalf("Inspect function is already set for AgentMock.Reload")
+	}
+
+	mmReload.mock.inspectFuncReload = f
+
+	return mmReload
+}
+
+// Return sets up results that will be returned by Agent.Reload
+func (mmReload *mAgentMockReload) Return(err error) *AgentMock {
+	if mmReload.mock.funcReload != nil {
+		mmReload.mock.t.Fatalf("AgentMock.Reload mock is already set by Set")
+	}
+
+	if mmReload.defaultExpectation == nil {
+		mmReload.defaultExpectation = &AgentMockReloadExpectation{mock: mmReload.mock}
+	}
+	mmReload.defaultExpectation.results = &AgentMockReloadResults{err}
+	return mmReload.mock
+}
+
+//Set uses given function f to mock the Agent.Reload method
+func (mmReload *mAgentMockReload) Set(f func(ctx Ctx) (err error)) *AgentMock {
+	if mmReload.defaultExpectation != nil {
+		mmReload.mock.t.Fatalf("Default expectation is already set for the Agent.Reload method")
+	}
+
+	if len(mmReload.expectations) > 0 {
+		mmReload.mock.t.Fatalf("Some expectations are already set for the Agent.Reload method")
+	}
+
+	mmReload.mock.funcReload = f
+	return mmReload.mock
+}
+
+// When sets expectation for the Agent.Reload which will trigger the result defined by the following
+// Then helper
+func (mmReload *mAgentMockReload) When(ctx Ctx) *AgentMockReloadExpectation {
+	if mmReload.mock.funcReload != nil {
+		mmReload.mock.t.Fatalf("AgentMock.Reload mock is already set by Set")
+	}
+
+	expectation := &AgentMockReloadExpectation{
+		mock:   mmReload.mock,
+		params: &AgentMockReloadParams{ctx},
+	}
+	mmReload.expectations = append(mmReload.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.Reload return parameters for the expectation previously defined by the When method
+func (e *AgentMockReloadExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockReloadResults{err}
+	return e.mock
+}
+
+// Reload implements Agent
+func (mmReload *AgentMock) Reload(ctx Ctx) (err error) {
+	mm_atomic.AddUint64(&mmReload.beforeReloadCounter, 1)
+	defer mm_atomic.AddUint64(&mmReload.afterReloadCounter, 1)
+
+	if mmReload.inspectFuncReload != nil {
+		mmReload.inspectFuncReload(ctx)
+	}
+
+	mm_params := &AgentMockReloadParams{ctx}
+
+	// Record call args
+	mmReload.ReloadMock.mutex.Lock()
+	mmReload.ReloadMock.callArgs = append(mmReload.ReloadMock.callArgs, mm_params)
+	mmReload.ReloadMock.mutex.Unlock()
+
+	for _, e := range mmReload.ReloadMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReload.ReloadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReload.ReloadMock.defaultExpectation.Counter, 1)
+		mm_want := mmReload.ReloadMock.defaultExpectation.params
+		mm_got := AgentMockReloadParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReload.t.Errorf("AgentMock.Reload got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReload.ReloadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReload.t.Fatal("No results are set for the AgentMock.Reload")
+		}
+		return (*mm_results).err
+	}
+	if mmReload.funcReload != nil {
+		return mmReload.funcReload(ctx)
+	}
+	mmReload.t.Fatalf("Unexpected call to AgentMock.Reload. %v", ctx)
+	return
+}
+
+// ReloadAfterCounter returns a count of finished AgentMock.Reload invocations
+func (mmReload *AgentMock) ReloadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReload.afterReloadCounter)
+}
+
+// ReloadBeforeCounter returns a count of AgentMock.Reload invocations
+func (mmReload *AgentMock) ReloadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReload.beforeReloadCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.Reload.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReload *mAgentMockReload) Calls() []*AgentMockReloadParams {
+	mmReload.mutex.RLock()
+
+	argCopy := make([]*AgentMockReloadParams, len(mmReload.callArgs))
+	copy(argCopy, mmReload.callArgs)
+
+	mmReload.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReloadDone returns true if the count of the Reload invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockReloadDone() bool {
+	for _, e := range m.ReloadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReloadMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReload != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReloadInspect logs each unmet expectation
+func (m *AgentMock) MinimockReloadInspect() {
+	for _, e := range m.ReloadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.Reload with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReloadMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
+		if m.ReloadMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.Reload")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.Reload with params: %#v", *m.ReloadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReload != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.Reload")
+	}
 }
 
-type mAgentMockMetrics struct {
+type mAgentMockSelf struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockMetricsExpectation
-	expectations       []*AgentMockMetricsExpectation
+	defaultExpectation *AgentMockSelfExpectation
+	expectations       []*AgentMockSelfExpectation
 
-	callArgs []*AgentMockMetricsParams
+	callArgs []*AgentMockSelfParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockMetricsExpectation specifies expectation struct of the Agent.Metrics
-type AgentMockMetricsExpectation struct {
+// AgentMockSelfExpectation specifies expectation struct of the Agent.Self
+type AgentMockSelfExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockMetricsParams
-	results *AgentMockMetricsResults
+	params  *AgentMockSelfParams
+	results *AgentMockSelfResults
 	Counter uint64
 }
 
-// AgentMockMetricsParams contains parameters of the Agent.Metrics
-type AgentMockMetricsParams struct {
+// AgentMockSelfParams contains parameters of the Agent.Self
+type AgentMockSelfParams struct {
 	ctx Ctx
 }
 
-// AgentMockMetricsResults contains results of the Agent.Metrics
-type AgentMockMetricsResults struct {
-	m1  Metrics
+// AgentMockSelfResults contains results of the Agent.Self
+type AgentMockSelfResults struct {
+	a1  AgentInfo
 	err error
 }
 
-// Expect sets up expected params for Agent.Metrics
-func (mmMetrics *mAgentMockMetrics) Expect(ctx Ctx) *mAgentMockMetrics {
-	if mmMetrics.mock.funcMetrics != nil {
-		mmMetrics.mock.t.Fatalf("AgentMock.Metrics mock is already set by Set")
+// Expect sets up expected params for Agent.Self
+func (mmSelf *mAgentMockSelf) Expect(ctx Ctx) *mAgentMockSelf {
+	if mmSelf.mock.funcSelf != nil {
+		mmSelf.mock.t.Fatalf("AgentMock.Self mock is already set by Set")
 	}
 
-	if mmMetrics.defaultExpectation == nil {
-		mmMetrics.defaultExpectation = &AgentMockMetricsExpectation{}
+	if mmSelf.defaultExpectation == nil {
+		mmSelf.defaultExpectation = &AgentMockSelfExpectation{}
 	}
 
-	mmMetrics.defaultExpectation.params = &AgentMockMetricsParams{ctx}
-	for _, e := range mmMetrics.expectations {
-		if minimock.Equal(e.params, mmMetrics.defaultExpectation.params) {
-			mmMetrics.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMetrics.defaultExpectation.params)
+	mmSelf.defaultExpectation.params = &AgentMockSelfParams{ctx}
+	for _, e := range mmSelf.expectations {
+		if minimock.Equal(e.params, mmSelf.defaultExpectation.params) {
+			mmSelf.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSelf.defaultExpectation.params)
 		}
 	}
 
-	return mmMetrics
+	return mmSelf
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.Metrics
-func (mmMetrics *mAgentMockMetrics) Inspect(f func(ctx Ctx)) *mAgentMockMetrics {
-	if mmMetrics.mock.inspectFuncMetrics != nil {
-		mmMetrics.mock.t.Fatalf("Inspect function is already set for AgentMock.Metrics")
+// Inspect accepts an inspector function that has same arguments as the Agent.Self
+func (mmSelf *mAgentMockSelf) Inspect(f func(ctx Ctx)) *mAgentMockSelf {
+	if mmSelf.mock.inspectFuncSelf != nil {
+		mmSelf.mock.t.Fatalf("Inspect function is already set for AgentMock.Self")
+	}
+
+	mmSelf.mock.inspectFuncSelf = f
+
+	return mmSelf
+}
+
+// Return sets up results that will be returned by Agent.Self
+func (mmSelf *mAgentMockSelf) Return(a1 AgentInfo, err error) *AgentMock {
+	if mmSelf.mock.funcSelf != nil {
+		mmSelf.mock.t.Fatalf("AgentMock.Self mock is already set by Set")
+	}
+
+	if mmSelf.defaultExpectation == nil {
+		mmSelf.defaultExpectation = &AgentMockSelfExpectation{mock: mmSelf.mock}
+	}
+	mmSelf.defaultExpectation.results = &AgentMockSelfResults{a1, err}
+	return mmSelf.mock
+}
+
+//Set uses given function f to mock the Agent.Self method
+func (mmSelf *mAgentMockSelf) Set(f func(ctx Ctx) (a1 AgentInfo, err error)) *AgentMock {
+	if mmSelf.defaultExpectation != nil {
+		mmSelf.mock.t.Fatalf("Default expectation is already set for the Agent.Self method")
+	}
+
+	if len(mmSelf.expectations) > 0 {
+		mmSelf.mock.t.Fatalf("Some expectations are already set for the Agent.Self method")
+	}
+
+	mmSelf.mock.funcSelf = f
+	return mmSelf.mock
+}
+
+// When sets expectation for the Agent.Self which will trigger the result defined by the following
+// Then helper
+func (mmSelf *mAgentMockSelf) When(ctx Ctx) *AgentMockSelfExpectation {
+	if mmSelf.mock.funcSelf != nil {
+		mmSelf.mock.t.Fatalf("AgentMock.Self mock is already set by Set")
+	}
+
+	expectation := &AgentMockSelfExpectation{
+		mock:   mmSelf.mock,
+		params: &AgentMockSelfParams{ctx},
+	}
+	mmSelf.expectations = append(mmSelf.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Agent.Self return parameters for the expectation previously defined by the When method
+func (e *AgentMockSelfExpectation) Then(a1 AgentInfo, err error) *AgentMock {
+	e.results = &AgentMockSelfResults{a1, err}
+	return e.mock
+}
+
+// Self implements Agent
+func (mmSelf *AgentMock) Self(ctx Ctx) (a1 AgentInfo, err error) {
+	mm_atomic.AddUint64(&mmSelf.beforeSelfCounter, 1)
+	defer mm_atomic.AddUint64(&mmSelf.afterSelfCounter, 1)
+
+	if mmSelf.inspectFuncSelf != nil {
+		mmSelf.inspectFuncSelf(ctx)
+	}
+
+	mm_params := &AgentMockSelfParams{ctx}
+
+	// Record call args
+	mmSelf.SelfMock.mutex.Lock()
+	mmSelf.SelfMock.callArgs = append(mmSelf.SelfMock.callArgs, mm_params)
+	mmSelf.SelfMock.mutex.Unlock()
+
+	for _, e := range mmSelf.SelfMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmSelf.SelfMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSelf.SelfMock.defaultExpectation.Counter, 1)
+		mm_want := mmSelf.SelfMock.defaultExpectation.params
+		mm_got := AgentMockSelfParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSelf.t.Errorf("AgentMock.Self got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSelf.SelfMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSelf.t.Fatal("No results are set for the AgentMock.Self")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmSelf.funcSelf != nil {
+		return mmSelf.funcSelf(ctx)
+	}
+	mmSelf.t.Fatalf("Unexpected call to AgentMock.Self. %v", ctx)
+	return
+}
+
+// SelfAfterCounter returns a count of finished AgentMock.Self invocations
+func (mmSelf *AgentMock) SelfAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSelf.afterSelfCounter)
+}
+
+// SelfBeforeCounter returns a count of AgentMock.Self invocations
+func (mmSelf *AgentMock) SelfBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSelf.beforeSelfCounter)
+}
+
+// Calls returns a list of arguments used in each call to AgentMock.Self.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSelf *mAgentMockSelf) Calls() []*AgentMockSelfParams {
+	mmSelf.mutex.RLock()
+
+	argCopy := make([]*AgentMockSelfParams, len(mmSelf.callArgs))
+	copy(argCopy, mmSelf.callArgs)
+
+	mmSelf.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSelfDone returns true if the count of the Self invocations corresponds
+// the number of defined expectations
+func (m *AgentMock) MinimockSelfDone() bool {
+	for _, e := range m.SelfMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SelfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSelf != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSelfInspect logs each unmet expectation
+func (m *AgentMock) MinimockSelfInspect() {
+	for _, e := range m.SelfMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AgentMock.Self with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SelfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
+		if m.SelfMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.Self")
+		} else {
+			m.t.Errorf("Expected call to AgentMock.Self with params: %#v", *m.SelfMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSelf != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.Self")
+	}
+}
+
+type mAgentMockServiceMaintenanceMode struct {
+	mock               *AgentMock
+	defaultExpectation *AgentMockServiceMaintenanceModeExpectation
+	expectations       []*AgentMockServiceMaintenanceModeExpectation
+
+	callArgs []*AgentMockServiceMaintenanceModeParams
+	mutex    sync.RWMutex
+}
+
+// AgentMockServiceMaintenanceModeExpectation specifies expectation struct of the Agent.ServiceMaintenanceMode
+type AgentMockServiceMaintenanceModeExpectation struct {
+	mock    *AgentMock
+	params  *AgentMockServiceMaintenanceModeParams
+	results *AgentMockServiceMaintenanceModeResults
+	Counter uint64
+}
+
+// AgentMockServiceMaintenanceModeParams contains parameters of the Agent.ServiceMaintenanceMode
+type AgentMockServiceMaintenanceModeParams struct {
+	ctx       Ctx
+	serviceID string
+	enabled   bool
+	reason    string
+}
+
+// AgentMockServiceMaintenanceModeResults contains results of the Agent.ServiceMaintenanceMode
+type AgentMockServiceMaintenanceModeResults struct {
+	err error
+}
+
+// Expect sets up expected params for Agent.ServiceMaintenanceMode
+func (mmServiceMaintenanceMode *mAgentMockServiceMaintenanceMode) Expect(ctx Ctx, serviceID string, enabled bool, reason string) *mAgentMockServiceMaintenanceMode {
+	if mmServiceMaintenanceMode.mock.funcServiceMaintenanceMode != nil {
+		mmServiceMaintenanceMode.mock.t.Fatalf("AgentMock.ServiceMaintenanceMode mock is already set by Set")
+	}
+
+	if mmServiceMaintenanceMode.defaultExpectation == nil {
+		mmServiceMaintenanceMode.defaultExpectation = &AgentMockServiceMaintenanceModeExpectation{}
+	}
+
+	mmServiceMaintenanceMode.defaultExpectation.params = &AgentMockServiceMaintenanceModeParams{ctx, serviceID, enabled, reason}
+	for _, e := range mmServiceMaintenanceMode.expectations {
+		if minimock.Equal(e.params, mmServiceMaintenanceMode.defaultExpectation.params) {
+			mmServiceMaintenanceMode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmServiceMaintenanceMode.defaultExpectation.params)
+		}
+	}
+
+	return mmServiceMaintenanceMode
+}
+
+// Inspect accepts an inspector function that has same arguments as the Agent.ServiceMaintenanceMode
+func (mmServiceMaintenanceMode *mAgentMockServiceMaintenanceMode) Inspect(f func(ctx Ctx, serviceID string, enabled bool, reason string)) *mAgentMockServiceMaintenanceMode {
+	if mmServiceMaintenanceMode.mock.inspectFuncServiceMaintenanceMode != nil {
+		mmServiceMaintenanceMode.mock.t.Fatalf("Inspect function is already set for AgentMock.ServiceMaintenanceMode")
 	}
 
-	mmMetrics.mock.inspectFuncMetrics = f
+	mmServiceMaintenanceMode.mock.inspectFuncServiceMaintenanceMode = f
 
-	return mmMetrics
+	return mmServiceMaintenanceMode
 }
 
-// Return sets up results that will be returned by Agent.Metrics
-func (mmMetrics *mAgentMockMetrics) Return(m1 Metrics, err error) *AgentMock {
-	if mmMetrics.mock.funcMetrics != nil {
-		mmMetrics.mock.t.Fatalf("AgentMock.Metrics mock is already set by Set")
+// Return sets up results that will be returned by Agent.ServiceMaintenanceMode
+func (mmServiceMaintenanceMode *mAgentMockServiceMaintenanceMode) Return(err error) *AgentMock {
+	if mmServiceMaintenanceMode.mock.funcServiceMaintenanceMode != nil {
+		mmServiceMaintenanceMode.mock.t.Fatalf("AgentMock.ServiceMaintenanceMode mock is already set by Set")
 	}
 
-	if mmMetrics.defaultExpectation == nil {
-		mmMetrics.defaultExpectation = &AgentMockMetricsExpectation{mock: mmMetrics.mock}
+	if mmServiceMaintenanceMode.defaultExpectation == nil {
+		mmServiceMaintenanceMode.defaultExpectation = &AgentMockServiceMaintenanceModeExpectation{mock: mmServiceMaintenanceMode.mock}
 	}
-	mmMetrics.defaultExpectation.results = &AgentMockMetricsResults{m1, err}
-	return mmMetrics.mock
+	mmServiceMaintenanceMode.defaultExpectation.results = &AgentMockServiceMaintenanceModeResults{err}
+	return mmServiceMaintenanceMode.mock
 }
 
-//Set uses given function f to mock the Agent.Metrics method
-func (mmMetrics *mAgentMockMetrics) Set(f func(ctx Ctx) (m1 Metrics, err error)) *AgentMock {
-	if mmMetrics.defaultExpectation != nil {
-		mmMetrics.mock.t.Fatalf("Default expectation is already set for the Agent.Metrics method")
+//Set uses given function f to mock the Agent.ServiceMaintenanceMode method
+func (mmServiceMaintenanceMode *mAgentMockServiceMaintenanceMode) Set(f func(ctx Ctx, serviceID string, enabled bool, reason string) (err error)) *AgentMock {
+	if mmServiceMaintenanceMode.defaultExpectation != nil {
+		mmServiceMaintenanceMode.mock.t.Fatalf("Default expectation is already set for the Agent.ServiceMaintenanceMode method")
 	}
 
-	if len(mmMetrics.expectations) > 0 {
-		mmMetrics.mock.t.Fatalf("Some expectations are already set for the Agent.Metrics method")
+	if len(mmServiceMaintenanceMode.expectations) > 0 {
+		mmServiceMaintenanceMode.mock.t.Fatalf("Some expectations are already set for the Agent.ServiceMaintenanceMode method")
 	}
 
-	mmMetrics.mock.funcMetrics = f
-	return mmMetrics.mock
+	mmServiceMaintenanceMode.mock.funcServiceMaintenanceMode = f
+	return mmServiceMaintenanceMode.mock
 }
 
-// When sets expectation for the Agent.Metrics which will trigger the result defined by the following
+// When sets expectation for the Agent.ServiceMaintenanceMode which will trigger the result defined by the following
 // Then helper
-func (mmMetrics *mAgentMockMetrics) When(ctx Ctx) *AgentMockMetricsExpectation {
-	if mmMetrics.mock.funcMetrics != nil {
-		mmMetrics.mock.t.Fatalf("AgentMock.Metrics mock is already set by Set")
+func (mmServiceMaintenanceMode *mAgentMockServiceMaintenanceMode) When(ctx Ctx, serviceID string, enabled bool, reason string) *AgentMockServiceMaintenanceModeExpectation {
+	if mmServiceMaintenanceMode.mock.funcServiceMaintenanceMode != nil {
+		mmServiceMaintenanceMode.mock.t.Fatalf("AgentMock.ServiceMaintenanceMode mock is already set by Set")
 	}
 
-	expectation := &AgentMockMetricsExpectation{
-		mock:   mmMetrics.mock,
-		params: &AgentMockMetricsParams{ctx},
+	expectation := &AgentMockServiceMaintenanceModeExpectation{
+		mock:   mmServiceMaintenanceMode.mock,
+		params: &AgentMockServiceMaintenanceModeParams{ctx, serviceID, enabled, reason},
 	}
-	mmMetrics.expectations = append(mmMetrics.expectations, expectation)
+	mmServiceMaintenanceMode.expectations = append(mmServiceMaintenanceMode.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.Metrics return parameters for the expectation previously defined by the When method
-func (e *AgentMockMetricsExpectation) Then(m1 Metrics, err error) *AgentMock {
-	e.results = &AgentMockMetricsResults{m1, err}
+// Then sets up Agent.ServiceMaintenanceMode return parameters for the expectation previously defined by the When method
+func (e *AgentMockServiceMaintenanceModeExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockServiceMaintenanceModeResults{err}
 	return e.mock
 }
 
-// Metrics implements Agent
-func (mmMetrics *AgentMock) Metrics(ctx Ctx) (m1 Metrics, err error) {
-	mm_atomic.AddUint64(&mmMetrics.beforeMetricsCounter, 1)
-	defer mm_atomic.AddUint64(&mmMetrics.afterMetricsCounter, 1)
+// ServiceMaintenanceMode implements Agent
+func (mmServiceMaintenanceMode *AgentMock) ServiceMaintenanceMode(ctx Ctx, serviceID string, enabled bool, reason string) (err error) {
+	mm_atomic.AddUint64(&mmServiceMaintenanceMode.beforeServiceMaintenanceModeCounter, 1)
+	defer mm_atomic.AddUint64(&mmServiceMaintenanceMode.afterServiceMaintenanceModeCounter, 1)
 
-	if mmMetrics.inspectFuncMetrics != nil {
-		mmMetrics.inspectFuncMetrics(ctx)
+	if mmServiceMaintenanceMode.inspectFuncServiceMaintenanceMode != nil {
+		mmServiceMaintenanceMode.inspectFuncServiceMaintenanceMode(ctx, serviceID, enabled, reason)
 	}
 
-	mm_params := &AgentMockMetricsParams{ctx}
+	mm_params := &AgentMockServiceMaintenanceModeParams{ctx, serviceID, enabled, reason}
 
 	// Record call args
-	mmMetrics.MetricsMock.mutex.Lock()
-	mmMetrics.MetricsMock.callArgs = append(mmMetrics.MetricsMock.callArgs, mm_params)
-	mmMetrics.MetricsMock.mutex.Unlock()
+	mmServiceMaintenanceMode.ServiceMaintenanceModeMock.mutex.Lock()
+	mmServiceMaintenanceMode.ServiceMaintenanceModeMock.callArgs = append(mmServiceMaintenanceMode.ServiceMaintenanceModeMock.callArgs, mm_params)
+	mmServiceMaintenanceMode.ServiceMaintenanceModeMock.mutex.Unlock()
 
-	for _, e := range mmMetrics.MetricsMock.expectations {
+	for _, e := range mmServiceMaintenanceMode.ServiceMaintenanceModeMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.m1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmMetrics.MetricsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmMetrics.MetricsMock.defaultExpectation.Counter, 1)
-		mm_want := mmMetrics.MetricsMock.defaultExpectation.params
-		mm_got := AgentMockMetricsParams{ctx}
+	if mmServiceMaintenanceMode.ServiceMaintenanceModeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmServiceMaintenanceMode.ServiceMaintenanceModeMock.defaultExpectation.Counter, 1)
+		mm_want := mmServiceMaintenanceMode.ServiceMaintenanceModeMock.defaultExpectation.params
+		mm_got := AgentMockServiceMaintenanceModeParams{ctx, serviceID, enabled, reason}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmMetrics.t.Errorf("AgentMock.Metrics got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmServiceMaintenanceMode.t.Errorf("AgentMock.ServiceMaintenanceMode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmMetrics.MetricsMock.defaultExpectation.results
+		mm_results := mmServiceMaintenanceMode.ServiceMaintenanceModeMock.defaultExpectation.results
 		if mm_results == nil {
-			mmMetrics.t.Fatal("No results are set for the AgentMock.Metrics")
+			mmServiceMaintenanceMode.t.Fatal("No results are set for the AgentMock.ServiceMaintenanceMode")
 		}
-		return (*mm_results).m1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmMetrics.funcMetrics != nil {
-		return mmMetrics.funcMetrics(ctx)
+	if mmServiceMaintenanceMode.funcServiceMaintenanceMode != nil {
+		return mmServiceMaintenanceMode.funcServiceMaintenanceMode(ctx, serviceID, enabled, reason)
 	}
-	mmMetrics.t.Fatalf("Unexpected call to AgentMock.Metrics. %v", ctx)
+	mmServiceMaintenanceMode.t.Fatalf("Unexpected call to AgentMock.ServiceMaintenanceMode. %v %v %v %v", ctx, serviceID, enabled, reason)
 	return
 }
 
-// MetricsAfterCounter returns a count of finished AgentMock.Metrics invocations
-func (mmMetrics *AgentMock) MetricsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMetrics.afterMetricsCounter)
+// ServiceMaintenanceModeAfterCounter returns a count of finished AgentMock.ServiceMaintenanceMode invocations
+func (mmServiceMaintenanceMode *AgentMock) ServiceMaintenanceModeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmServiceMaintenanceMode.afterServiceMaintenanceModeCounter)
 }
 
-// MetricsBeforeCounter returns a count of AgentMock.Metrics invocations
-func (mmMetrics *AgentMock) MetricsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmMetrics.beforeMetricsCounter)
+// ServiceMaintenanceModeBeforeCounter returns a count of AgentMock.ServiceMaintenanceMode invocations
+func (mmServiceMaintenanceMode *AgentMock) ServiceMaintenanceModeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmServiceMaintenanceMode.beforeServiceMaintenanceModeCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.Metrics.
+// Calls returns a list of arguments used in each call to AgentMock.ServiceMaintenanceMode.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmMetrics *mAgentMockMetrics) Calls() []*AgentMockMetricsParams {
-	mmMetrics.mutex.RLock()
+func (mmServiceMaintenanceMode *mAgentMockServiceMaintenanceMode) Calls() []*AgentMockServiceMaintenanceModeParams {
+	mmServiceMaintenanceMode.mutex.RLock()
 
-	argCopy := make([]*AgentMockMetricsParams, len(mmMetrics.callArgs))
-	copy(argCopy, mmMetrics.callArgs)
+	argCopy := make([]*AgentMockServiceMaintenanceModeParams, len(mmServiceMaintenanceMode.callArgs))
+	copy(argCopy, mmServiceMaintenanceMode.callArgs)
 
-	mmMetrics.mutex.RUnlock()
+	mmServiceMaintenanceMode.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockMetricsDone returns true if the count of the Metrics invocations corresponds
+// MinimockServiceMaintenanceModeDone returns true if the count of the ServiceMaintenanceMode invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockMetricsDone() bool {
-	for _, e := range m.MetricsMock.expectations {
+func (m *AgentMock) MinimockServiceMaintenanceModeDone() bool {
+	for _, e := range m.ServiceMaintenanceModeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MetricsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
+	if m.ServiceMaintenanceModeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterServiceMaintenanceModeCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMetrics != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
+	if m.funcServiceMaintenanceMode != nil && mm_atomic.LoadUint64(&m.afterServiceMaintenanceModeCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockMetricsInspect logs each unmet expectation
-func (m *AgentMock) MinimockMetricsInspect() {
-	for _, e := range m.MetricsMock.expectations {
+// MinimockServiceMaintenanceModeInspect logs each unmet expectation
+func (m *AgentMock) MinimockServiceMaintenanceModeInspect() {
+	for _, e := range m.ServiceMaintenanceModeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.Metrics with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.ServiceMaintenanceMode with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.MetricsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
-		if m.MetricsMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.Metrics")
+	if m.ServiceMaintenanceModeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterServiceMaintenanceModeCounter) < 1 {
+		if m.ServiceMaintenanceModeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.ServiceMaintenanceMode")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.Metrics with params: %#v", *m.MetricsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.ServiceMaintenanceMode with params: %#v", *m.ServiceMaintenanceModeMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcMetrics != nil && mm_atomic.LoadUint64(&m.afterMetricsCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.Metrics")
+	if m.funcServiceMaintenanceMode != nil && mm_atomic.LoadUint64(&m.afterServiceMaintenanceModeCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.ServiceMaintenanceMode")
 	}
 }
 
-type mAgentMockReload struct {
+type mAgentMockSetACLToken struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockReloadExpectation
-	expectations       []*AgentMockReloadExpectation
+	defaultExpectation *AgentMockSetACLTokenExpectation
+	expectations       []*AgentMockSetACLTokenExpectation
 
-	callArgs []*AgentMockReloadParams
+	callArgs []*AgentMockSetACLTokenParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockReloadExpectation specifies expectation struct of the Agent.Reload
-type AgentMockReloadExpectation struct {
+// AgentMockSetACLTokenExpectation specifies expectation struct of the Agent.SetACLToken
+type AgentMockSetACLTokenExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockReloadParams
-	results *AgentMockReloadResults
+	params  *AgentMockSetACLTokenParams
+	results *AgentMockSetACLTokenResults
 	Counter uint64
 }
 
-// AgentMockReloadParams contains parameters of the Agent.Reload
-type AgentMockReloadParams struct {
-	ctx Ctx
+// AgentMockSetACLTokenParams contains parameters of the Agent.SetACLToken
+type AgentMockSetACLTokenParams struct {
+	ctx   Ctx
+	kind  AgentTokenKind
+	token string
 }
 
-// AgentMockReloadResults contains results of the Agent.Reload
-type AgentMockReloadResults struct {
+// AgentMockSetACLTokenResults contains results of the Agent.SetACLToken
+type AgentMockSetACLTokenResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.Reload
-func (mmReload *mAgentMockReload) Expect(ctx Ctx) *mAgentMockReload {
-	if mmReload.mock.funcReload != nil {
-		mmReload.mock.t.Fatalf("AgentMock.Reload mock is already set by Set")
+// Expect sets up expected params for Agent.SetACLToken
+func (mmSetACLToken *mAgentMockSetACLToken) Expect(ctx Ctx, kind AgentTokenKind, token string) *mAgentMockSetACLToken {
+	if mmSetACLToken.mock.funcSetACLToken != nil {
+		mmSetACLToken.mock.t.Fatalf("AgentMock.SetACLToken mock is already set by Set")
 	}
 
-	if mmReload.defaultExpectation == nil {
-		mmReload.defaultExpectation = &AgentMockReloadExpectation{}
+	if mmSetACLToken.defaultExpectation == nil {
+		mmSetACLToken.defaultExpectation = &AgentMockSetACLTokenExpectation{}
 	}
 
-	mmReload.defaultExpectation.params = &AgentMockReloadParams{ctx}
-	for _, e := range mmReload.expectations {
-		if minimock.Equal(e.params, mmReload.defaultExpectation.params) {
-			mmReload.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReload.defaultExpectation.params)
+	mmSetACLToken.defaultExpectation.params = &AgentMockSetACLTokenParams{ctx, kind, token}
+	for _, e := range mmSetACLToken.expectations {
+		if minimock.Equal(e.params, mmSetACLToken.defaultExpectation.params) {
+			mmSetACLToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetACLToken.defaultExpectation.params)
 		}
 	}
 
-	return mmReload
+	return mmSetACLToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.Reload
-func (mmReload *mAgentMockReload) Inspect(f func(ctx Ctx)) *mAgentMockReload {
-	if mmReload.mock.inspectFuncReload != nil {
-		mmReload.mock.t.Fatalf("Inspect function is already set for AgentMock.Reload")
+// Inspect accepts an inspector function that has same arguments as the Agent.SetACLToken
+func (mmSetACLToken *mAgentMockSetACLToken) Inspect(f func(ctx Ctx, kind AgentTokenKind, token string)) *mAgentMockSetACLToken {
+	if mmSetACLToken.mock.inspectFuncSetACLToken != nil {
+		mmSetACLToken.mock.t.Fatalf("Inspect function is already set for AgentMock.SetACLToken")
 	}
 
-	mmReload.mock.inspectFuncReload = f
+	mmSetACLToken.mock.inspectFuncSetACLToken = f
 
-	return mmReload
+	return mmSetACLToken
 }
 
-// Return sets up results that will be returned by Agent.Reload
-func (mmReload *mAgentMockReload) Return(err error) *AgentMock {
-	if mmReload.mock.funcReload != nil {
-		mmReload.mock.t.Fatalf("AgentMock.Reload mock is already set by Set")
+// Return sets up results that will be returned by Agent.SetACLToken
+func (mmSetACLToken *mAgentMockSetACLToken) Return(err error) *AgentMock {
+	if mmSetACLToken.mock.funcSetACLToken != nil {
+		mmSetACLToken.mock.t.Fatalf("AgentMock.SetACLToken mock is already set by Set")
 	}
 
-	if mmReload.defaultExpectation == nil {
-		mmReload.defaultExpectation = &AgentMockReloadExpectation{mock: mmReload.mock}
+	if mmSetACLToken.defaultExpectation == nil {
+		mmSetACLToken.defaultExpectation = &AgentMockSetACLTokenExpectation{mock: mmSetACLToken.mock}
 	}
-	mmReload.defaultExpectation.results = &AgentMockReloadResults{err}
-	return mmReload.mock
+	mmSetACLToken.defaultExpectation.results = &AgentMockSetACLTokenResults{err}
+	return mmSetACLToken.mock
 }
 
-//Set uses given function f to mock the Agent.Reload method
-func (mmReload *mAgentMockReload) Set(f func(ctx Ctx) (err error)) *AgentMock {
-	if mmReload.defaultExpectation != nil {
-		mmReload.mock.t.Fatalf("Default expectation is already set for the Agent.Reload method")
+//Set uses given function f to mock the Agent.SetACLToken method
+func (mmSetACLToken *mAgentMockSetACLToken) Set(f func(ctx Ctx, kind AgentTokenKind, token string) (err error)) *AgentMock {
+	if mmSetACLToken.defaultExpectation != nil {
+		mmSetACLToken.mock.t.Fatalf("Default expectation is already set for the Agent.SetACLToken method")
 	}
 
-	if len(mmReload.expectations) > 0 {
-		mmReload.mock.t.Fatalf("Some expectations are already set for the Agent.Reload method")
+	if len(mmSetACLToken.expectations) > 0 {
+		mmSetACLToken.mock.t.Fatalf("Some expectations are already set for the Agent.SetACLToken method")
 	}
 
-	mmReload.mock.funcReload = f
-	return mmReload.mock
+	mmSetACLToken.mock.funcSetACLToken = f
+	return mmSetACLToken.mock
 }
 
-// When sets expectation for the Agent.Reload which will trigger the result defined by the following
+// When sets expectation for the Agent.SetACLToken which will trigger the result defined by the following
 // Then helper
-func (mmReload *mAgentMockReload) When(ctx Ctx) *AgentMockReloadExpectation {
-	if mmReload.mock.funcReload != nil {
-		mmReload.mock.t.Fatalf("AgentMock.Reload mock is already set by Set")
+func (mmSetACLToken *mAgentMockSetACLToken) When(ctx Ctx, kind AgentTokenKind, token string) *AgentMockSetACLTokenExpectation {
+	if mmSetACLToken.mock.funcSetACLToken != nil {
+		mmSetACLToken.mock.t.Fatalf("AgentMock.SetACLToken mock is already set by Set")
 	}
 
-	expectation := &AgentMockReloadExpectation{
-		mock:   mmReload.mock,
-		params: &AgentMockReloadParams{ctx},
+	expectation := &AgentMockSetACLTokenExpectation{
+		mock:   mmSetACLToken.mock,
+		params: &AgentMockSetACLTokenParams{ctx, kind, token},
 	}
-	mmReload.expectations = append(mmReload.expectations, expectation)
+	mmSetACLToken.expectations = append(mmSetACLToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.Reload return parameters for the expectation previously defined by the When method
-func (e *AgentMockReloadExpectation) Then(err error) *AgentMock {
-	e.results = &AgentMockReloadResults{err}
+// Then sets up Agent.SetACLToken return parameters for the expectation previously defined by the When method
+func (e *AgentMockSetACLTokenExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockSetACLTokenResults{err}
 	return e.mock
 }
 
-// Reload implements Agent
-func (mmReload *AgentMock) Reload(ctx Ctx) (err error) {
-	mm_atomic.AddUint64(&mmReload.beforeReloadCounter, 1)
-	defer mm_atomic.AddUint64(&mmReload.afterReloadCounter, 1)
+// SetACLToken implements Agent
+func (mmSetACLToken *AgentMock) SetACLToken(ctx Ctx, kind AgentTokenKind, token string) (err error) {
+	mm_atomic.AddUint64(&mmSetACLToken.beforeSetACLTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetACLToken.afterSetACLTokenCounter, 1)
 
-	if mmReload.inspectFuncReload != nil {
-		mmReload.inspectFuncReload(ctx)
+	if mmSetACLToken.inspectFuncSetACLToken != nil {
+		mmSetACLToken.inspectFuncSetACLToken(ctx, kind, token)
 	}
 
-	mm_params := &AgentMockReloadParams{ctx}
+	mm_params := &AgentMockSetACLTokenParams{ctx, kind, token}
 
 	// Record call args
-	mmReload.ReloadMock.mutex.Lock()
-	mmReload.ReloadMock.callArgs = append(mmReload.ReloadMock.callArgs, mm_params)
-	mmReload.ReloadMock.mutex.Unlock()
+	mmSetACLToken.SetACLTokenMock.mutex.Lock()
+	mmSetACLToken.SetACLTokenMock.callArgs = append(mmSetACLToken.SetACLTokenMock.callArgs, mm_params)
+	mmSetACLToken.SetACLTokenMock.mutex.Unlock()
 
-	for _, e := range mmReload.ReloadMock.expectations {
+	for _, e := range mmSetACLToken.SetACLTokenMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmReload.ReloadMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmReload.ReloadMock.defaultExpectation.Counter, 1)
-		mm_want := mmReload.ReloadMock.defaultExpectation.params
-		mm_got := AgentMockReloadParams{ctx}
+	if mmSetACLToken.SetACLTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetACLToken.SetACLTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetACLToken.SetACLTokenMock.defaultExpectation.params
+		mm_got := AgentMockSetACLTokenParams{ctx, kind, token}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmReload.t.Errorf("AgentMock.Reload got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetACLToken.t.Errorf("AgentMock.SetACLToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmReload.ReloadMock.defaultExpectation.results
+		mm_results := mmSetACLToken.SetACLTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmReload.t.Fatal("No results are set for the AgentMock.Reload")
+			mmSetACLToken.t.Fatal("No results are set for the AgentMock.SetACLToken")
 		}
 		return (*mm_results).err
 	}
-	if mmReload.funcReload != nil {
-		return mmReload.funcReload(ctx)
+	if mmSetACLToken.funcSetACLToken != nil {
+		return mmSetACLToken.funcSetACLToken(ctx, kind, token)
 	}
-	mmReload.t.Fatalf("Unexpected call to AgentMock.Reload. %v", ctx)
+	mmSetACLToken.t.Fatalf("Unexpected call to AgentMock.SetACLToken. %v %v %v", ctx, kind, token)
 	return
 }
 
-// ReloadAfterCounter returns a count of finished AgentMock.Reload invocations
-func (mmReload *AgentMock) ReloadAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmReload.afterReloadCounter)
+// SetACLTokenAfterCounter returns a count of finished AgentMock.SetACLToken invocations
+func (mmSetACLToken *AgentMock) SetACLTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetACLToken.afterSetACLTokenCounter)
 }
 
-// ReloadBeforeCounter returns a count of AgentMock.Reload invocations
-func (mmReload *AgentMock) ReloadBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmReload.beforeReloadCounter)
+// SetACLTokenBeforeCounter returns a count of AgentMock.SetACLToken invocations
+func (mmSetACLToken *AgentMock) SetACLTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetACLToken.beforeSetACLTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.Reload.
+// Calls returns a list of arguments used in each call to AgentMock.SetACLToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmReload *mAgentMockReload) Calls() []*AgentMockReloadParams {
-	mmReload.mutex.RLock()
+func (mmSetACLToken *mAgentMockSetACLToken) Calls() []*AgentMockSetACLTokenParams {
+	mmSetACLToken.mutex.RLock()
 
-	argCopy := make([]*AgentMockReloadParams, len(mmReload.callArgs))
-	copy(argCopy, mmReload.callArgs)
+	argCopy := make([]*AgentMockSetACLTokenParams, len(mmSetACLToken.callArgs))
+	copy(argCopy, mmSetACLToken.callArgs)
 
-	mmReload.mutex.RUnlock()
+	mmSetACLToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockReloadDone returns true if the count of the Reload invocations corresponds
+// MinimockSetACLTokenDone returns true if the count of the SetACLToken invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockReloadDone() bool {
-	for _, e := range m.ReloadMock.expectations {
+func (m *AgentMock) MinimockSetACLTokenDone() bool {
+	for _, e := range m.SetACLTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ReloadMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
+	if m.SetACLTokenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcReload != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
+	if m.funcSetACLToken != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockReloadInspect logs each unmet expectation
-func (m *AgentMock) MinimockReloadInspect() {
-	for _, e := range m.ReloadMock.expectations {
+// MinimockSetACLTokenInspect logs each unmet expectation
+func (m *AgentMock) MinimockSetACLTokenInspect() {
+	for _, e := range m.SetACLTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.Reload with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.SetACLToken with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ReloadMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
-		if m.ReloadMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.Reload")
+	if m.SetACLTokenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
+		if m.SetACLTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.SetACLToken")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.Reload with params: %#v", *m.ReloadMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.SetACLToken with params: %#v", *m.SetACLTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcReload != nil && mm_atomic.LoadUint64(&m.afterReloadCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.Reload")
+	if m.funcSetACLToken != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.SetACLToken")
 	}
 }
 
-type mAgentMockSelf struct {
+type mAgentMockUpdateTTL struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockSelfExpectation
-	expectations       []*AgentMockSelfExpectation
+	defaultExpectation *AgentMockUpdateTTLExpectation
+	expectations       []*AgentMockUpdateTTLExpectation
 
-	callArgs []*AgentMockSelfParams
+	callArgs []*AgentMockUpdateTTLParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockSelfExpectation specifies expectation struct of the Agent.Self
-type AgentMockSelfExpectation struct {
+// AgentMockUpdateTTLExpectation specifies expectation struct of the Agent.UpdateTTL
+type AgentMockUpdateTTLExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockSelfParams
-	results *AgentMockSelfResults
+	params  *AgentMockUpdateTTLParams
+	results *AgentMockUpdateTTLResults
 	Counter uint64
 }
 
-// AgentMockSelfParams contains parameters of the Agent.Self
-type AgentMockSelfParams struct {
-	ctx Ctx
+// AgentMockUpdateTTLParams contains parameters of the Agent.UpdateTTL
+type AgentMockUpdateTTLParams struct {
+	ctx     Ctx
+	checkID string
+	output  string
+	status  CheckStatus
 }
 
-// AgentMockSelfResults contains results of the Agent.Self
-type AgentMockSelfResults struct {
-	a1  AgentInfo
+// AgentMockUpdateTTLResults contains results of the Agent.UpdateTTL
+type AgentMockUpdateTTLResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.Self
-func (mmSelf *mAgentMockSelf) Expect(ctx Ctx) *mAgentMockSelf {
-	if mmSelf.mock.funcSelf != nil {
-		mmSelf.mock.t.Fatalf("AgentMock.Self mock is already set by Set")
+// Expect sets up expected params for Agent.UpdateTTL
+func (mmUpdateTTL *mAgentMockUpdateTTL) Expect(ctx Ctx, checkID string, output string, status CheckStatus) *mAgentMockUpdateTTL {
+	if mmUpdateTTL.mock.funcUpdateTTL != nil {
+		mmUpdateTTL.mock.t.Fatalf("AgentMock.UpdateTTL mock is already set by Set")
 	}
 
-	if mmSelf.defaultExpectation == nil {
-		mmSelf.defaultExpectation = &AgentMockSelfExpectation{}
+	if mmUpdateTTL.defaultExpectation == nil {
+		mmUpdateTTL.defaultExpectation = &AgentMockUpdateTTLExpectation{}
 	}
 
-	mmSelf.defaultExpectation.params = &AgentMockSelfParams{ctx}
-	for _, e := range mmSelf.expectations {
-		if minimock.Equal(e.params, mmSelf.defaultExpectation.params) {
-			mmSelf.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSelf.defaultExpectation.params)
+	mmUpdateTTL.defaultExpectation.params = &AgentMockUpdateTTLParams{ctx, checkID, output, status}
+	for _, e := range mmUpdateTTL.expectations {
+		if minimock.Equal(e.params, mmUpdateTTL.defaultExpectation.params) {
+			mmUpdateTTL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateTTL.defaultExpectation.params)
 		}
 	}
 
-	return mmSelf
+	return mmUpdateTTL
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.Self
-func (mmSelf *mAgentMockSelf) Inspect(f func(ctx Ctx)) *mAgentMockSelf {
-	if mmSelf.mock.inspectFuncSelf != nil {
-		mmSelf.mock.t.Fatalf("Inspect function is already set for AgentMock.Self")
+// Inspect accepts an inspector function that has same arguments as the Agent.UpdateTTL
+func (mmUpdateTTL *mAgentMockUpdateTTL) Inspect(f func(ctx Ctx, checkID string, output string, status CheckStatus)) *mAgentMockUpdateTTL {
+	if mmUpdateTTL.mock.inspectFuncUpdateTTL != nil {
+		mmUpdateTTL.mock.t.Fatalf("Inspect function is already set for AgentMock.UpdateTTL")
 	}
 
-	mmSelf.mock.inspectFuncSelf = f
+	mmUpdateTTL.mock.inspectFuncUpdateTTL = f
 
-	return mmSelf
+	return mmUpdateTTL
 }
 
-// Return sets up results that will be returned by Agent.Self
-func (mmSelf *mAgentMockSelf) Return(a1 AgentInfo, err error) *AgentMock {
-	if mmSelf.mock.funcSelf != nil {
-		mmSelf.mock.t.Fatalf("AgentMock.Self mock is already set by Set")
+// Return sets up results that will be returned by Agent.UpdateTTL
+func (mmUpdateTTL *mAgentMockUpdateTTL) Return(err error) *AgentMock {
+	if mmUpdateTTL.mock.funcUpdateTTL != nil {
+		mmUpdateTTL.mock.t.Fatalf("AgentMock.UpdateTTL mock is already set by Set")
 	}
 
-	if mmSelf.defaultExpectation == nil {
-		mmSelf.defaultExpectation = &AgentMockSelfExpectation{mock: mmSelf.mock}
+	if mmUpdateTTL.defaultExpectation == nil {
+		mmUpdateTTL.defaultExpectation = &AgentMockUpdateTTLExpectation{mock: mmUpdateTTL.mock}
 	}
-	mmSelf.defaultExpectation.results = &AgentMockSelfResults{a1, err}
-	return mmSelf.mock
+	mmUpdateTTL.defaultExpectation.results = &AgentMockUpdateTTLResults{err}
+	return mmUpdateTTL.mock
 }
 
-//Set uses given function f to mock the Agent.Self method
-func (mmSelf *mAgentMockSelf) Set(f func(ctx Ctx) (a1 AgentInfo, err error)) *AgentMock {
-	if mmSelf.defaultExpectation != nil {
-		mmSelf.mock.t.Fatalf("Default expectation is already set for the Agent.Self method")
+//Set uses given function f to mock the Agent.UpdateTTL method
+func (mmUpdateTTL *mAgentMockUpdateTTL) Set(f func(ctx Ctx, checkID string, output string, status CheckStatus) (err error)) *AgentMock {
+	if mmUpdateTTL.defaultExpectation != nil {
+		mmUpdateTTL.mock.t.Fatalf("Default expectation is already set for the Agent.UpdateTTL method")
 	}
 
-	if len(mmSelf.expectations) > 0 {
-		mmSelf.mock.t.Fatalf("Some expectations are already set for the Agent.Self method")
+	if len(mmUpdateTTL.expectations) > 0 {
+		mmUpdateTTL.mock.t.Fatalf("Some expectations are already set for the Agent.UpdateTTL method")
 	}
 
-	mmSelf.mock.funcSelf = f
-	return mmSelf.mock
+	mmUpdateTTL.mock.funcUpdateTTL = f
+	return mmUpdateTTL.mock
 }
 
-// When sets expectation for the Agent.Self which will trigger the result defined by the following
+// When sets expectation for the Agent.UpdateTTL which will trigger the result defined by the following
 // Then helper
-func (mmSelf *mAgentMockSelf) When(ctx Ctx) *AgentMockSelfExpectation {
-	if mmSelf.mock.funcSelf != nil {
-		mmSelf.mock.t.Fatalf("AgentMock.Self mock is already set by Set")
+func (mmUpdateTTL *mAgentMockUpdateTTL) When(ctx Ctx, checkID string, output string, status CheckStatus) *AgentMockUpdateTTLExpectation {
+	if mmUpdateTTL.mock.funcUpdateTTL != nil {
+		mmUpdateTTL.mock.t.Fatalf("AgentMock.UpdateTTL mock is already set by Set")
 	}
 
-	expectation := &AgentMockSelfExpectation{
-		mock:   mmSelf.mock,
-		params: &AgentMockSelfParams{ctx},
+	expectation := &AgentMockUpdateTTLExpectation{
+		mock:   mmUpdateTTL.mock,
+		params: &AgentMockUpdateTTLParams{ctx, checkID, output, status},
 	}
-	mmSelf.expectations = append(mmSelf.expectations, expectation)
+	mmUpdateTTL.expectations = append(mmUpdateTTL.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.Self return parameters for the expectation previously defined by the When method
-func (e *AgentMockSelfExpectation) Then(a1 AgentInfo, err error) *AgentMock {
-	e.results = &AgentMockSelfResults{a1, err}
+// Then sets up Agent.UpdateTTL return parameters for the expectation previously defined by the When method
+func (e *AgentMockUpdateTTLExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockUpdateTTLResults{err}
 	return e.mock
 }
 
-// Self implements Agent
-func (mmSelf *AgentMock) Self(ctx Ctx) (a1 AgentInfo, err error) {
-	mm_atomic.AddUint64(&mmSelf.beforeSelfCounter, 1)
-	defer mm_atomic.AddUint64(&mmSelf.afterSelfCounter, 1)
+// UpdateTTL implements Agent
+func (mmUpdateTTL *AgentMock) UpdateTTL(ctx Ctx, checkID string, output string, status CheckStatus) (err error) {
+	mm_atomic.AddUint64(&mmUpdateTTL.beforeUpdateTTLCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateTTL.afterUpdateTTLCounter, 1)
 
-	if mmSelf.inspectFuncSelf != nil {
-		mmSelf.inspectFuncSelf(ctx)
+	if mmUpdateTTL.inspectFuncUpdateTTL != nil {
+		mmUpdateTTL.inspectFuncUpdateTTL(ctx, checkID, output, status)
 	}
 
-	mm_params := &AgentMockSelfParams{ctx}
+	mm_params := &AgentMockUpdateTTLParams{ctx, checkID, output, status}
 
 	// Record call args
-	mmSelf.SelfMock.mutex.Lock()
-	mmSelf.SelfMock.callArgs = append(mmSelf.SelfMock.callArgs, mm_params)
-	mmSelf.SelfMock.mutex.Unlock()
+	mmUpdateTTL.UpdateTTLMock.mutex.Lock()
+	mmUpdateTTL.UpdateTTLMock.callArgs = append(mmUpdateTTL.UpdateTTLMock.callArgs, mm_params)
+	mmUpdateTTL.UpdateTTLMock.mutex.Unlock()
 
-	for _, e := range mmSelf.SelfMock.expectations {
+	for _, e := range mmUpdateTTL.UpdateTTLMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.a1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmSelf.SelfMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSelf.SelfMock.defaultExpectation.Counter, 1)
-		mm_want := mmSelf.SelfMock.defaultExpectation.params
-		mm_got := AgentMockSelfParams{ctx}
+	if mmUpdateTTL.UpdateTTLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateTTL.UpdateTTLMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateTTL.UpdateTTLMock.defaultExpectation.params
+		mm_got := AgentMockUpdateTTLParams{ctx, checkID, output, status}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSelf.t.Errorf("AgentMock.Self got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateTTL.t.Errorf("AgentMock.UpdateTTL got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSelf.SelfMock.defaultExpectation.results
+		mm_results := mmUpdateTTL.UpdateTTLMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSelf.t.Fatal("No results are set for the AgentMock.Self")
+			mmUpdateTTL.t.Fatal("No results are set for the AgentMock.UpdateTTL")
 		}
-		return (*mm_results).a1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmSelf.funcSelf != nil {
-		return mmSelf.funcSelf(ctx)
+	if mmUpdateTTL.funcUpdateTTL != nil {
+		return mmUpdateTTL.funcUpdateTTL(ctx, checkID, output, status)
 	}
-	mmSelf.t.Fatalf("Unexpected call to AgentMock.Self. %v", ctx)
+	mmUpdateTTL.t.Fatalf("Unexpected call to AgentMock.UpdateTTL. %v %v %v %v", ctx, checkID, output, status)
 	return
 }
 
-// SelfAfterCounter returns a count of finished AgentMock.Self invocations
-func (mmSelf *AgentMock) SelfAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSelf.afterSelfCounter)
+// UpdateTTLAfterCounter returns a count of finished AgentMock.UpdateTTL invocations
+func (mmUpdateTTL *AgentMock) UpdateTTLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateTTL.afterUpdateTTLCounter)
 }
 
-// SelfBeforeCounter returns a count of AgentMock.Self invocations
-func (mmSelf *AgentMock) SelfBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSelf.beforeSelfCounter)
+// UpdateTTLBeforeCounter returns a count of AgentMock.UpdateTTL invocations
+func (mmUpdateTTL *AgentMock) UpdateTTLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateTTL.beforeUpdateTTLCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.Self.
+// Calls returns a list of arguments used in each call to AgentMock.UpdateTTL.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSelf *mAgentMockSelf) Calls() []*AgentMockSelfParams {
-	mmSelf.mutex.RLock()
+func (mmUpdateTTL *mAgentMockUpdateTTL) Calls() []*AgentMockUpdateTTLParams {
+	mmUpdateTTL.mutex.RLock()
 
-	argCopy := make([]*AgentMockSelfParams, len(mmSelf.callArgs))
-	copy(argCopy, mmSelf.callArgs)
+	argCopy := make([]*AgentMockUpdateTTLParams, len(mmUpdateTTL.callArgs))
+	copy(argCopy, mmUpdateTTL.callArgs)
 
-	mmSelf.mutex.RUnlock()
+	mmUpdateTTL.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSelfDone returns true if the count of the Self invocations corresponds
+// MinimockUpdateTTLDone returns true if the count of the UpdateTTL invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockSelfDone() bool {
-	for _, e := range m.SelfMock.expectations {
+func (m *AgentMock) MinimockUpdateTTLDone() bool {
+	for _, e := range m.UpdateTTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SelfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
+	if m.UpdateTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateTTLCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSelf != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
+	if m.funcUpdateTTL != nil && mm_atomic.LoadUint64(&m.afterUpdateTTLCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockSelfInspect logs each unmet expectation
-func (m *AgentMock) MinimockSelfInspect() {
-	for _, e := range m.SelfMock.expectations {
+// MinimockUpdateTTLInspect logs each unmet expectation
+func (m *AgentMock) MinimockUpdateTTLInspect() {
+	for _, e := range m.UpdateTTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.Self with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.UpdateTTL with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SelfMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
-		if m.SelfMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.Self")
+	if m.UpdateTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateTTLCounter) < 1 {
+		if m.UpdateTTLMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.UpdateTTL")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.Self with params: %#v", *m.SelfMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.UpdateTTL with params: %#v", *m.UpdateTTLMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSelf != nil && mm_atomic.LoadUint64(&m.afterSelfCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.Self")
+	if m.funcUpdateTTL != nil && mm_atomic.LoadUint64(&m.afterUpdateTTLCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.UpdateTTL")
 	}
 }
 
-type mAgentMockSetACLToken struct {
+type mAgentMockWarnTTL struct {
 	mock               *AgentMock
-	defaultExpectation *AgentMockSetACLTokenExpectation
-	expectations       []*AgentMockSetACLTokenExpectation
+	defaultExpectation *AgentMockWarnTTLExpectation
+	expectations       []*AgentMockWarnTTLExpectation
 
-	callArgs []*AgentMockSetACLTokenParams
+	callArgs []*AgentMockWarnTTLParams
 	mutex    sync.RWMutex
 }
 
-// AgentMockSetACLTokenExpectation specifies expectation struct of the Agent.SetACLToken
-type AgentMockSetACLTokenExpectation struct {
+// AgentMockWarnTTLExpectation specifies expectation struct of the Agent.WarnTTL
+type AgentMockWarnTTLExpectation struct {
 	mock    *AgentMock
-	params  *AgentMockSetACLTokenParams
-	results *AgentMockSetACLTokenResults
+	params  *AgentMockWarnTTLParams
+	results *AgentMockWarnTTLResults
 	Counter uint64
 }
 
-// AgentMockSetACLTokenParams contains parameters of the Agent.SetACLToken
-type AgentMockSetACLTokenParams struct {
-	ctx   Ctx
-	kind  string
-	token string
+// AgentMockWarnTTLParams contains parameters of the Agent.WarnTTL
+type AgentMockWarnTTLParams struct {
+	ctx     Ctx
+	checkID string
+	note    string
 }
 
-// AgentMockSetACLTokenResults contains results of the Agent.SetACLToken
-type AgentMockSetACLTokenResults struct {
+// AgentMockWarnTTLResults contains results of the Agent.WarnTTL
+type AgentMockWarnTTLResults struct {
 	err error
 }
 
-// Expect sets up expected params for Agent.SetACLToken
-func (mmSetACLToken *mAgentMockSetACLToken) Expect(ctx Ctx, kind string, token string) *mAgentMockSetACLToken {
-	if mmSetACLToken.mock.funcSetACLToken != nil {
-		mmSetACLToken.mock.t.Fatalf("AgentMock.SetACLToken mock is already set by Set")
+// Expect sets up expected params for Agent.WarnTTL
+func (mmWarnTTL *mAgentMockWarnTTL) Expect(ctx Ctx, checkID string, note string) *mAgentMockWarnTTL {
+	if mmWarnTTL.mock.funcWarnTTL != nil {
+		mmWarnTTL.mock.t.Fatalf("AgentMock.WarnTTL mock is already set by Set")
 	}
 
-	if mmSetACLToken.defaultExpectation == nil {
-		mmSetACLToken.defaultExpectation = &AgentMockSetACLTokenExpectation{}
+	if mmWarnTTL.defaultExpectation == nil {
+		mmWarnTTL.defaultExpectation = &AgentMockWarnTTLExpectation{}
 	}
 
-	mmSetACLToken.defaultExpectation.params = &AgentMockSetACLTokenParams{ctx, kind, token}
-	for _, e := range mmSetACLToken.expectations {
-		if minimock.Equal(e.params, mmSetACLToken.defaultExpectation.params) {
-			mmSetACLToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetACLToken.defaultExpectation.params)
+	mmWarnTTL.defaultExpectation.params = &AgentMockWarnTTLParams{ctx, checkID, note}
+	for _, e := range mmWarnTTL.expectations {
+		if minimock.Equal(e.params, mmWarnTTL.defaultExpectation.params) {
+			mmWarnTTL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWarnTTL.defaultExpectation.params)
 		}
 	}
 
-	return mmSetACLToken
+	return mmWarnTTL
 }
 
-// Inspect accepts an inspector function that has same arguments as the Agent.SetACLToken
-func (mmSetACLToken *mAgentMockSetACLToken) Inspect(f func(ctx Ctx, kind string, token string)) *mAgentMockSetACLToken {
-	if mmSetACLToken.mock.inspectFuncSetACLToken != nil {
-		mmSetACLToken.mock.t.Fatalf("Inspect function is already set for AgentMock.SetACLToken")
+// Inspect accepts an inspector function that has same arguments as the Agent.WarnTTL
+func (mmWarnTTL *mAgentMockWarnTTL) Inspect(f func(ctx Ctx, checkID string, note string)) *mAgentMockWarnTTL {
+	if mmWarnTTL.mock.inspectFuncWarnTTL != nil {
+		mmWarnTTL.mock.t.Fatalf("Inspect function is already set for AgentMock.WarnTTL")
 	}
 
-	mmSetACLToken.mock.inspectFuncSetACLToken = f
+	mmWarnTTL.mock.inspectFuncWarnTTL = f
 
-	return mmSetACLToken
+	return mmWarnTTL
 }
 
-// Return sets up results that will be returned by Agent.SetACLToken
-func (mmSetACLToken *mAgentMockSetACLToken) Return(err error) *AgentMock {
-	if mmSetACLToken.mock.funcSetACLToken != nil {
-		mmSetACLToken.mock.t.Fatalf("AgentMock.SetACLToken mock is already set by Set")
+// Return sets up results that will be returned by Agent.WarnTTL
+func (mmWarnTTL *mAgentMockWarnTTL) Return(err error) *AgentMock {
+	if mmWarnTTL.mock.funcWarnTTL != nil {
+		mmWarnTTL.mock.t.Fatalf("AgentMock.WarnTTL mock is already set by Set")
 	}
 
-	if mmSetACLToken.defaultExpectation == nil {
-		mmSetACLToken.defaultExpectation = &AgentMockSetACLTokenExpectation{mock: mmSetACLToken.mock}
+	if mmWarnTTL.defaultExpectation == nil {
+		mmWarnTTL.defaultExpectation = &AgentMockWarnTTLExpectation{mock: mmWarnTTL.mock}
 	}
-	mmSetACLToken.defaultExpectation.results = &AgentMockSetACLTokenResults{err}
-	return mmSetACLToken.mock
+	mmWarnTTL.defaultExpectation.results = &AgentMockWarnTTLResults{err}
+	return mmWarnTTL.mock
 }
 
-//Set uses given function f to mock the Agent.SetACLToken method
-func (mmSetACLToken *mAgentMockSetACLToken) Set(f func(ctx Ctx, kind string, token string) (err error)) *AgentMock {
-	if mmSetACLToken.defaultExpectation != nil {
-		mmSetACLToken.mock.t.Fatalf("Default expectation is already set for the Agent.SetACLToken method")
+//Set uses given function f to mock the Agent.WarnTTL method
+func (mmWarnTTL *mAgentMockWarnTTL) Set(f func(ctx Ctx, checkID string, note string) (err error)) *AgentMock {
+	if mmWarnTTL.defaultExpectation != nil {
+		mmWarnTTL.mock.t.Fatalf("Default expectation is already set for the Agent.WarnTTL method")
 	}
 
-	if len(mmSetACLToken.expectations) > 0 {
-		mmSetACLToken.mock.t.Fatalf("Some expectations are already set for the Agent.SetACLToken method")
+	if len(mmWarnTTL.expectations) > 0 {
+		mmWarnTTL.mock.t.Fatalf("Some expectations are already set for the Agent.WarnTTL method")
 	}
 
-	mmSetACLToken.mock.funcSetACLToken = f
-	return mmSetACLToken.mock
+	mmWarnTTL.mock.funcWarnTTL = f
+	return mmWarnTTL.mock
 }
 
-// When sets expectation for the Agent.SetACLToken which will trigger the result defined by the following
+// When sets expectation for the Agent.WarnTTL which will trigger the result defined by the following
 // Then helper
-func (mmSetACLToken *mAgentMockSetACLToken) When(ctx Ctx, kind string, token string) *AgentMockSetACLTokenExpectation {
-	if mmSetACLToken.mock.funcSetACLToken != nil {
-		mmSetACLToken.mock.t.Fatalf("AgentMock.SetACLToken mock is already set by Set")
+func (mmWarnTTL *mAgentMockWarnTTL) When(ctx Ctx, checkID string, note string) *AgentMockWarnTTLExpectation {
+	if mmWarnTTL.mock.funcWarnTTL != nil {
+		mmWarnTTL.mock.t.Fatalf("AgentMock.WarnTTL mock is already set by Set")
 	}
 
-	expectation := &AgentMockSetACLTokenExpectation{
-		mock:   mmSetACLToken.mock,
-		params: &AgentMockSetACLTokenParams{ctx, kind, token},
+	expectation := &AgentMockWarnTTLExpectation{
+		mock:   mmWarnTTL.mock,
+		params: &AgentMockWarnTTLParams{ctx, checkID, note},
 	}
-	mmSetACLToken.expectations = append(mmSetACLToken.expectations, expectation)
+	mmWarnTTL.expectations = append(mmWarnTTL.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Agent.SetACLToken return parameters for the expectation previously defined by the When method
-func (e *AgentMockSetACLTokenExpectation) Then(err error) *AgentMock {
-	e.results = &AgentMockSetACLTokenResults{err}
+// Then sets up Agent.WarnTTL return parameters for the expectation previously defined by the When method
+func (e *AgentMockWarnTTLExpectation) Then(err error) *AgentMock {
+	e.results = &AgentMockWarnTTLResults{err}
 	return e.mock
 }
 
-// SetACLToken implements Agent
-func (mmSetACLToken *AgentMock) SetACLToken(ctx Ctx, kind string, token string) (err error) {
-	mm_atomic.AddUint64(&mmSetACLToken.beforeSetACLTokenCounter, 1)
-	defer mm_atomic.AddUint64(&mmSetACLToken.afterSetACLTokenCounter, 1)
+// WarnTTL implements Agent
+func (mmWarnTTL *AgentMock) WarnTTL(ctx Ctx, checkID string, note string) (err error) {
+	mm_atomic.AddUint64(&mmWarnTTL.beforeWarnTTLCounter, 1)
+	defer mm_atomic.AddUint64(&mmWarnTTL.afterWarnTTLCounter, 1)
 
-	if mmSetACLToken.inspectFuncSetACLToken != nil {
-		mmSetACLToken.inspectFuncSetACLToken(ctx, kind, token)
+	if mmWarnTTL.inspectFuncWarnTTL != nil {
+		mmWarnTTL.inspectFuncWarnTTL(ctx, checkID, note)
 	}
 
-	mm_params := &AgentMockSetACLTokenParams{ctx, kind, token}
+	mm_params := &AgentMockWarnTTLParams{ctx, checkID, note}
 
 	// Record call args
-	mmSetACLToken.SetACLTokenMock.mutex.Lock()
-	mmSetACLToken.SetACLTokenMock.callArgs = append(mmSetACLToken.SetACLTokenMock.callArgs, mm_params)
-	mmSetACLToken.SetACLTokenMock.mutex.Unlock()
+	mmWarnTTL.WarnTTLMock.mutex.Lock()
+	mmWarnTTL.WarnTTLMock.callArgs = append(mmWarnTTL.WarnTTLMock.callArgs, mm_params)
+	mmWarnTTL.WarnTTLMock.mutex.Unlock()
 
-	for _, e := range mmSetACLToken.SetACLTokenMock.expectations {
+	for _, e := range mmWarnTTL.WarnTTLMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmSetACLToken.SetACLTokenMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSetACLToken.SetACLTokenMock.defaultExpectation.Counter, 1)
-		mm_want := mmSetACLToken.SetACLTokenMock.defaultExpectation.params
-		mm_got := AgentMockSetACLTokenParams{ctx, kind, token}
+	if mmWarnTTL.WarnTTLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWarnTTL.WarnTTLMock.defaultExpectation.Counter, 1)
+		mm_want := mmWarnTTL.WarnTTLMock.defaultExpectation.params
+		mm_got := AgentMockWarnTTLParams{ctx, checkID, note}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSetACLToken.t.Errorf("AgentMock.SetACLToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmWarnTTL.t.Errorf("AgentMock.WarnTTL got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSetACLToken.SetACLTokenMock.defaultExpectation.results
+		mm_results := mmWarnTTL.WarnTTLMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSetACLToken.t.Fatal("No results are set for the AgentMock.SetACLToken")
+			mmWarnTTL.t.Fatal("No results are set for the AgentMock.WarnTTL")
 		}
 		return (*mm_results).err
 	}
-	if mmSetACLToken.funcSetACLToken != nil {
-		return mmSetACLToken.funcSetACLToken(ctx, kind, token)
+	if mmWarnTTL.funcWarnTTL != nil {
+		return mmWarnTTL.funcWarnTTL(ctx, checkID, note)
 	}
-	mmSetACLToken.t.Fatalf("Unexpected call to AgentMock.SetACLToken. %v %v %v", ctx, kind, token)
+	mmWarnTTL.t.Fatalf("Unexpected call to AgentMock.WarnTTL. %v %v %v", ctx, checkID, note)
 	return
 }
 
-// SetACLTokenAfterCounter returns a count of finished AgentMock.SetACLToken invocations
-func (mmSetACLToken *AgentMock) SetACLTokenAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetACLToken.afterSetACLTokenCounter)
+// WarnTTLAfterCounter returns a count of finished AgentMock.WarnTTL invocations
+func (mmWarnTTL *AgentMock) WarnTTLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWarnTTL.afterWarnTTLCounter)
 }
 
-// SetACLTokenBeforeCounter returns a count of AgentMock.SetACLToken invocations
-func (mmSetACLToken *AgentMock) SetACLTokenBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetACLToken.beforeSetACLTokenCounter)
+// WarnTTLBeforeCounter returns a count of AgentMock.WarnTTL invocations
+func (mmWarnTTL *AgentMock) WarnTTLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWarnTTL.beforeWarnTTLCounter)
 }
 
-// Calls returns a list of arguments used in each call to AgentMock.SetACLToken.
+// Calls returns a list of arguments used in each call to AgentMock.WarnTTL.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSetACLToken *mAgentMockSetACLToken) Calls() []*AgentMockSetACLTokenParams {
-	mmSetACLToken.mutex.RLock()
+func (mmWarnTTL *mAgentMockWarnTTL) Calls() []*AgentMockWarnTTLParams {
+	mmWarnTTL.mutex.RLock()
 
-	argCopy := make([]*AgentMockSetACLTokenParams, len(mmSetACLToken.callArgs))
-	copy(argCopy, mmSetACLToken.callArgs)
+	argCopy := make([]*AgentMockWarnTTLParams, len(mmWarnTTL.callArgs))
+	copy(argCopy, mmWarnTTL.callArgs)
 
-	mmSetACLToken.mutex.RUnlock()
+	mmWarnTTL.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSetACLTokenDone returns true if the count of the SetACLToken invocations corresponds
+// MinimockWarnTTLDone returns true if the count of the WarnTTL invocations corresponds
 // the number of defined expectations
-func (m *AgentMock) MinimockSetACLTokenDone() bool {
-	for _, e := range m.SetACLTokenMock.expectations {
+func (m *AgentMock) MinimockWarnTTLDone() bool {
+	for _, e := range m.WarnTTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetACLTokenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
+	if m.WarnTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterWarnTTLCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetACLToken != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
+	if m.funcWarnTTL != nil && mm_atomic.LoadUint64(&m.afterWarnTTLCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockSetACLTokenInspect logs each unmet expectation
-func (m *AgentMock) MinimockSetACLTokenInspect() {
-	for _, e := range m.SetACLTokenMock.expectations {
+// MinimockWarnTTLInspect logs each unmet expectation
+func (m *AgentMock) MinimockWarnTTLInspect() {
+	for _, e := range m.WarnTTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AgentMock.SetACLToken with params: %#v", *e.params)
+			m.t.Errorf("Expected call to AgentMock.WarnTTL with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetACLTokenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
-		if m.SetACLTokenMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to AgentMock.SetACLToken")
+	if m.WarnTTLMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterWarnTTLCounter) < 1 {
+		if m.WarnTTLMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AgentMock.WarnTTL")
 		} else {
-			m.t.Errorf("Expected call to AgentMock.SetACLToken with params: %#v", *m.SetACLTokenMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AgentMock.WarnTTL with params: %#v", *m.WarnTTLMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetACLToken != nil && mm_atomic.LoadUint64(&m.afterSetACLTokenCounter) < 1 {
-		m.t.Error("Expected call to AgentMock.SetACLToken")
+	if m.funcWarnTTL != nil && mm_atomic.LoadUint64(&m.afterWarnTTLCounter) < 1 {
+		m.t.Error("Expected call to AgentMock.WarnTTL")
 	}
 }
 
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *AgentMock) MinimockFinish() {
 	if !m.minimockDone() {
+		m.MinimockDeregisterCheckInspect()
+
+		m.MinimockDeregisterServiceInspect()
+
+		m.MinimockFailTTLInspect()
+
 		m.MinimockForceLeaveInspect()
 
 		m.MinimockJoinInspect()
@@ -2067,11 +4106,23 @@ func (m *AgentMock) MinimockFinish() {
 
 		m.MinimockMetricsInspect()
 
+		m.MinimockPassTTLInspect()
+
+		m.MinimockRegisterCheckInspect()
+
+		m.MinimockRegisterServiceInspect()
+
 		m.MinimockReloadInspect()
 
 		m.MinimockSelfInspect()
 
+		m.MinimockServiceMaintenanceModeInspect()
+
 		m.MinimockSetACLTokenInspect()
+
+		m.MinimockUpdateTTLInspect()
+
+		m.MinimockWarnTTLInspect()
 		m.t.FailNow()
 	}
 }
@@ -2095,13 +4146,22 @@ func (m *AgentMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AgentMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockDeregisterCheckDone() &&
+		m.MinimockDeregisterServiceDone() &&
+		m.MinimockFailTTLDone() &&
 		m.MinimockForceLeaveDone() &&
 		m.MinimockJoinDone() &&
 		m.MinimockLeaveDone() &&
 		m.MinimockMaintenanceModeDone() &&
 		m.MinimockMembersDone() &&
 		m.MinimockMetricsDone() &&
+		m.MinimockPassTTLDone() &&
+		m.MinimockRegisterCheckDone() &&
+		m.MinimockRegisterServiceDone() &&
 		m.MinimockReloadDone() &&
 		m.MinimockSelfDone() &&
-		m.MinimockSetACLTokenDone()
+		m.MinimockServiceMaintenanceModeDone() &&
+		m.MinimockSetACLTokenDone() &&
+		m.MinimockUpdateTTLDone() &&
+		m.MinimockWarnTTLDone()
 }
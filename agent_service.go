@@ -0,0 +1,446 @@
+package consulapi
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// An AgentServiceRegistration describes a service to be registered with the
+// local agent using RegisterService.
+//
+// https://www.consul.io/docs/agent/services.html
+type AgentServiceRegistration struct {
+	// Kind is the kind of service. Blank for typical services, otherwise
+	// one of "connect-proxy", "mesh-gateway", "terminating-gateway" or
+	// "ingress-gateway".
+	Kind string
+
+	// ID uniquely identifies the service instance on the agent. If not set,
+	// the ID defaults to Name.
+	ID string
+
+	// Name of the service. Required, except for a SidecarService.
+	Name string
+
+	// Tags are used to filter service instances in catalog and health queries.
+	Tags []string
+
+	// Port on which the service is listening.
+	Port int
+
+	// Address on which the service is listening. If not set, the address of
+	// the agent node is used.
+	Address string
+
+	// TaggedAddresses are additional addresses of the service, e.g. "lan"
+	// or "wan", keyed by tag.
+	TaggedAddresses map[string]Address
+
+	// EnableTagOverride allows the tags of the service to be modified by
+	// third parties through the catalog.
+	EnableTagOverride bool
+
+	// Meta is arbitrary key-value metadata associated with the service.
+	Meta map[string]string
+
+	// Weights (optional) determine the weight of the service in DNS responses,
+	// depending on whether the service is passing or warning.
+	Weights *Weights
+
+	// Checks are the health checks of the service. The ServiceID of each
+	// check is ignored.
+	Checks []AgentCheckRegistration
+
+	// Proxy configures the service if Kind is "connect-proxy", or the
+	// proxy of a SidecarService.
+	Proxy *Proxy
+
+	// Connect (optional) configures consul CONNECT for the service.
+	Connect *AgentServiceConnect
+}
+
+// AgentServiceConnect configures consul CONNECT for a service registration.
+type AgentServiceConnect struct {
+	// Native indicates the service natively supports consul CONNECT.
+	Native bool
+
+	// SidecarService (optional) registers a sidecar proxy alongside the
+	// service. Most fields may be left blank, and will be filled in with
+	// defaults derived from the parent service.
+	SidecarService *AgentServiceRegistration
+}
+
+// An AgentCheckRegistration describes a health check to be registered with
+// the local agent, either on its own using RegisterCheck, or as part of an
+// AgentServiceRegistration.
+//
+// Exactly one kind of check must be configured, by setting one of HTTP, TCP,
+// GRPC, ScriptArgs, TTL or AliasService.
+//
+// https://www.consul.io/docs/agent/checks.html
+type AgentCheckRegistration struct {
+	// ID uniquely identifies the check on the agent. If not set, the ID
+	// defaults to Name.
+	ID string
+
+	// Name of the check. Required when using RegisterCheck.
+	Name string
+
+	// Notes is an opaque human-readable description of the check.
+	Notes string
+
+	// ServiceID associates the check with a service registered on the agent.
+	// Only used by RegisterCheck.
+	ServiceID string
+
+	// Status is the initial state of the check. If not set, the check starts
+	// in the critical state.
+	Status CheckStatus
+
+	// HTTP is the URL to be queried by an HTTP check. The check is passing
+	// if the response has a 2xx status code.
+	HTTP string
+
+	// Method is the HTTP method used by an HTTP check. If not set, GET is used.
+	Method string
+
+	// Header contains the headers set on the request of an HTTP check.
+	Header map[string][]string
+
+	// Body is sent as the body of the request of an HTTP check.
+	Body string
+
+	// TLSServerName sets the SNI of an HTTPS or gRPC check.
+	TLSServerName string
+
+	// TLSSkipVerify disables verification of the certificate of an HTTPS or
+	// gRPC check.
+	TLSSkipVerify bool
+
+	// TCP is the host:port to be connected to by a TCP check.
+	TCP string
+
+	// GRPC is the host:port/service of the gRPC health checking endpoint to be
+	// queried by a gRPC check.
+	GRPC string
+
+	// GRPCUseTLS enables TLS for a gRPC check.
+	GRPCUseTLS bool
+
+	// ScriptArgs is the command and arguments run by a script check. Script
+	// checks must be enabled in the configuration of the agent.
+	ScriptArgs []string
+
+	// TTL is how long the check remains in its state before becoming
+	// critical, unless updated through PassTTL, WarnTTL, FailTTL or UpdateTTL.
+	TTL time.Duration
+
+	// AliasService is the ID of a service whose health is mirrored by an
+	// alias check.
+	AliasService string
+
+	// AliasNode is the node of AliasService. If not set, the service is
+	// assumed to be on the same node as the agent.
+	AliasNode string
+
+	// Interval is how often the check is run. Required by HTTP, TCP, gRPC
+	// and script checks.
+	Interval time.Duration
+
+	// Timeout is how long the check waits for a response, for HTTP, TCP,
+	// gRPC and script checks.
+	Timeout time.Duration
+
+	// DeregisterCriticalServiceAfter (optional) causes the service of the
+	// check to be deregistered after it has been critical for this long.
+	DeregisterCriticalServiceAfter time.Duration
+
+	// SuccessBeforePassing is the number of consecutive successes required
+	// before the check becomes passing.
+	SuccessBeforePassing int
+
+	// FailuresBeforeCritical is the number of consecutive failures required
+	// before the check becomes critical.
+	FailuresBeforeCritical int
+}
+
+type checkFormat struct {
+	ID                             string              `json:"ID,omitempty"`
+	Name                           string              `json:"Name,omitempty"`
+	Notes                          string              `json:"Notes,omitempty"`
+	ServiceID                      string              `json:"ServiceID,omitempty"`
+	Status                         string              `json:"Status,omitempty"`
+	HTTP                           string              `json:"HTTP,omitempty"`
+	Method                         string              `json:"Method,omitempty"`
+	Header                         map[string][]string `json:"Header,omitempty"`
+	Body                           string              `json:"Body,omitempty"`
+	TLSServerName                  string              `json:"TLSServerName,omitempty"`
+	TLSSkipVerify                  bool                `json:"TLSSkipVerify,omitempty"`
+	TCP                            string              `json:"TCP,omitempty"`
+	GRPC                           string              `json:"GRPC,omitempty"`
+	GRPCUseTLS                     bool                `json:"GRPCUseTLS,omitempty"`
+	ScriptArgs                     []string            `json:"ScriptArgs,omitempty"`
+	TTL                            string              `json:"TTL,omitempty"`
+	AliasService                   string              `json:"AliasService,omitempty"`
+	AliasNode                      string              `json:"AliasNode,omitempty"`
+	Interval                       string              `json:"Interval,omitempty"`
+	Timeout                        string              `json:"Timeout,omitempty"`
+	DeregisterCriticalServiceAfter string              `json:"DeregisterCriticalServiceAfter,omitempty"`
+	SuccessBeforePassing           int                 `json:"SuccessBeforePassing,omitempty"`
+	FailuresBeforeCritical         int                 `json:"FailuresBeforeCritical,omitempty"`
+}
+
+type serviceFormat struct {
+	Kind              string             `json:"Kind,omitempty"`
+	ID                string             `json:"ID,omitempty"`
+	Name              string             `json:"Name,omitempty"`
+	Tags              []string           `json:"Tags,omitempty"`
+	Port              int                `json:"Port,omitempty"`
+	Address           string             `json:"Address,omitempty"`
+	TaggedAddresses   map[string]Address `json:"TaggedAddresses,omitempty"`
+	EnableTagOverride bool               `json:"EnableTagOverride,omitempty"`
+	Meta              map[string]string  `json:"Meta,omitempty"`
+	Weights           *Weights           `json:"Weights,omitempty"`
+	Checks            []checkFormat      `json:"Checks,omitempty"`
+	Proxy             *Proxy             `json:"Proxy,omitempty"`
+	Connect           *connectFormat     `json:"Connect,omitempty"`
+}
+
+type connectFormat struct {
+	Native         bool           `json:"Native,omitempty"`
+	SidecarService *serviceFormat `json:"SidecarService,omitempty"`
+}
+
+func durationString(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+func internalizeCheck(check AgentCheckRegistration) (checkFormat, error) {
+	kinds := 0
+	for _, set := range []bool{
+		check.HTTP != "",
+		check.TCP != "",
+		check.GRPC != "",
+		len(check.ScriptArgs) > 0,
+		check.TTL != 0,
+		check.AliasService != "",
+	} {
+		if set {
+			kinds++
+		}
+	}
+
+	switch {
+	case kinds == 0:
+		return checkFormat{}, errors.New("check must be one of HTTP, TCP, GRPC, ScriptArgs, TTL or AliasService")
+	case kinds > 1:
+		return checkFormat{}, errors.New("check must be only one of HTTP, TCP, GRPC, ScriptArgs, TTL or AliasService")
+	}
+
+	needsInterval := check.HTTP != "" || check.TCP != "" || check.GRPC != "" || len(check.ScriptArgs) > 0
+	if needsInterval && check.Interval <= 0 {
+		return checkFormat{}, errors.New("check interval required for HTTP, TCP, GRPC and ScriptArgs checks")
+	}
+
+	return checkFormat{
+		ID:                             check.ID,
+		Name:                           check.Name,
+		Notes:                          check.Notes,
+		ServiceID:                      check.ServiceID,
+		Status:                         string(check.Status),
+		HTTP:                           check.HTTP,
+		Method:                         check.Method,
+		Header:                         check.Header,
+		Body:                           check.Body,
+		TLSServerName:                  check.TLSServerName,
+		TLSSkipVerify:                  check.TLSSkipVerify,
+		TCP:                            check.TCP,
+		GRPC:                           check.GRPC,
+		GRPCUseTLS:                     check.GRPCUseTLS,
+		ScriptArgs:                     check.ScriptArgs,
+		TTL:                            durationString(check.TTL),
+		AliasService:                   check.AliasService,
+		AliasNode:                      check.AliasNode,
+		Interval:                       durationString(check.Interval),
+		Timeout:                        durationString(check.Timeout),
+		DeregisterCriticalServiceAfter: durationString(check.DeregisterCriticalServiceAfter),
+		SuccessBeforePassing:           check.SuccessBeforePassing,
+		FailuresBeforeCritical:         check.FailuresBeforeCritical,
+	}, nil
+}
+
+func internalizeService(registration AgentServiceRegistration, sidecar bool) (serviceFormat, error) {
+	if registration.Name == "" && !sidecar {
+		return serviceFormat{}, errors.New("service name required")
+	}
+
+	checks := make([]checkFormat, 0, len(registration.Checks))
+	for _, check := range registration.Checks {
+		check.ServiceID = "" // implied by the service
+		ic, err := internalizeCheck(check)
+		if err != nil {
+			return serviceFormat{}, err
+		}
+		checks = append(checks, ic)
+	}
+
+	var connect *connectFormat
+	if registration.Connect != nil {
+		connect = &connectFormat{Native: registration.Connect.Native}
+		if registration.Connect.SidecarService != nil {
+			if sidecar {
+				return serviceFormat{}, errors.New("sidecar service cannot have a sidecar service")
+			}
+			isc, err := internalizeService(*registration.Connect.SidecarService, true)
+			if err != nil {
+				return serviceFormat{}, errors.Wrap(err, "invalid sidecar service")
+			}
+			connect.SidecarService = &isc
+		}
+	}
+
+	return serviceFormat{
+		Kind:              registration.Kind,
+		ID:                registration.ID,
+		Name:              registration.Name,
+		Tags:              registration.Tags,
+		Port:              registration.Port,
+		Address:           registration.Address,
+		TaggedAddresses:   registration.TaggedAddresses,
+		EnableTagOverride: registration.EnableTagOverride,
+		Meta:              registration.Meta,
+		Weights:           registration.Weights,
+		Checks:            checks,
+		Proxy:             registration.Proxy,
+		Connect:           connect,
+	}, nil
+}
+
+func (c *client) RegisterService(ctx Ctx, registration AgentServiceRegistration, replaceChecks bool) error {
+	isr, err := internalizeService(registration, false)
+	if err != nil {
+		return err
+	}
+
+	bs, err := json.Marshal(isr)
+	if err != nil {
+		return errors.Wrap(err, "unable to create service payload")
+	}
+
+	var params [][2]string
+	if replaceChecks {
+		params = append(params, [2]string{"replace-existing-checks", "true"})
+	}
+
+	rPath := fixup("/v1/agent/service", "/register", params...)
+	if err := c.put(ctx, rPath, string(bs), nil); err != nil {
+		return errors.Wrap(err, "failed to register service")
+	}
+
+	return nil
+}
+
+func (c *client) DeregisterService(ctx Ctx, serviceID string) error {
+	rPath := fixup("/v1/agent/service/deregister", serviceID)
+	if err := c.put(ctx, rPath, "", nil); err != nil {
+		return errors.Wrap(err, "failed to deregister service")
+	}
+	return nil
+}
+
+func (c *client) ServiceMaintenanceMode(ctx Ctx, serviceID string, enabled bool, reason string) error {
+	enableS := strconv.FormatBool(enabled)
+	rPath := fixup(
+		"/v1/agent/service/maintenance", serviceID,
+		[2]string{"enable", enableS}, [2]string{"reason", reason},
+	)
+	if err := c.put(ctx, rPath, "", nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) RegisterCheck(ctx Ctx, check AgentCheckRegistration) error {
+	if check.Name == "" {
+		return errors.New("check name required")
+	}
+
+	icr, err := internalizeCheck(check)
+	if err != nil {
+		return err
+	}
+
+	bs, err := json.Marshal(icr)
+	if err != nil {
+		return errors.Wrap(err, "unable to create check payload")
+	}
+
+	rPath := fixup("/v1/agent/check", "/register")
+	if err := c.put(ctx, rPath, string(bs), nil); err != nil {
+		return errors.Wrap(err, "failed to register check")
+	}
+
+	return nil
+}
+
+func (c *client) DeregisterCheck(ctx Ctx, checkID string) error {
+	rPath := fixup("/v1/agent/check/deregister", checkID)
+	if err := c.put(ctx, rPath, "", nil); err != nil {
+		return errors.Wrap(err, "failed to deregister check")
+	}
+	return nil
+}
+
+func (c *client) PassTTL(ctx Ctx, checkID, note string) error {
+	return c.ttl(ctx, "pass", checkID, note)
+}
+
+func (c *client) WarnTTL(ctx Ctx, checkID, note string) error {
+	return c.ttl(ctx, "warn", checkID, note)
+}
+
+func (c *client) FailTTL(ctx Ctx, checkID, note string) error {
+	return c.ttl(ctx, "fail", checkID, note)
+}
+
+func (c *client) ttl(ctx Ctx, verb, checkID, note string) error {
+	rPath := fixup("/v1/agent/check/"+verb, checkID, [2]string{"note", note})
+	if err := c.put(ctx, rPath, "", nil); err != nil {
+		return errors.Wrapf(err, "failed to %s ttl check", verb)
+	}
+	return nil
+}
+
+type updateTTL struct {
+	Status string `json:"Status"`
+	Output string `json:"Output,omitempty"`
+}
+
+func (c *client) UpdateTTL(ctx Ctx, checkID, output string, status CheckStatus) error {
+	switch status {
+	case CheckPassing, CheckWarning, CheckCritical:
+	default:
+		return errors.Errorf("ttl check status must be passing, warning or critical, not %q", status)
+	}
+
+	bs, err := json.Marshal(updateTTL{
+		Status: string(status),
+		Output: output,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to create check update payload")
+	}
+
+	rPath := fixup("/v1/agent/check/update", checkID)
+	if err := c.put(ctx, rPath, string(bs), nil); err != nil {
+		return errors.Wrap(err, "failed to update ttl check")
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package consulapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// These tests are for the /v1/agent/service/* and /v1/agent/check/* endpoints
+//
+// https://www.consul.io/api/agent/service.html
+// https://www.consul.io/api/agent/check.html
+
+func Test_Client_v1_agent_service_register(t *testing.T) {
+	expPayload := `{"ID":"myapp-1","Name":"myapp","Tags":["primary"],"Port":8000,` +
+		`"TaggedAddresses":{"wan":{"address":"1.1.1.1","port":8080}},"Meta":{"version":"1.2"},` +
+		`"Weights":{"Passing":10,"Warning":1},"Checks":[` +
+		`{"Name":"http","HTTP":"http://localhost:8000/health","Interval":"10s","Timeout":"1s"},` +
+		`{"Name":"ttl","TTL":"30s"},` +
+		`{"Name":"alias","AliasService":"redis"}],` +
+		`"Connect":{"SidecarService":{"Port":21000,"Proxy":{"DestinationServiceName":"",` +
+		`"DestinationServiceID":"","LocalServiceAddress":"","LocalServicePort":0,"Config":null,` +
+		`"Upstreams":[{"DestinationName":"redis","LocalBindPort":6379}]}}}}`
+
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/service/register",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"replace-existing-checks": {"true"},
+		},
+		hasBody: expPayload,
+	})
+	defer ts.Close()
+
+	err := client.RegisterService(ctx, AgentServiceRegistration{
+		ID:   "myapp-1",
+		Name: "myapp",
+		Tags: []string{"primary"},
+		Port: 8000,
+		TaggedAddresses: map[string]Address{
+			"wan": {Address: "1.1.1.1", Port: 8080},
+		},
+		Meta:    map[string]string{"version": "1.2"},
+		Weights: &Weights{Passing: 10, Warning: 1},
+		Checks: []AgentCheckRegistration{{
+			Name:     "http",
+			HTTP:     "http://localhost:8000/health",
+			Interval: 10 * time.Second,
+			Timeout:  1 * time.Second,
+		}, {
+			Name:      "ttl",
+			ServiceID: "ignored",
+			TTL:       30 * time.Second,
+		}, {
+			Name:         "alias",
+			AliasService: "redis",
+		}},
+		Connect: &AgentServiceConnect{
+			SidecarService: &AgentServiceRegistration{
+				Port: 21000,
+				Proxy: &Proxy{
+					Upstreams: []Upstream{{
+						DestinationName: "redis",
+						LocalBindPort:   6379,
+					}},
+				},
+			},
+		},
+	}, true)
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_service_register_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/agent/service/register",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{"Name":"myapp","Port":8000}`,
+	})
+	defer ts.Close()
+
+	err := client.RegisterService(ctx, AgentServiceRegistration{
+		Name: "myapp",
+		Port: 8000,
+	}, false)
+	require.EqualError(t, err, "failed to register service: PUT /v1/agent/service/register: status code (500): malfunction")
+}
+
+func Test_Client_v1_agent_service_register_invalid(t *testing.T) {
+	ctx, ts, client := testClient(&responder{t: t})
+	defer ts.Close()
+
+	err := client.RegisterService(ctx, AgentServiceRegistration{
+		Port: 8000,
+	}, false)
+	require.EqualError(t, err, "service name required")
+
+	err = client.RegisterService(ctx, AgentServiceRegistration{
+		Name: "myapp",
+		Checks: []AgentCheckRegistration{{
+			Name: "tcp",
+			TCP:  "localhost:8000",
+		}},
+	}, false)
+	require.EqualError(t, err, "check interval required for HTTP, TCP, GRPC and ScriptArgs checks")
+
+	err = client.RegisterService(ctx, AgentServiceRegistration{
+		Name: "myapp",
+		Checks: []AgentCheckRegistration{{
+			Name:     "both",
+			TCP:      "localhost:8000",
+			GRPC:     "localhost:8000/myapp",
+			Interval: 1 * time.Second,
+		}},
+	}, false)
+	require.EqualError(t, err, "check must be only one of HTTP, TCP, GRPC, ScriptArgs, TTL or AliasService")
+}
+
+func Test_Client_v1_agent_service_deregister(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/service/deregister/myapp-1",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	err := client.DeregisterService(ctx, "myapp-1")
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_service_maintenance(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/service/maintenance/myapp-1",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"enable": {"true"},
+			"reason": {"deploying"},
+		},
+	})
+	defer ts.Close()
+
+	err := client.ServiceMaintenanceMode(ctx, "myapp-1", true, "deploying")
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_check_register(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/check/register",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"ID":"myapp-grpc","Name":"grpc","ServiceID":"myapp-1","Status":"passing",` +
+			`"GRPC":"localhost:8000/myapp","GRPCUseTLS":true,"Interval":"5s",` +
+			`"DeregisterCriticalServiceAfter":"1h0m0s"}`,
+	})
+	defer ts.Close()
+
+	err := client.RegisterCheck(ctx, AgentCheckRegistration{
+		ID:                             "myapp-grpc",
+		Name:                           "grpc",
+		ServiceID:                      "myapp-1",
+		Status:                         CheckPassing,
+		GRPC:                           "localhost:8000/myapp",
+		GRPCUseTLS:                     true,
+		Interval:                       5 * time.Second,
+		DeregisterCriticalServiceAfter: 1 * time.Hour,
+	})
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_check_register_no_name(t *testing.T) {
+	ctx, ts, client := testClient(&responder{t: t})
+	defer ts.Close()
+
+	err := client.RegisterCheck(ctx, AgentCheckRegistration{
+		TTL: 10 * time.Second,
+	})
+	require.EqualError(t, err, "check name required")
+}
+
+func Test_Client_v1_agent_check_deregister(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/check/deregister/myapp-grpc",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	err := client.DeregisterCheck(ctx, "myapp-grpc")
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_check_pass(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/check/pass/myapp-ttl",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"note": {"all good"},
+		},
+	})
+	defer ts.Close()
+
+	err := client.PassTTL(ctx, "myapp-ttl", "all good")
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_check_warn(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/check/warn/myapp-ttl",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	err := client.WarnTTL(ctx, "myapp-ttl", "")
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_check_fail_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusNotFound,
+		body:      "",
+		hasPath:   "/v1/agent/check/fail/myapp-ttl",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"note": {"oops"},
+		},
+	})
+	defer ts.Close()
+
+	err := client.FailTTL(ctx, "myapp-ttl", "oops")
+	require.EqualError(t, err, "failed to fail ttl check: PUT /v1/agent/check/fail/myapp-ttl?note=oops: status code (404)")
+}
+
+func Test_Client_v1_agent_check_update(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/check/update/myapp-ttl",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{"Status":"warning","Output":"queue is backing up"}`,
+	})
+	defer ts.Close()
+
+	err := client.UpdateTTL(ctx, "myapp-ttl", "queue is backing up", CheckWarning)
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_check_update_bad_status(t *testing.T) {
+	ctx, ts, client := testClient(&responder{t: t})
+	defer ts.Close()
+
+	err := client.UpdateTTL(ctx, "myapp-ttl", "", CheckMaintenance)
+	require.EqualError(t, err, `ttl check status must be passing, warning or critical, not "maintenance"`)
+}
@@ -44,7 +44,7 @@ func Test_Client_v1_agent_self_err(t *testing.T) {
 	defer ts.Close()
 
 	_, err := client.Self(ctx)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/agent/self: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_members(t *testing.T) {
@@ -75,7 +75,7 @@ func Test_Client_v1_agent_members_err(t *testing.T) {
 	defer ts.Close()
 
 	_, err := client.Members(ctx, false)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/agent/members: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_members_wan(t *testing.T) {
@@ -108,7 +108,7 @@ func Test_Client_v1_agent_members_wan_err(t *testing.T) {
 	defer ts.Close()
 
 	_, err := client.Members(ctx, true)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/agent/members?wan=true: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_reload(t *testing.T) {
@@ -136,7 +136,7 @@ func Test_Client_v1_agent_reload_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.Reload(ctx)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/reload: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_maintenance_enable(t *testing.T) {
@@ -172,7 +172,7 @@ func Test_Client_v1_agent_maintenance_enable_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.MaintenanceMode(ctx, true, "my reason")
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/maintenance?enable=true&reason=my+reason: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_maintenance_disable(t *testing.T) {
@@ -208,7 +208,7 @@ func Test_Client_v1_agent_maintenance_disable_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.MaintenanceMode(ctx, false, "my reason")
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/maintenance?enable=false&reason=my+reason: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_metrics(t *testing.T) {
@@ -238,7 +238,7 @@ func Test_Client_v1_agent_metrics_err(t *testing.T) {
 	defer ts.Close()
 
 	_, err := client.Metrics(ctx)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/agent/metrics: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_join(t *testing.T) {
@@ -268,7 +268,7 @@ func Test_Client_v1_agent_join_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.Join(ctx, "10.0.0.1", false)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/join/10.0.0.1?wan=false: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_join_wan(t *testing.T) {
@@ -298,7 +298,7 @@ func Test_Client_v1_agent_join_wan_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.Join(ctx, "10.0.0.1", true)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/join/10.0.0.1?wan=true: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_leave(t *testing.T) {
@@ -326,7 +326,7 @@ func Test_Client_v1_agent_leave_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.Leave(ctx)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/leave: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_forceLeave(t *testing.T) {
@@ -354,7 +354,7 @@ func Test_Client_v1_agent_forceLeave_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.ForceLeave(ctx, "badNode1")
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/force-leave/badNode1: status code (500): malfunction")
 }
 
 func Test_Client_v1_agent_token_unknown_kind(t *testing.T) {
@@ -392,5 +392,87 @@ func Test_Client_v1_agent_token_default_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.SetACLToken(ctx, "default", "abc123")
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/agent/token/default: status code (500)")
+}
+
+func Test_Client_v1_agent_token_dns(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/token/dns",
+		hasMethod: http.MethodPut,
+		hasBody:   `{"Token":"abc123"}`,
+	})
+	defer ts.Close()
+
+	err := client.SetACLToken(ctx, AgentTokenDNS, "abc123")
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_token_agent_recovery_fallback(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusNotFound,
+		body:      "",
+		hasPath:   "/v1/agent/token/agent_recovery",
+		hasMethod: http.MethodPut,
+		hasBody:   `{"Token":"abc123"}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/agent/token/agent_master",
+		hasMethod: http.MethodPut,
+		hasBody:   `{"Token":"abc123"}`,
+	}}})
+	defer ts.Close()
+
+	err := client.SetACLToken(ctx, AgentTokenAgentRecovery, "abc123")
+	require.NoError(t, err)
+}
+
+func Test_Client_v1_agent_token_agent_recovery_fallback_err(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusNotFound,
+		body:      "",
+		hasPath:   "/v1/agent/token/agent_recovery",
+		hasMethod: http.MethodPut,
+		hasBody:   `{"Token":"abc123"}`,
+	}, {
+		t:         t,
+		code:      http.StatusNotFound,
+		body:      "",
+		hasPath:   "/v1/agent/token/agent_master",
+		hasMethod: http.MethodPut,
+		hasBody:   `{"Token":"abc123"}`,
+	}, {
+		t:         t,
+		code:      http.StatusForbidden,
+		body:      "",
+		hasPath:   "/v1/agent/token/acl_agent_master_token",
+		hasMethod: http.MethodPut,
+		hasBody:   `{"Token":"abc123"}`,
+	}}})
+	defer ts.Close()
+
+	err := client.SetACLToken(ctx, AgentTokenAgentRecovery, "abc123")
+	require.EqualError(t, err, "PUT /v1/agent/token/acl_agent_master_token: status code (403)")
+	require.True(t, IsPermissionDenied(err))
+}
+
+func Test_Client_v1_agent_token_err_no_fallback(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusForbidden,
+		body:      "",
+		hasPath:   "/v1/agent/token/agent_recovery",
+		hasMethod: http.MethodPut,
+		hasBody:   `{"Token":"abc123"}`,
+	})
+	defer ts.Close()
+
+	err := client.SetACLToken(ctx, AgentTokenAgentRecovery, "abc123")
+	require.EqualError(t, err, "PUT /v1/agent/token/agent_recovery: status code (403)")
 }
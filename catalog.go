@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"time"
 )
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Catalog -s _mock.go
@@ -12,7 +13,7 @@ import (
 //
 // Note that the register and deregister endpoints are not implemented. Per the
 // consul documentation, it is preferable to make use of the Agent endpoints
-// for service registrations.
+// for service registrations (i.e. Agent.RegisterService).
 type Catalog interface {
 
 	// DataCenters returns the list of all known DCs. The order of the
@@ -25,31 +26,31 @@ type Catalog interface {
 	// Nodes will return the list of nodes in dc.
 	//
 	// https://www.consul.io/api/catalog.html#list-nodes
-	Nodes(Ctx, NodesQuery) ([]Node, error)
+	Nodes(Ctx, NodesQuery) ([]Node, QueryMeta, error)
 
 	// Node will return detailed meta information associated
 	// a particular node in dc.
 	//
 	// https://www.consul.io/api/catalog.html#list-services-for-node
-	Node(Ctx, string, NodeQuery) (NodeInfo, error)
+	Node(Ctx, string, NodeQuery) (NodeInfo, QueryMeta, error)
 
 	// Services will return a list of names of services
 	// in dc, along with the associated tags for each service.
 	//
 	// https://www.consul.io/api/catalog.html#list-services
-	Services(Ctx, ServicesQuery) (map[string][]string, error)
+	Services(Ctx, ServicesQuery) (map[string][]string, QueryMeta, error)
 
 	// Service returns detailed meta information about a particular
 	// named service, in dc, which matches all of the listed tags.
 	//
 	// https://www.consul.io/api/catalog.html#list-nodes-for-service
-	Service(Ctx, string, ServiceQuery) ([]Instance, error)
+	Service(Ctx, string, ServiceQuery) ([]Instance, QueryMeta, error)
 
 	// Connect returns the detailed meta information about a particular
 	// consul CONNECT enabled service in a given DC.
 	//
 	// https://www.consul.io/api/catalog.html#list-nodes-for-connect-capable-service
-	Connect(Ctx, string, ServiceQuery) ([]Instance, error)
+	Connect(Ctx, string, ServiceQuery) ([]Instance, QueryMeta, error)
 }
 
 func (c *client) DataCenters(ctx Ctx) ([]string, error) {
@@ -93,6 +94,16 @@ type NodesQuery struct {
 	//
 	// https://www.consul.io/api/features/filtering.html
 	Filter string
+
+	// WaitIndex turns the request into a blocking query. When set, consul will
+	// not respond until the index of the data being read is greater than
+	// WaitIndex, or until WaitTime has elapsed. Typically WaitIndex is set to
+	// the QueryMeta.LastIndex returned by a previous request.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
 }
 
 // A Node represents a host on which a consul agent is running.
@@ -102,7 +113,7 @@ type Node struct {
 	TaggedAddresses map[string]string `json:"TaggedAddresses"`
 }
 
-func (c *client) Nodes(ctx Ctx, nq NodesQuery) ([]Node, error) {
+func (c *client) Nodes(ctx Ctx, nq NodesQuery) ([]Node, QueryMeta, error) {
 	var params [][2]string
 
 	if nq.DC != "" {
@@ -121,14 +132,18 @@ func (c *client) Nodes(ctx Ctx, nq NodesQuery) ([]Node, error) {
 		params = append(params, [2]string{"filter", url.QueryEscape(nq.Filter)})
 	}
 
+	bParams, wait := blocking(nq.WaitIndex, nq.WaitTime)
+	params = append(params, bParams...)
+
 	path := fixup("/v1/catalog", "/nodes", params...)
 	nodes := make([]Node, 0, 100)
 
-	if err := c.get(ctx, path, &nodes); err != nil {
-		return nil, err
+	meta, err := c.getMeta(ctx, path, wait, &nodes)
+	if err != nil {
+		return nil, meta, err
 	}
 
-	return nodes, nil
+	return nodes, meta, nil
 }
 
 // A NodeInfo contains detailed information about a node,
@@ -156,9 +171,19 @@ type NodeQuery struct {
 	//
 	// https://www.consul.io/api/features/filtering.html
 	Filter string
+
+	// WaitIndex turns the request into a blocking query. When set, consul will
+	// not respond until the index of the data being read is greater than
+	// WaitIndex, or until WaitTime has elapsed. Typically WaitIndex is set to
+	// the QueryMeta.LastIndex returned by a previous request.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
 }
 
-func (c *client) Node(ctx Ctx, name string, nq NodeQuery) (NodeInfo, error) {
+func (c *client) Node(ctx Ctx, name string, nq NodeQuery) (NodeInfo, QueryMeta, error) {
 	var params [][2]string
 
 	if nq.DC != "" {
@@ -169,14 +194,18 @@ func (c *client) Node(ctx Ctx, name string, nq NodeQuery) (NodeInfo, error) {
 		params = append(params, [2]string{"filter", url.QueryEscape(nq.Filter)})
 	}
 
+	bParams, wait := blocking(nq.WaitIndex, nq.WaitTime)
+	params = append(params, bParams...)
+
 	path := fixup("/v1/catalog", "/node/"+name, params...)
 
 	var info NodeInfo
-	if err := c.get(ctx, path, &info); err != nil {
-		return NodeInfo{}, err
+	meta, err := c.getMeta(ctx, path, wait, &info)
+	if err != nil {
+		return NodeInfo{}, meta, err
 	}
 
-	return info, nil
+	return info, meta, nil
 }
 
 type ServicesQuery struct {
@@ -190,9 +219,19 @@ type ServicesQuery struct {
 	//
 	// If blank, no node metadata filter is applied.
 	NodeMeta []Pair
+
+	// WaitIndex turns the request into a blocking query. When set, consul will
+	// not respond until the index of the data being read is greater than
+	// WaitIndex, or until WaitTime has elapsed. Typically WaitIndex is set to
+	// the QueryMeta.LastIndex returned by a previous request.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
 }
 
-func (c *client) Services(ctx Ctx, sq ServicesQuery) (map[string][]string, error) {
+func (c *client) Services(ctx Ctx, sq ServicesQuery) (map[string][]string, QueryMeta, error) {
 	var params [][2]string
 
 	if sq.DC != "" {
@@ -203,11 +242,15 @@ func (c *client) Services(ctx Ctx, sq ServicesQuery) (map[string][]string, error
 		params = append(params, [2]string{"node-meta", pair.String()})
 	}
 
+	bParams, wait := blocking(sq.WaitIndex, sq.WaitTime)
+	params = append(params, bParams...)
+
 	path := fixup("/v1/catalog", "/services", params...)
 
 	services := make(map[string][]string, 1024)
-	if err := c.get(ctx, path, &services); err != nil {
-		return nil, err
+	meta, err := c.getMeta(ctx, path, wait, &services)
+	if err != nil {
+		return nil, meta, err
 	}
 
 	// Sort the list of tags for each returned service, so that the response
@@ -216,7 +259,7 @@ func (c *client) Services(ctx Ctx, sq ServicesQuery) (map[string][]string, error
 		sort.Strings(tags)
 	}
 
-	return services, nil
+	return services, meta, nil
 }
 
 type ServiceQuery struct {
@@ -248,19 +291,29 @@ type ServiceQuery struct {
 	//
 	// https://www.consul.io/api/features/filtering.html
 	Filter string
+
+	// WaitIndex turns the request into a blocking query. When set, consul will
+	// not respond until the index of the data being read is greater than
+	// WaitIndex, or until WaitTime has elapsed. Typically WaitIndex is set to
+	// the QueryMeta.LastIndex returned by a previous request.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
 }
 
-func (c *client) Service(ctx Ctx, service string, sq ServiceQuery) ([]Instance, error) {
+func (c *client) Service(ctx Ctx, service string, sq ServiceQuery) ([]Instance, QueryMeta, error) {
 	serviceEP := "/v1/catalog/service/"
 	return c.service(ctx, serviceEP, service, sq)
 }
 
-func (c *client) Connect(ctx Ctx, service string, sq ServiceQuery) ([]Instance, error) {
+func (c *client) Connect(ctx Ctx, service string, sq ServiceQuery) ([]Instance, QueryMeta, error) {
 	connectEP := "/v1/catalog/connect/"
 	return c.service(ctx, connectEP, service, sq)
 }
 
-func (c *client) service(ctx Ctx, ep, service string, sq ServiceQuery) ([]Instance, error) {
+func (c *client) service(ctx Ctx, ep, service string, sq ServiceQuery) ([]Instance, QueryMeta, error) {
 	var params [][2]string
 
 	if sq.DC != "" {
@@ -283,12 +336,16 @@ func (c *client) service(ctx Ctx, ep, service string, sq ServiceQuery) ([]Instan
 		params = append(params, [2]string{"filter", url.QueryEscape(sq.Filter)})
 	}
 
+	bParams, wait := blocking(sq.WaitIndex, sq.WaitTime)
+	params = append(params, bParams...)
+
 	path := fixup(ep, service, params...)
 	instances := make([]Instance, 0, 100)
 
-	if err := c.get(ctx, path, &instances); err != nil {
-		return nil, err
+	meta, err := c.getMeta(ctx, path, wait, &instances)
+	if err != nil {
+		return nil, meta, err
 	}
 
-	return instances, nil
+	return instances, meta, nil
 }
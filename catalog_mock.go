@@ -14,7 +14,7 @@ import (
 type CatalogMock struct {
 	t minimock.Tester
 
-	funcConnect          func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, err error)
+	funcConnect          func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, q1 QueryMeta, err error)
 	inspectFuncConnect   func(c1 Ctx, s1 string, s2 ServiceQuery)
 	afterConnectCounter  uint64
 	beforeConnectCounter uint64
@@ -26,25 +26,25 @@ type CatalogMock struct {
 	beforeDataCentersCounter uint64
 	DataCentersMock          mCatalogMockDataCenters
 
-	funcNode          func(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, err error)
+	funcNode          func(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, q1 QueryMeta, err error)
 	inspectFuncNode   func(c1 Ctx, s1 string, n1 NodeQuery)
 	afterNodeCounter  uint64
 	beforeNodeCounter uint64
 	NodeMock          mCatalogMockNode
 
-	funcNodes          func(c1 Ctx, n1 NodesQuery) (na1 []Node, err error)
+	funcNodes          func(c1 Ctx, n1 NodesQuery) (na1 []Node, q1 QueryMeta, err error)
 	inspectFuncNodes   func(c1 Ctx, n1 NodesQuery)
 	afterNodesCounter  uint64
 	beforeNodesCounter uint64
 	NodesMock          mCatalogMockNodes
 
-	funcService          func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, err error)
+	funcService          func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, q1 QueryMeta, err error)
 	inspectFuncService   func(c1 Ctx, s1 string, s2 ServiceQuery)
 	afterServiceCounter  uint64
 	beforeServiceCounter uint64
 	ServiceMock          mCatalogMockService
 
-	funcServices          func(c1 Ctx, s1 ServicesQuery) (m1 map[string][]string, err error)
+	funcServices          func(c1 Ctx, s1 ServicesQuery) (m1 map[string][]string, q1 QueryMeta, err error)
 	inspectFuncServices   func(c1 Ctx, s1 ServicesQuery)
 	afterServicesCounter  uint64
 	beforeServicesCounter uint64
@@ -106,6 +106,7 @@ type CatalogMockConnectParams struct {
 // CatalogMockConnectResults contains results of the Catalog.Connect
 type CatalogMockConnectResults struct {
 	ia1 []Instance
+	q1  QueryMeta
 	err error
 }
 
@@ -141,7 +142,7 @@ func (mmConnect *mCatalogMockConnect) Inspect(f func(c1 Ctx, s1 string, s2 Servi
 }
 
 // Return sets up results that will be returned by Catalog.Connect
-func (mmConnect *mCatalogMockConnect) Return(ia1 []Instance, err error) *CatalogMock {
+func (mmConnect *mCatalogMockConnect) Return(ia1 []Instance, q1 QueryMeta, err error) *CatalogMock {
 	if mmConnect.mock.funcConnect != nil {
 		mmConnect.mock.t.Fatalf("CatalogMock.Connect mock is already set by Set")
 	}
@@ -149,12 +150,12 @@ func (mmConnect *mCatalogMockConnect) Return(ia1 []Instance, err error) *Catalog
 	if mmConnect.defaultExpectation == nil {
 		mmConnect.defaultExpectation = &CatalogMockConnectExpectation{mock: mmConnect.mock}
 	}
-	mmConnect.defaultExpectation.results = &CatalogMockConnectResults{ia1, err}
+	mmConnect.defaultExpectation.results = &CatalogMockConnectResults{ia1, q1, err}
 	return mmConnect.mock
 }
 
 //Set uses given function f to mock the Catalog.Connect method
-func (mmConnect *mCatalogMockConnect) Set(f func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, err error)) *CatalogMock {
+func (mmConnect *mCatalogMockConnect) Set(f func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, q1 QueryMeta, err error)) *CatalogMock {
 	if mmConnect.defaultExpectation != nil {
 		mmConnect.mock.t.Fatalf("Default expectation is already set for the Catalog.Connect method")
 	}
@@ -183,13 +184,13 @@ func (mmConnect *mCatalogMockConnect) When(c1 Ctx, s1 string, s2 ServiceQuery) *
 }
 
 // Then sets up Catalog.Connect return parameters for the expectation previously defined by the When method
-func (e *CatalogMockConnectExpectation) Then(ia1 []Instance, err error) *CatalogMock {
-	e.results = &CatalogMockConnectResults{ia1, err}
+func (e *CatalogMockConnectExpectation) Then(ia1 []Instance, q1 QueryMeta, err error) *CatalogMock {
+	e.results = &CatalogMockConnectResults{ia1, q1, err}
 	return e.mock
 }
 
 // Connect implements Catalog
-func (mmConnect *CatalogMock) Connect(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, err error) {
+func (mmConnect *CatalogMock) Connect(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, q1 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmConnect.beforeConnectCounter, 1)
 	defer mm_atomic.AddUint64(&mmConnect.afterConnectCounter, 1)
 
@@ -207,7 +208,7 @@ func (mmConnect *CatalogMock) Connect(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 [
 	for _, e := range mmConnect.ConnectMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ia1, e.results.err
+			return e.results.ia1, e.results.q1, e.results.err
 		}
 	}
 
@@ -223,7 +224,7 @@ func (mmConnect *CatalogMock) Connect(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 [
 		if mm_results == nil {
 			mmConnect.t.Fatal("No results are set for the CatalogMock.Connect")
 		}
-		return (*mm_results).ia1, (*mm_results).err
+		return (*mm_results).ia1, (*mm_results).q1, (*mm_results).err
 	}
 	if mmConnect.funcConnect != nil {
 		return mmConnect.funcConnect(c1, s1, s2)
@@ -540,6 +541,7 @@ type CatalogMockNodeParams struct {
 // CatalogMockNodeResults contains results of the Catalog.Node
 type CatalogMockNodeResults struct {
 	n2  NodeInfo
+	q1  QueryMeta
 	err error
 }
 
@@ -575,7 +577,7 @@ func (mmNode *mCatalogMockNode) Inspect(f func(c1 Ctx, s1 string, n1 NodeQuery))
 }
 
 // Return sets up results that will be returned by Catalog.Node
-func (mmNode *mCatalogMockNode) Return(n2 NodeInfo, err error) *CatalogMock {
+func (mmNode *mCatalogMockNode) Return(n2 NodeInfo, q1 QueryMeta, err error) *CatalogMock {
 	if mmNode.mock.funcNode != nil {
 		mmNode.mock.t.Fatalf("CatalogMock.Node mock is already set by Set")
 	}
@@ -583,12 +585,12 @@ func (mmNode *mCatalogMockNode) Return(n2 NodeInfo, err error) *CatalogMock {
 	if mmNode.defaultExpectation == nil {
 		mmNode.defaultExpectation = &CatalogMockNodeExpectation{mock: mmNode.mock}
 	}
-	mmNode.defaultExpectation.results = &CatalogMockNodeResults{n2, err}
+	mmNode.defaultExpectation.results = &CatalogMockNodeResults{n2, q1, err}
 	return mmNode.mock
 }
 
 //Set uses given function f to mock the Catalog.Node method
-func (mmNode *mCatalogMockNode) Set(f func(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, err error)) *CatalogMock {
+func (mmNode *mCatalogMockNode) Set(f func(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, q1 QueryMeta, err error)) *CatalogMock {
 	if mmNode.defaultExpectation != nil {
 		mmNode.mock.t.Fatalf("Default expectation is already set for the Catalog.Node method")
 	}
@@ -617,13 +619,13 @@ func (mmNode *mCatalogMockNode) When(c1 Ctx, s1 string, n1 NodeQuery) *CatalogMo
 }
 
 // Then sets up Catalog.Node return parameters for the expectation previously defined by the When method
-func (e *CatalogMockNodeExpectation) Then(n2 NodeInfo, err error) *CatalogMock {
-	e.results = &CatalogMockNodeResults{n2, err}
+func (e *CatalogMockNodeExpectation) Then(n2 NodeInfo, q1 QueryMeta, err error) *CatalogMock {
+	e.results = &CatalogMockNodeResults{n2, q1, err}
 	return e.mock
 }
 
 // Node implements Catalog
-func (mmNode *CatalogMock) Node(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, err error) {
+func (mmNode *CatalogMock) Node(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, q1 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmNode.beforeNodeCounter, 1)
 	defer mm_atomic.AddUint64(&mmNode.afterNodeCounter, 1)
 
@@ -641,7 +643,7 @@ func (mmNode *CatalogMock) Node(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, e
 	for _, e := range mmNode.NodeMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.n2, e.results.err
+			return e.results.n2, e.results.q1, e.results.err
 		}
 	}
 
@@ -657,7 +659,7 @@ func (mmNode *CatalogMock) Node(c1 Ctx, s1 string, n1 NodeQuery) (n2 NodeInfo, e
 		if mm_results == nil {
 			mmNode.t.Fatal("No results are set for the CatalogMock.Node")
 		}
-		return (*mm_results).n2, (*mm_results).err
+		return (*mm_results).n2, (*mm_results).q1, (*mm_results).err
 	}
 	if mmNode.funcNode != nil {
 		return mmNode.funcNode(c1, s1, n1)
@@ -757,6 +759,7 @@ type CatalogMockNodesParams struct {
 // CatalogMockNodesResults contains results of the Catalog.Nodes
 type CatalogMockNodesResults struct {
 	na1 []Node
+	q1  QueryMeta
 	err error
 }
 
@@ -792,7 +795,7 @@ func (mmNodes *mCatalogMockNodes) Inspect(f func(c1 Ctx, n1 NodesQuery)) *mCatal
 }
 
 // Return sets up results that will be returned by Catalog.Nodes
-func (mmNodes *mCatalogMockNodes) Return(na1 []Node, err error) *CatalogMock {
+func (mmNodes *mCatalogMockNodes) Return(na1 []Node, q1 QueryMeta, err error) *CatalogMock {
 	if mmNodes.mock.funcNodes != nil {
 		mmNodes.mock.t.Fatalf("CatalogMock.Nodes mock is already set by Set")
 	}
@@ -800,12 +803,12 @@ func (mmNodes *mCatalogMockNodes) Return(na1 []Node, err error) *CatalogMock {
 	if mmNodes.defaultExpectation == nil {
 		mmNodes.defaultExpectation = &CatalogMockNodesExpectation{mock: mmNodes.mock}
 	}
-	mmNodes.defaultExpectation.results = &CatalogMockNodesResults{na1, err}
+	mmNodes.defaultExpectation.results = &CatalogMockNodesResults{na1, q1, err}
 	return mmNodes.mock
 }
 
 //Set uses given function f to mock the Catalog.Nodes method
-func (mmNodes *mCatalogMockNodes) Set(f func(c1 Ctx, n1 NodesQuery) (na1 []Node, err error)) *CatalogMock {
+func (mmNodes *mCatalogMockNodes) Set(f func(c1 Ctx, n1 NodesQuery) (na1 []Node, q1 QueryMeta, err error)) *CatalogMock {
 	if mmNodes.defaultExpectation != nil {
 		mmNodes.mock.t.Fatalf("Default expectation is already set for the Catalog.Nodes method")
 	}
@@ -834,13 +837,13 @@ func (mmNodes *mCatalogMockNodes) When(c1 Ctx, n1 NodesQuery) *CatalogMockNodesE
 }
 
 // Then sets up Catalog.Nodes return parameters for the expectation previously defined by the When method
-func (e *CatalogMockNodesExpectation) Then(na1 []Node, err error) *CatalogMock {
-	e.results = &CatalogMockNodesResults{na1, err}
+func (e *CatalogMockNodesExpectation) Then(na1 []Node, q1 QueryMeta, err error) *CatalogMock {
+	e.results = &CatalogMockNodesResults{na1, q1, err}
 	return e.mock
 }
 
 // Nodes implements Catalog
-func (mmNodes *CatalogMock) Nodes(c1 Ctx, n1 NodesQuery) (na1 []Node, err error) {
+func (mmNodes *CatalogMock) Nodes(c1 Ctx, n1 NodesQuery) (na1 []Node, q1 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmNodes.beforeNodesCounter, 1)
 	defer mm_atomic.AddUint64(&mmNodes.afterNodesCounter, 1)
 
@@ -858,7 +861,7 @@ func (mmNodes *CatalogMock) Nodes(c1 Ctx, n1 NodesQuery) (na1 []Node, err error)
 	for _, e := range mmNodes.NodesMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.na1, e.results.err
+			return e.results.na1, e.results.q1, e.results.err
 		}
 	}
 
@@ -874,7 +877,7 @@ func (mmNodes *CatalogMock) Nodes(c1 Ctx, n1 NodesQuery) (na1 []Node, err error)
 		if mm_results == nil {
 			mmNodes.t.Fatal("No results are set for the CatalogMock.Nodes")
 		}
-		return (*mm_results).na1, (*mm_results).err
+		return (*mm_results).na1, (*mm_results).q1, (*mm_results).err
 	}
 	if mmNodes.funcNodes != nil {
 		return mmNodes.funcNodes(c1, n1)
@@ -975,6 +978,7 @@ type CatalogMockServiceParams struct {
 // CatalogMockServiceResults contains results of the Catalog.Service
 type CatalogMockServiceResults struct {
 	ia1 []Instance
+	q1  QueryMeta
 	err error
 }
 
@@ -1010,7 +1014,7 @@ func (mmService *mCatalogMockService) Inspect(f func(c1 Ctx, s1 string, s2 Servi
 }
 
 // Return sets up results that will be returned by Catalog.Service
-func (mmService *mCatalogMockService) Return(ia1 []Instance, err error) *CatalogMock {
+func (mmService *mCatalogMockService) Return(ia1 []Instance, q1 QueryMeta, err error) *CatalogMock {
 	if mmService.mock.funcService != nil {
 		mmService.mock.t.Fatalf("CatalogMock.Service mock is already set by Set")
 	}
@@ -1018,12 +1022,12 @@ func (mmService *mCatalogMockService) Return(ia1 []Instance, err error) *Catalog
 	if mmService.defaultExpectation == nil {
 		mmService.defaultExpectation = &CatalogMockServiceExpectation{mock: mmService.mock}
 	}
-	mmService.defaultExpectation.results = &CatalogMockServiceResults{ia1, err}
+	mmService.defaultExpectation.results = &CatalogMockServiceResults{ia1, q1, err}
 	return mmService.mock
 }
 
 //Set uses given function f to mock the Catalog.Service method
-func (mmService *mCatalogMockService) Set(f func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, err error)) *CatalogMock {
+func (mmService *mCatalogMockService) Set(f func(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, q1 QueryMeta, err error)) *CatalogMock {
 	if mmService.defaultExpectation != nil {
 		mmService.mock.t.Fatalf("Default expectation is already set for the Catalog.Service method")
 	}
@@ -1052,13 +1056,13 @@ func (mmService *mCatalogMockService) When(c1 Ctx, s1 string, s2 ServiceQuery) *
 }
 
 // Then sets up Catalog.Service return parameters for the expectation previously defined by the When method
-func (e *CatalogMockServiceExpectation) Then(ia1 []Instance, err error) *CatalogMock {
-	e.results = &CatalogMockServiceResults{ia1, err}
+func (e *CatalogMockServiceExpectation) Then(ia1 []Instance, q1 QueryMeta, err error) *CatalogMock {
+	e.results = &CatalogMockServiceResults{ia1, q1, err}
 	return e.mock
 }
 
 // Service implements Catalog
-func (mmService *CatalogMock) Service(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, err error) {
+func (mmService *CatalogMock) Service(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 []Instance, q1 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmService.beforeServiceCounter, 1)
 	defer mm_atomic.AddUint64(&mmService.afterServiceCounter, 1)
 
@@ -1076,7 +1080,7 @@ func (mmService *CatalogMock) Service(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 [
 	for _, e := range mmService.ServiceMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ia1, e.results.err
+			return e.results.ia1, e.results.q1, e.results.err
 		}
 	}
 
@@ -1092,7 +1096,7 @@ func (mmService *CatalogMock) Service(c1 Ctx, s1 string, s2 ServiceQuery) (ia1 [
 		if mm_results == nil {
 			mmService.t.Fatal("No results are set for the CatalogMock.Service")
 		}
-		return (*mm_results).ia1, (*mm_results).err
+		return (*mm_results).ia1, (*mm_results).q1, (*mm_results).err
 	}
 	if mmService.funcService != nil {
 		return mmService.funcService(c1, s1, s2)
@@ -1192,6 +1196,7 @@ type CatalogMockServicesParams struct {
 // CatalogMockServicesResults contains results of the Catalog.Services
 type CatalogMockServicesResults struct {
 	m1  map[string][]string
+	q1  QueryMeta
 	err error
 }
 
@@ -1227,7 +1232,7 @@ func (mmServices *mCatalogMockServices) Inspect(f func(c1 Ctx, s1 ServicesQuery)
 }
 
 // Return sets up results that will be returned by Catalog.Services
-func (mmServices *mCatalogMockServices) Return(m1 map[string][]string, err error) *CatalogMock {
+func (mmServices *mCatalogMockServices) Return(m1 map[string][]string, q1 QueryMeta, err error) *CatalogMock {
 	if mmServices.mock.funcServices != nil {
 		mmServices.mock.t.Fatalf("CatalogMock.Services mock is already set by Set")
 	}
@@ -1235,12 +1240,12 @@ func (mmServices *mCatalogMockServices) Return(m1 map[string][]string, err error
 	if mmServices.defaultExpectation == nil {
 		mmServices.defaultExpectation = &CatalogMockServicesExpectation{mock: mmServices.mock}
 	}
-	mmServices.defaultExpectation.results = &CatalogMockServicesResults{m1, err}
+	mmServices.defaultExpectation.results = &CatalogMockServicesResults{m1, q1, err}
 	return mmServices.mock
 }
 
 //Set uses given function f to mock the Catalog.Services method
-func (mmServices *mCatalogMockServices) Set(f func(c1 Ctx, s1 ServicesQuery) (m1 map[string][]string, err error)) *CatalogMock {
+func (mmServices *mCatalogMockServices) Set(f func(c1 Ctx, s1 ServicesQuery) (m1 map[string][]string, q1 QueryMeta, err error)) *CatalogMock {
 	if mmServices.defaultExpectation != nil {
 		mmServices.mock.t.Fatalf("Default expectation is already set for the Catalog.Services method")
 	}
@@ -1269,13 +1274,13 @@ func (mmServices *mCatalogMockServices) When(c1 Ctx, s1 ServicesQuery) *CatalogM
 }
 
 // Then sets up Catalog.Services return parameters for the expectation previously defined by the When method
-func (e *CatalogMockServicesExpectation) Then(m1 map[string][]string, err error) *CatalogMock {
-	e.results = &CatalogMockServicesResults{m1, err}
+func (e *CatalogMockServicesExpectation) Then(m1 map[string][]string, q1 QueryMeta, err error) *CatalogMock {
+	e.results = &CatalogMockServicesResults{m1, q1, err}
 	return e.mock
 }
 
 // Services implements Catalog
-func (mmServices *CatalogMock) Services(c1 Ctx, s1 ServicesQuery) (m1 map[string][]string, err error) {
+func (mmServices *CatalogMock) Services(c1 Ctx, s1 ServicesQuery) (m1 map[string][]string, q1 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmServices.beforeServicesCounter, 1)
 	defer mm_atomic.AddUint64(&mmServices.afterServicesCounter, 1)
 
@@ -1293,7 +1298,7 @@ func (mmServices *CatalogMock) Services(c1 Ctx, s1 ServicesQuery) (m1 map[string
 	for _, e := range mmServices.ServicesMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.m1, e.results.err
+			return e.results.m1, e.results.q1, e.results.err
 		}
 	}
 
@@ -1309,7 +1314,7 @@ func (mmServices *CatalogMock) Services(c1 Ctx, s1 ServicesQuery) (m1 map[string
 		if mm_results == nil {
 			mmServices.t.Fatal("No results are set for the CatalogMock.Services")
 		}
-		return (*mm_results).m1, (*mm_results).err
+		return (*mm_results).m1, (*mm_results).q1, (*mm_results).err
 	}
 	if mmServices.funcServices != nil {
 		return mmServices.funcServices(c1, s1)
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -35,7 +36,7 @@ func Test_Client_v1_catalog_datacenters_err(t *testing.T) {
 	defer ts.Close()
 
 	_, err := client.DataCenters(ctx)
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/catalog/datacenters: status code (500): malfunction")
 }
 
 func node(name, address, wan string) Node {
@@ -60,7 +61,7 @@ func Test_Client_v1_catalog_nodes_defaults(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodes, err := client.Nodes(ctx, NodesQuery{
+	nodes, _, err := client.Nodes(ctx, NodesQuery{
 		// empty
 	})
 	require.NoError(t, err)
@@ -83,10 +84,10 @@ func Test_Client_v1_catalog_nodes_defaults_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Nodes(ctx, NodesQuery{
+	_, _, err := client.Nodes(ctx, NodesQuery{
 		// empty
 	})
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/catalog/nodes: status code (500): malfunction")
 }
 
 func Test_Client_v1_catalog_nodes_dc(t *testing.T) {
@@ -102,7 +103,7 @@ func Test_Client_v1_catalog_nodes_dc(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodes, err := client.Nodes(ctx, NodesQuery{
+	nodes, _, err := client.Nodes(ctx, NodesQuery{
 		DC: "dc2",
 	})
 	require.NoError(t, err)
@@ -124,7 +125,7 @@ func Test_Client_v1_catalog_nodes_near(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodes, err := client.Nodes(ctx, NodesQuery{
+	nodes, _, err := client.Nodes(ctx, NodesQuery{
 		Near: "dc1-node1",
 	})
 	require.NoError(t, err)
@@ -151,7 +152,7 @@ func Test_Client_v1_catalog_nodes_nodeMeta(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodes, err := client.Nodes(ctx, NodesQuery{
+	nodes, _, err := client.Nodes(ctx, NodesQuery{
 		NodeMeta: []Pair{
 			{Key: "instance_type", Value: "t2.medium"},
 			{Key: "instance_type", Value: "t2.tiny"},
@@ -176,7 +177,7 @@ func Test_Client_v1_catalog_nodes_filter(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodes, err := client.Nodes(ctx, NodesQuery{
+	nodes, _, err := client.Nodes(ctx, NodesQuery{
 		Filter: "Meta.env == qa",
 	})
 	require.NoError(t, err)
@@ -201,7 +202,7 @@ func Test_Client_v1_catalog_nodes_mix(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodes, err := client.Nodes(ctx, NodesQuery{
+	nodes, _, err := client.Nodes(ctx, NodesQuery{
 		DC:       "dc1",
 		Near:     "dc1-node1",
 		NodeMeta: []Pair{{Key: "instance_type", Value: "t2.tiny"}},
@@ -224,7 +225,7 @@ func Test_Client_v1_catalog_node(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodeInfo, err := client.Node(ctx, "foobar", NodeQuery{})
+	nodeInfo, _, err := client.Node(ctx, "foobar", NodeQuery{})
 	require.NoError(t, err)
 	require.Equal(t, "foobar", nodeInfo.Node.Name)
 }
@@ -240,8 +241,8 @@ func Test_Client_v1_catalog_node_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Node(ctx, "foobar", NodeQuery{})
-	require.EqualError(t, err, "status code (500)")
+	_, _, err := client.Node(ctx, "foobar", NodeQuery{})
+	require.Contains(t, err.Error(), "GET /v1/catalog/node/foobar: status code (500): ")
 }
 
 func Test_Client_v1_catalog_node_dc(t *testing.T) {
@@ -257,7 +258,7 @@ func Test_Client_v1_catalog_node_dc(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodeInfo, err := client.Node(ctx, "foobar", NodeQuery{
+	nodeInfo, _, err := client.Node(ctx, "foobar", NodeQuery{
 		DC: "dc1",
 	})
 	require.NoError(t, err)
@@ -277,7 +278,7 @@ func Test_Client_v1_catalog_node_filter(t *testing.T) {
 	})
 	defer ts.Close()
 
-	nodeInfo, err := client.Node(ctx, "foobar", NodeQuery{
+	nodeInfo, _, err := client.Node(ctx, "foobar", NodeQuery{
 		Filter: "Meta.redis_version == 4.0",
 	})
 	require.NoError(t, err)
@@ -295,7 +296,7 @@ func Test_Client_v1_catalog_services(t *testing.T) {
 	})
 	defer ts.Close()
 
-	services, err := client.Services(ctx, ServicesQuery{
+	services, _, err := client.Services(ctx, ServicesQuery{
 		// empty
 	})
 	require.NoError(t, err)
@@ -314,10 +315,10 @@ func Test_Client_v1_catalog_services_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Services(ctx, ServicesQuery{
+	_, _, err := client.Services(ctx, ServicesQuery{
 		// empty
 	})
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/catalog/services: status code (500): malfunction")
 }
 
 func Test_Client_v1_catalog_services_dc(t *testing.T) {
@@ -333,7 +334,7 @@ func Test_Client_v1_catalog_services_dc(t *testing.T) {
 	})
 	defer ts.Close()
 
-	services, err := client.Services(ctx, ServicesQuery{
+	services, _, err := client.Services(ctx, ServicesQuery{
 		DC: "dc1",
 	})
 	require.NoError(t, err)
@@ -353,7 +354,7 @@ func Test_Client_v1_catalog_services_nodeMeta(t *testing.T) {
 	})
 	defer ts.Close()
 
-	services, err := client.Services(ctx, ServicesQuery{
+	services, _, err := client.Services(ctx, ServicesQuery{
 		NodeMeta: []Pair{
 			{Key: "a", Value: "1"},
 			{Key: "b", Value: "2"},
@@ -375,7 +376,7 @@ func Test_Client_v1_catalog_service(t *testing.T) {
 	})
 	defer ts.Close()
 
-	instances, err := client.Service(ctx, "myapp", ServiceQuery{
+	instances, _, err := client.Service(ctx, "myapp", ServiceQuery{
 		// empty
 	})
 	require.NoError(t, err)
@@ -384,6 +385,34 @@ func Test_Client_v1_catalog_service(t *testing.T) {
 	require.Equal(t, "myapp", instances[1].ServiceName)
 }
 
+func Test_Client_v1_catalog_service_blocking(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:    t,
+		code: http.StatusOK,
+		body: load(t, "v1_catalog_service.json"),
+		headers: map[string]string{
+			"X-Consul-Index":       "2001",
+			"X-Consul-KnownLeader": "true",
+		},
+		hasPath:   "/v1/catalog/service/myapp",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"2000"},
+			"wait":  {"60000ms"},
+		},
+	})
+	defer ts.Close()
+
+	instances, meta, err := client.Service(ctx, "myapp", ServiceQuery{
+		WaitIndex: 2000,
+		WaitTime:  1 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(instances))
+	require.Equal(t, uint64(2001), meta.LastIndex)
+	require.True(t, meta.KnownLeader)
+}
+
 func Test_Client_v1_catalog_service_err(t *testing.T) {
 	ctx, ts, client := testClient(&responder{
 		t:         t,
@@ -395,10 +424,10 @@ func Test_Client_v1_catalog_service_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Service(ctx, "myapp", ServiceQuery{
+	_, _, err := client.Service(ctx, "myapp", ServiceQuery{
 		// empty
 	})
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/catalog/service/myapp: status code (500): malfunction")
 }
 
 func Test_Client_v1_catalog_service_mix(t *testing.T) {
@@ -418,7 +447,7 @@ func Test_Client_v1_catalog_service_mix(t *testing.T) {
 	})
 	defer ts.Close()
 
-	instances, err := client.Service(ctx, "myapp", ServiceQuery{
+	instances, _, err := client.Service(ctx, "myapp", ServiceQuery{
 		DC:   "dc1",
 		Tags: []string{"tag1", "tag2"},
 		Near: "dc1-node7",
@@ -442,7 +471,7 @@ func Test_Client_v1_catalog_connect(t *testing.T) {
 	})
 	defer ts.Close()
 
-	instances, err := client.Connect(ctx, "myapp", ServiceQuery{
+	instances, _, err := client.Connect(ctx, "myapp", ServiceQuery{
 		// empty
 	})
 	require.NoError(t, err)
@@ -462,10 +491,10 @@ func Test_Client_v1_catalog_connect_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Connect(ctx, "myapp", ServiceQuery{
+	_, _, err := client.Connect(ctx, "myapp", ServiceQuery{
 		// empty
 	})
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "GET /v1/catalog/connect/myapp: status code (500): malfunction")
 }
 
 func Test_Client_v1_catalog_connect_mix(t *testing.T) {
@@ -485,7 +514,7 @@ func Test_Client_v1_catalog_connect_mix(t *testing.T) {
 	})
 	defer ts.Close()
 
-	instances, err := client.Connect(ctx, "myapp", ServiceQuery{
+	instances, _, err := client.Connect(ctx, "myapp", ServiceQuery{
 		DC:   "dc1",
 		Tags: []string{"tag1", "tag2"},
 		Near: "dc1-node7",
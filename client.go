@@ -1,8 +1,8 @@
 package consulapi // import "gophers.dev/pkgs/consulapi"
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	clean "github.com/hashicorp/go-cleanhttp"
+	"github.com/pkg/errors"
 
 	"gophers.dev/pkgs/ignore"
 	"gophers.dev/pkgs/loggy"
@@ -29,9 +30,17 @@ const (
 type Client interface {
 	Agent
 	Catalog
+	Health
 	KV
 	Session
+	Txn
+	Watcher
+	Locker
 	Candidate
+	Observer
+	Failover
+	ACL
+	PreparedQueries
 }
 
 // ClientOptions are used to configure options of a client upon creation.
@@ -39,35 +48,61 @@ type ClientOptions struct {
 	// Address (optional) of the consul agent to communicate with. This value
 	// will default to http://localhost:8500 if left unset. This is likely
 	// the desired value, as consul is designed to run with an agent on
-	// every node.
+	// every node. The address of an agent listening on a unix socket uses
+	// the unix:// scheme, e.g. unix:///var/run/consul.sock.
 	Address string
 
+	// Addresses (optional) of further consul agents or servers to fail over
+	// to, in order of preference, when Address cannot be reached. This is
+	// useful when there is no local agent, and the client talks directly to
+	// a pool of consul servers.
+	Addresses []string
+
+	// EjectionPeriod (optional) is how long an address which could not be
+	// reached is avoided for, before requests are sent to it again. Address
+	// is always preferred once its ejection period is over. If not set, the
+	// EjectionPeriod defaults to 30 seconds.
+	EjectionPeriod time.Duration
+
 	// Token (optional) will be used to authenticate requests to consul.
 	Token string
 
+	// TokenSource (optional) provides the token used to authenticate requests
+	// to consul, for tokens which change over time, e.g. NewFileTokenSource.
+	// If set, Token is not used.
+	TokenSource TokenSource
+
+	// Namespace (optional) is the consul enterprise namespace of requests,
+	// unless a request specifies its own.
+	Namespace string
+
+	// Partition (optional) is the consul enterprise admin partition of
+	// requests, unless a request specifies its own.
+	Partition string
+
 	// HTTPClient (optional) is the underlying HTTP client to use for making
 	// requests to consul agents and servers. If not set, a default HTTP client
 	// is used with a default timeout of 10 seconds, and will keep connections
-	// open.
+	// open. The default timeout is extended by the wait time of blocking
+	// queries. A provided HTTPClient with a Timeout set must allow enough time
+	// for any blocking queries to complete.
 	HTTPClient *http.Client
 
+	// TLSConfig (optional) configures the default HTTP client to communicate
+	// with consul using TLS, e.g. with an https:// Address. Not used if the
+	// HTTPClient is set.
+	TLSConfig *TLSConfig
+
 	// Logger may be optionally configured as an output for trace level logging
 	// produced internally by the Client. This can be helpful for debugging logic
 	// errors in client code.
 	Logger loggy.Logger
-}
-
-// RequestError exposes the status code of a http request error
-type RequestError struct {
-	statusCode int
-}
 
-func (h *RequestError) Error() string {
-	return fmt.Sprintf("status code (%d)", h.statusCode)
-}
-
-func (h *RequestError) StatusCode() int {
-	return h.statusCode
+	// RetryPolicy (optional) decides whether requests which failed, e.g.
+	// because the consul agent is restarting, are tried again. Each attempt
+	// is subject to the timeout of the HTTP client. If not set, requests are
+	// not retried. BackoffRetryPolicy is a reasonable choice.
+	RetryPolicy RetryPolicy
 }
 
 // New creates a new Client that will use the provided ClientOptions for
@@ -77,30 +112,51 @@ func New(opts ClientOptions) Client {
 	if address == "" {
 		address = defaultAddress
 	}
+	endpoints := newEndpoints(append([]string{address}, opts.Addresses...), opts.EjectionPeriod)
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = loggy.Discard()
+	}
 
+	// the timeout of the default client is applied per request, so that it
+	// can be extended for blocking queries
+	timeout := time.Duration(0)
 	httpClient := opts.HTTPClient
 	if httpClient == nil {
 		httpClient = clean.DefaultPooledClient()
-		httpClient.Timeout = defaultTimeout
+		timeout = defaultTimeout
+		if opts.TLSConfig != nil {
+			httpClient.Transport = opts.TLSConfig.transport(clean.DefaultPooledTransport(), logger)
+		}
 	}
+	httpClient = endpoints.sockets.client(httpClient, logger)
 
-	logger := opts.Logger
-	if logger == nil {
-		logger = loggy.Discard()
+	token := opts.TokenSource
+	if token == nil {
+		token = StaticTokenSource(opts.Token)
 	}
 
 	return &client{
-		address:    address,
-		token:      opts.Token,
+		endpoints:  endpoints,
+		token:      token,
+		namespace:  opts.Namespace,
+		partition:  opts.Partition,
 		httpClient: httpClient,
+		timeout:    timeout,
+		retry:      opts.RetryPolicy,
 		log:        logger,
 	}
 }
 
 type client struct {
-	address    string
-	token      string
+	endpoints  *endpoints
+	token      TokenSource
+	namespace  string
+	partition  string
 	httpClient *http.Client
+	timeout    time.Duration
+	retry      RetryPolicy
 	log        loggy.Logger
 }
 
@@ -141,83 +197,270 @@ func (c *client) newRequest(ctx Ctx, method, fullURL string, body io.Reader) (*h
 	return rCtx, nil
 }
 
+// withTimeout applies the default per-request timeout to ctx, if the client
+// is using the default HTTP client. The extra duration is added on top of the
+// default timeout, e.g. for the wait time of a blocking query.
+func (c *client) withTimeout(ctx Ctx, extra time.Duration) (Ctx, context.CancelFunc) {
+	if c.timeout == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout+extra)
+}
+
 func (c *client) get(ctx Ctx, path string, i interface{}) error {
-	completeURL := c.address + path
+	_, err := c.getMeta(ctx, path, 0, i)
+	return err
+}
 
-	request, err := c.newRequest(ctx, http.MethodGet, completeURL, nil)
+// getMeta is like get, but also returns the QueryMeta of the response. The
+// QueryMeta is returned even if the response is an error, so that blocking
+// queries may continue from the returned index (e.g. on a missing key). The
+// wait duration is how long consul may block before responding.
+func (c *client) getMeta(ctx Ctx, path string, wait time.Duration, i interface{}) (QueryMeta, error) {
+	response, done, err := c.do(ctx, http.MethodGet, path, "", wait)
 	if err != nil {
-		return err
+		return QueryMeta{}, err
 	}
+	defer done()
 
-	response, err := c.httpClient.Do(request)
+	meta, err := parseQueryMeta(response.Header)
 	if err != nil {
-		return err
+		return QueryMeta{}, err
 	}
-	defer ignore.Drain(response.Body)
 
 	if response.StatusCode >= 400 {
-		return &RequestError{statusCode: response.StatusCode}
+		return meta, newRequestError(http.MethodGet, path, response)
 	}
 
-	return json.NewDecoder(response.Body).Decode(i)
+	return meta, json.NewDecoder(response.Body).Decode(i)
 }
 
 func (c *client) put(ctx Ctx, path, body string, i interface{}) error {
-	completeURL := c.address + path
+	_, err := c.putConflict(ctx, path, body, i, false)
+	return err
+}
+
+// putConflict is like put, but if decodeConflict is set, the body of a
+// response with status code 409 (conflict) is also decoded into i, which is
+// indicated by the returned bool. Consul uses the conflict status code to
+// describe why a transaction was rolled back.
+func (c *client) putConflict(ctx Ctx, path, body string, i interface{}, decodeConflict bool) (bool, error) {
+	return c.submit(ctx, http.MethodPut, path, body, i, decodeConflict)
+}
 
-	r := strings.NewReader(body)
-	request, err := c.newRequest(ctx, http.MethodPut, completeURL, r)
+func (c *client) post(ctx Ctx, path, body string, i interface{}) error {
+	_, err := c.submit(ctx, http.MethodPost, path, body, i, false)
+	return err
+}
+
+// submit sends body using method, which is PUT or POST, decoding the response
+// into i, as described by putConflict.
+func (c *client) submit(ctx Ctx, method, path, body string, i interface{}, decodeConflict bool) (bool, error) {
+	response, done, err := c.do(ctx, method, path, body, 0)
 	if err != nil {
-		return err
+		return false, err
 	}
+	defer done()
 
-	response, err := c.httpClient.Do(request)
+	conflict := decodeConflict && response.StatusCode == http.StatusConflict
+
+	if response.StatusCode >= 400 && !conflict {
+		return false, newRequestError(method, path, response)
+	}
+
+	if i != nil {
+		return conflict, json.NewDecoder(response.Body).Decode(i)
+	}
+
+	return conflict, nil
+}
+
+func (c *client) delete(ctx Ctx, path string) error {
+	response, done, err := c.do(ctx, http.MethodDelete, path, "", 0)
 	if err != nil {
 		return err
 	}
-	defer ignore.Drain(response.Body)
+	defer done()
 
 	if response.StatusCode >= 400 {
-		return &RequestError{statusCode: response.StatusCode}
+		return newRequestError(http.MethodDelete, path, response)
 	}
 
-	if i != nil {
-		return json.NewDecoder(response.Body).Decode(i)
+	return nil
+}
+
+// do makes a request to consul, trying again according to the RetryPolicy
+// of the client. The response of the last attempt is returned, along with a
+// function which must be called once the response has been consumed. The
+// wait duration is how long consul may block before responding.
+func (c *client) do(ctx Ctx, method, path, body string, wait time.Duration) (*http.Response, func(), error) {
+	path = c.scope(path)
+
+	ctx, err := c.authorize(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
+	for number := 1; ; number++ {
+		response, done, err := c.attempt(ctx, method, path, body, wait)
+
+		if c.retry == nil || ctx.Err() != nil {
+			return response, done, err
+		}
+
+		attempt := RetryAttempt{
+			Number:     number,
+			Method:     method,
+			Path:       path,
+			Idempotent: idempotent(method, path),
+			Err:        err,
+		}
+		if err == nil {
+			attempt.StatusCode = response.StatusCode
+			attempt.RetryAfter = parseRetryAfter(response.Header.Get(headerRetryAfter), time.Now())
+			if response.StatusCode < 400 {
+				return response, done, nil
+			}
+		}
+
+		backoff, retry := c.retry.Retry(attempt)
+		if !retry {
+			return response, done, err
+		}
+
+		if err == nil {
+			err = newRequestError(method, path, response)
+			done()
+		}
+		c.log.Warnf("request %s %s failed on attempt %d, try again in %v: %v", method, path, number, backoff, err)
+
+		if !sleep(ctx, backoff) {
+			return nil, nil, ctx.Err()
+		}
+	}
 }
 
-func (c *client) delete(ctx Ctx, path string) error {
-	completeURL := c.address + path
+// attempt makes a single request to consul, using the active address of the
+// client. If the address cannot be reached, it is ejected and the request is
+// made again using the next address, as long as the request is idempotent or
+// was never sent.
+func (c *client) attempt(ctx Ctx, method, path, body string, wait time.Duration) (*http.Response, func(), error) {
+	for tries := 1; ; tries++ {
+		index, address := c.endpoints.pick()
+
+		response, done, err := c.send(ctx, method, c.endpoints.base(index)+path, body, wait)
+		if err == nil {
+			// the address is reachable, but may be unable to serve requests, in
+			// which case any retry of the request goes to the next address
+			if unhealthyStatus(response.StatusCode) {
+				c.endpoints.eject(index, &RequestError{statusCode: response.StatusCode, method: method, path: redact(path)})
+			}
+			return response, done, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, nil, err
+		}
+
+		c.endpoints.eject(index, err)
+
+		if tries >= c.endpoints.size() || !(idempotent(method, path) || isDialError(err)) {
+			return nil, nil, err
+		}
+		c.log.Warnf("request %s %s to %s failed, failing over: %v", method, path, address, err)
+	}
+}
+
+// send makes a single request to the complete url.
+func (c *client) send(ctx Ctx, method, completeURL, body string, wait time.Duration) (*http.Response, func(), error) {
+	ctx, cancel := c.withTimeout(ctx, wait)
 
-	request, err := c.newRequest(ctx, http.MethodDelete, completeURL, nil)
+	var r io.Reader
+	if body != "" || method == http.MethodPut {
+		r = strings.NewReader(body)
+	}
+
+	request, err := c.newRequest(ctx, method, completeURL, r)
 	if err != nil {
-		return err
+		cancel()
+		return nil, nil, err
 	}
 
 	response, err := c.httpClient.Do(request)
 	if err != nil {
-		return err
+		cancel()
+		return nil, nil, err
 	}
-	defer ignore.Drain(response.Body)
 
-	if response.StatusCode >= 400 {
-		return &RequestError{statusCode: response.StatusCode}
+	return response, func() {
+		ignore.Drain(response.Body)
+		cancel()
+	}, nil
+}
+
+// scope adds the namespace and partition of the client to the query of path,
+// unless they are already set.
+func (c *client) scope(path string) string {
+	if c.namespace == "" && c.partition == "" {
+		return path
 	}
 
-	return nil
+	u, err := url.Parse(path)
+	if err != nil {
+		return path
+	}
+
+	values := u.Query()
+	for _, p := range [][2]string{
+		param("ns", c.namespace),
+		param("partition", c.partition),
+	} {
+		if p[1] != "" && values.Get(p[0]) == "" {
+			values.Set(p[0], p[1])
+		}
+	}
+
+	u.RawQuery = values.Encode()
+	return u.String()
+}
+
+// tokenKey is the context key of the token of a request, which overrides the
+// token of the client.
+type tokenKey struct{}
+
+// withToken returns a context which makes requests use token instead of the
+// token of the client, unless token is empty.
+func withToken(ctx Ctx, token string) Ctx {
+	if token == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// authorize returns a context which makes requests use the current token of
+// the client, unless ctx already has a token of its own.
+func (c *client) authorize(ctx Ctx) (Ctx, error) {
+	if _, exists := ctx.Value(tokenKey{}).(string); exists {
+		return ctx, nil
+	}
+
+	token, err := c.token.Token()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get token")
+	}
+	return withToken(ctx, token), nil
 }
 
 func (c *client) maybeSetToken(request *http.Request) {
-	if c.token != "" {
-		request.Header.Set(consulTokenHeader, c.token)
+	if token, _ := request.Context().Value(tokenKey{}).(string); token != "" {
+		request.Header.Set(consulTokenHeader, token)
 	}
 }
 
 const (
 	headerContentType = "Content-Type"
 	headerUserAgent   = "User-Agent"
+	headerRetryAfter  = "Retry-After"
 	mimeJSON          = "application/json"
 	userAgent         = "consulapi/1.0"
 )
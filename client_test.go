@@ -1,7 +1,9 @@
 package consulapi
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -47,8 +49,8 @@ func Test_Client_New_defaults(t *testing.T) {
 		// empty, use defaults
 	}).(*client)
 
-	require.Equal(t, "http://localhost:8500", c.address)
-	require.Equal(t, "", c.token)
+	require.Equal(t, "http://localhost:8500", c.ActiveAddress())
+	require.Equal(t, StaticTokenSource(""), c.token)
 	require.NotNil(t, c.httpClient)
 	require.NotNil(t, c.log)
 }
@@ -118,7 +120,7 @@ func Test_Client_get_bad_response(t *testing.T) {
 
 	var value myFoo
 	err := c.(*client).get(ctx, "/test/arbitrary", &value)
-	require.EqualError(t, err, "status code (418)")
+	require.Contains(t, err.Error(), "GET /test/arbitrary: status code (418): ")
 }
 
 const (
@@ -171,7 +173,7 @@ func Test_Client_put_bad_response(t *testing.T) {
 
 	var value myFoo
 	err := c.(*client).put(ctx, "/test/arbitrary", egBody, &value)
-	require.EqualError(t, err, "status code (418)")
+	require.Contains(t, err.Error(), "PUT /test/arbitrary: status code (418): ")
 }
 
 func Test_Client_delete(t *testing.T) {
@@ -214,5 +216,34 @@ func Test_Client_delete_bad_response(t *testing.T) {
 	defer ts.Close()
 
 	err := c.(*client).delete(ctx, "/test/arbitrary")
-	require.EqualError(t, err, "status code (418)")
+	require.EqualError(t, err, "DELETE /test/arbitrary: status code (418): malfunction")
+}
+
+func Test_Client_scope(t *testing.T) {
+	ts := httptest.NewServer(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc":        {"dc2"},
+			"ns":        {"ns1"},
+			"partition": {"part1"},
+		},
+	})
+	defer ts.Close()
+
+	c := New(ClientOptions{
+		Address:   ts.URL,
+		Namespace: "ns1",
+		Partition: "part1",
+	})
+
+	_, _, err := c.Get(context.Background(), "config/a", Query{DC: "dc2"})
+	require.NoError(t, err)
+
+	// a namespace set by the request is kept
+	scoped := c.(*client).scope("/v1/kv/config/a?ns=ns2")
+	require.Equal(t, "/v1/kv/config/a?ns=ns2&partition=part1", scoped)
 }
@@ -0,0 +1,149 @@
+package consulapi
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The environment variables understood by FromEnv, which are the same ones
+// used by the consul command line.
+//
+// https://www.consul.io/commands#environment-variables
+const (
+	EnvHTTPAddr      = "CONSUL_HTTP_ADDR"
+	EnvHTTPToken     = "CONSUL_HTTP_TOKEN"
+	EnvHTTPTokenFile = "CONSUL_HTTP_TOKEN_FILE"
+	EnvHTTPSSL       = "CONSUL_HTTP_SSL"
+	EnvHTTPSSLVerify = "CONSUL_HTTP_SSL_VERIFY"
+	EnvCACert        = "CONSUL_CACERT"
+	EnvCAPath        = "CONSUL_CAPATH"
+	EnvClientCert    = "CONSUL_CLIENT_CERT"
+	EnvClientKey     = "CONSUL_CLIENT_KEY"
+	EnvTLSServerName = "CONSUL_TLS_SERVER_NAME"
+	EnvNamespace     = "CONSUL_NAMESPACE"
+	EnvPartition     = "CONSUL_PARTITION"
+)
+
+const (
+	schemeHTTP  = "http://"
+	schemeHTTPS = "https://"
+	schemeUnix  = "unix://"
+)
+
+// NewFromEnv creates a new Client configured by the standard consul
+// environment variables. See ClientOptions.FromEnv for details.
+func NewFromEnv() (Client, error) {
+	opts, err := ClientOptions{}.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return New(opts), nil
+}
+
+// FromEnv returns a copy of opts, overridden by whichever of the standard
+// consul environment variables are set.
+//
+// The token of CONSUL_HTTP_TOKEN_FILE is read again whenever the file changes.
+//
+// CONSUL_HTTP_ADDR may be a host and port, or an address with the http://,
+// https:// or unix:// scheme. If no scheme is given, https:// is used when
+// CONSUL_HTTP_SSL is true, and http:// otherwise.
+//
+// If any of the TLS related variables are set, the TLSConfig is replaced with
+// one which uses them, and the certificates are checked to be loadable.
+func (opts ClientOptions) FromEnv() (ClientOptions, error) {
+	return opts.fromEnv(os.Getenv)
+}
+
+func (opts ClientOptions) fromEnv(getenv func(string) string) (ClientOptions, error) {
+	ssl, err := envBool(getenv, EnvHTTPSSL, false)
+	if err != nil {
+		return opts, err
+	}
+
+	verify, err := envBool(getenv, EnvHTTPSSLVerify, true)
+	if err != nil {
+		return opts, err
+	}
+
+	if address := getenv(EnvHTTPAddr); address != "" {
+		switch {
+		case strings.HasPrefix(address, schemeHTTP),
+			strings.HasPrefix(address, schemeHTTPS),
+			strings.HasPrefix(address, schemeUnix):
+		case ssl:
+			address = schemeHTTPS + address
+		default:
+			address = schemeHTTP + address
+		}
+		opts.Address = address
+	}
+
+	if token := getenv(EnvHTTPToken); token != "" {
+		opts.Token = token
+		opts.TokenSource = nil
+	} else if file := getenv(EnvHTTPTokenFile); file != "" {
+		source := NewFileTokenSource(file)
+		if _, err := source.Token(); err != nil {
+			return opts, err
+		}
+		opts.TokenSource = source
+	}
+
+	if namespace := getenv(EnvNamespace); namespace != "" {
+		opts.Namespace = namespace
+	}
+
+	if partition := getenv(EnvPartition); partition != "" {
+		opts.Partition = partition
+	}
+
+	tlsConfig, err := envTLS(getenv, verify)
+	if err != nil {
+		return opts, err
+	}
+	if tlsConfig != nil {
+		opts.TLSConfig = tlsConfig
+	}
+
+	return opts, nil
+}
+
+func envBool(getenv func(string) string, name string, value bool) (bool, error) {
+	s := getenv(name)
+	if s == "" {
+		return value, nil
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse %s", name)
+	}
+	return b, nil
+}
+
+// envTLS creates a TLSConfig from the TLS related environment variables, or
+// returns nil if none are set.
+func envTLS(getenv func(string) string, verify bool) (*TLSConfig, error) {
+	config := &TLSConfig{
+		CAFile:             getenv(EnvCACert),
+		CAPath:             getenv(EnvCAPath),
+		CertFile:           getenv(EnvClientCert),
+		KeyFile:            getenv(EnvClientKey),
+		ServerName:         getenv(EnvTLSServerName),
+		InsecureSkipVerify: !verify,
+	}
+
+	if *config == (TLSConfig{}) {
+		return nil, nil
+	}
+
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
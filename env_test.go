@@ -0,0 +1,174 @@
+package consulapi
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func env(values map[string]string) func(string) string {
+	return func(name string) string {
+		return values[name]
+	}
+}
+
+func Test_ClientOptions_fromEnv(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	tokenFile := filepath.Join(dir, "token")
+	err = ioutil.WriteFile(tokenFile, []byte("def456\n"), 0600)
+	require.NoError(t, err)
+
+	// nothing set, nothing changes
+	opts, err := ClientOptions{Address: "http://consul:8500", Token: "abc123"}.fromEnv(env(nil))
+	require.NoError(t, err)
+	require.Equal(t, ClientOptions{Address: "http://consul:8500", Token: "abc123"}, opts)
+
+	opts, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPAddr:      "consul:8500",
+		EnvHTTPTokenFile: tokenFile,
+		EnvNamespace:     "ns1",
+		EnvPartition:     "part1",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "http://consul:8500", opts.Address)
+	require.Empty(t, opts.Token)
+	token, err := opts.TokenSource.Token()
+	require.NoError(t, err)
+	require.Equal(t, "def456", token)
+	require.Equal(t, "ns1", opts.Namespace)
+	require.Equal(t, "part1", opts.Partition)
+	require.Nil(t, opts.HTTPClient)
+
+	// the token takes precedence over the token file
+	opts, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPAddr:      "consul:8501",
+		EnvHTTPSSL:       "true",
+		EnvHTTPToken:     "abc123",
+		EnvHTTPTokenFile: tokenFile,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "https://consul:8501", opts.Address)
+	require.Equal(t, "abc123", opts.Token)
+
+	// an explicit scheme is kept
+	opts, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPAddr: "https://consul:8501",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "https://consul:8501", opts.Address)
+
+	_, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPSSLVerify: "maybe",
+	}))
+	require.EqualError(t, err, `failed to parse CONSUL_HTTP_SSL_VERIFY: strconv.ParseBool: parsing "maybe": invalid syntax`)
+
+	_, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPTokenFile: filepath.Join(dir, "missing"),
+	}))
+	require.Error(t, err)
+
+	_, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvClientCert: filepath.Join(dir, "missing.crt"),
+		EnvClientKey:  filepath.Join(dir, "missing.key"),
+	}))
+	require.Error(t, err)
+
+	_, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvCACert: tokenFile,
+	}))
+	require.EqualError(t, err, "no CA certificates in "+tokenFile)
+}
+
+func Test_ClientOptions_fromEnv_tls(t *testing.T) {
+	ts := httptest.NewTLSServer(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	err = ioutil.WriteFile(filepath.Join(dir, "ca.pem"), ca, 0600)
+	require.NoError(t, err)
+
+	// the certificate of the test server is valid for example.com
+	opts, err := ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPAddr:      ts.URL,
+		EnvCAPath:        dir,
+		EnvTLSServerName: "example.com",
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, opts.TLSConfig)
+	require.Nil(t, opts.HTTPClient)
+
+	value, _, err := New(opts).Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+
+	// without the CA the server is not trusted, unless verification is off
+	opts, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPAddr: ts.URL,
+	}))
+	require.NoError(t, err)
+
+	_, _, err = New(opts).Get(context.Background(), "config/a", Query{})
+	require.Error(t, err)
+
+	opts, err = ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPAddr:      ts.URL,
+		EnvHTTPSSLVerify: "false",
+	}))
+	require.NoError(t, err)
+
+	_, _, err = New(opts).Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+}
+
+func Test_ClientOptions_fromEnv_unix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	socket := filepath.Join(dir, "consul.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	ts := httptest.NewUnstartedServer(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	ts.Listener = listener
+	ts.Start()
+	defer ts.Close()
+
+	opts, err := ClientOptions{}.fromEnv(env(map[string]string{
+		EnvHTTPAddr: "unix://" + socket,
+	}))
+	require.NoError(t, err)
+
+	value, _, err := New(opts).Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+}
@@ -0,0 +1,232 @@
+package consulapi
+
+import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The kinds of errors which may be returned by a Client. Each kind may be
+// matched using errors.Is, or the corresponding helper (e.g. IsNotFound),
+// which also works on errors wrapped using github.com/pkg/errors.
+var (
+	// ErrNotFound indicates the requested object does not exist.
+	ErrNotFound = stderrors.New("not found")
+
+	// ErrPermissionDenied indicates the token of the request is not allowed
+	// to perform the request.
+	ErrPermissionDenied = stderrors.New("permission denied")
+
+	// ErrRateLimited indicates the request was rejected because too many
+	// requests are being made.
+	ErrRateLimited = stderrors.New("rate limited")
+
+	// ErrNoLeader indicates there is no leader, either of the consul cluster
+	// or of a leadership election.
+	ErrNoLeader = stderrors.New("no leader")
+
+	// ErrCASConflict indicates a check-and-set operation was not applied,
+	// because the index of the object did not match.
+	ErrCASConflict = stderrors.New("cas conflict")
+)
+
+const (
+	headerRequestID = "X-Request-Id"
+
+	// maxErrorBody limits how much of the body of an error response is kept
+	maxErrorBody = 64 * 1024
+
+	// maxErrorMessageBody limits how much of the body of an error response is
+	// included in the message of a RequestError
+	maxErrorMessageBody = 256
+
+	// noClusterLeader is the error message of consul when the cluster has no
+	// leader, e.g. because it has lost quorum
+	noClusterLeader = "No cluster leader"
+)
+
+// RequestError describes a request which failed with an error response from
+// consul.
+type RequestError struct {
+	statusCode int
+	method     string
+	path       string
+	body       string
+	requestID  string
+}
+
+// newRequestError creates a RequestError from the error response of the
+// request using method on path, including the body of response.
+func newRequestError(method, path string, response *http.Response) *RequestError {
+	bs, _ := ioutil.ReadAll(io.LimitReader(response.Body, maxErrorBody))
+
+	return &RequestError{
+		statusCode: response.StatusCode,
+		method:     method,
+		path:       redact(path),
+		body:       strings.TrimSpace(string(bs)),
+		requestID:  response.Header.Get(headerRequestID),
+	}
+}
+
+// Error describes the request and the response, e.g.
+//
+//	PUT /v1/kv/a?dc=dc2: status code (403): Permission denied (request id abc)
+//
+// The body of the response is truncated, and parts which are not known are
+// omitted. The complete details are available using the other methods of
+// RequestError.
+func (h *RequestError) Error() string {
+	var sb strings.Builder
+	if h.method != "" {
+		sb.WriteString(h.method + " ")
+	}
+	if h.path != "" {
+		sb.WriteString(h.path + ": ")
+	}
+	sb.WriteString(fmt.Sprintf("status code (%d)", h.statusCode))
+	if h.body != "" {
+		sb.WriteString(": " + truncate(h.body, maxErrorMessageBody))
+	}
+	if h.requestID != "" {
+		sb.WriteString(" (request id " + h.requestID + ")")
+	}
+	return sb.String()
+}
+
+// truncate returns s shortened to at most n bytes, marking where it was cut.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "") + "..."
+}
+
+// StatusCode returns the http status code of the response.
+func (h *RequestError) StatusCode() int {
+	return h.statusCode
+}
+
+// Method returns the http method of the request.
+func (h *RequestError) Method() string {
+	return h.method
+}
+
+// Path returns the path and query of the request, with any token redacted.
+func (h *RequestError) Path() string {
+	return h.path
+}
+
+// Body returns the body of the response, which usually describes the error.
+func (h *RequestError) Body() string {
+	return h.body
+}
+
+// RequestID returns the value of the X-Request-Id header of the response,
+// which is set by some proxies and load balancers in front of consul.
+func (h *RequestError) RequestID() string {
+	return h.requestID
+}
+
+// Is indicates whether the response matches one of the kinds of errors, e.g.
+// ErrNotFound for a response with status code 404 (not found).
+func (h *RequestError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return h.statusCode == http.StatusNotFound
+	case ErrPermissionDenied:
+		return h.statusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return h.statusCode == http.StatusTooManyRequests
+	case ErrNoLeader:
+		return h.statusCode == http.StatusInternalServerError && strings.Contains(h.body, noClusterLeader)
+	case ErrCASConflict:
+		return h.statusCode == http.StatusConflict
+	}
+	return false
+}
+
+// redact replaces the value of any token in the query of path.
+func redact(path string) string {
+	u, err := url.Parse(path)
+	if err != nil {
+		return path
+	}
+
+	values := u.Query()
+	if values.Get("token") == "" {
+		return path
+	}
+
+	values.Set("token", "redacted")
+	u.RawQuery = values.Encode()
+	return u.String()
+}
+
+// A KeyNotFoundError indicates a key, or key-space, does not exist in the KV
+// store.
+type KeyNotFoundError struct {
+	// Key is the path of the key.
+	Key string
+
+	// DC is the datacenter of the KV store.
+	DC string
+
+	// Recurse indicates whether the key-space under Key was read.
+	Recurse bool
+}
+
+func (e *KeyNotFoundError) Error() string {
+	if e.Recurse {
+		return fmt.Sprintf("key-space %q does not exist", e.Key)
+	}
+	return fmt.Sprintf("key %q does not exist", fixup("/v1/kv", e.Key, param("dc", e.DC)))
+}
+
+// Is indicates whether target is ErrNotFound.
+func (e *KeyNotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// IsNotFound indicates whether err was caused by an object which does not
+// exist, e.g. a KeyNotFoundError, or a response with status code 404.
+func IsNotFound(err error) bool {
+	return is(err, ErrNotFound)
+}
+
+// IsPermissionDenied indicates whether err was caused by a response with
+// status code 403 (forbidden).
+func IsPermissionDenied(err error) bool {
+	return is(err, ErrPermissionDenied)
+}
+
+// IsRateLimited indicates whether err was caused by a response with status
+// code 429 (too many requests).
+func IsRateLimited(err error) bool {
+	return is(err, ErrRateLimited)
+}
+
+// IsNoLeader indicates whether err was caused by a NoLeaderError, or by the
+// consul cluster having no leader.
+func IsNoLeader(err error) bool {
+	return is(err, ErrNoLeader)
+}
+
+// IsCASConflict indicates whether err was caused by a check-and-set operation
+// which was not applied, e.g. a transaction rolled back because of a stale
+// index.
+func IsCASConflict(err error) bool {
+	return is(err, ErrCASConflict)
+}
+
+// is is like errors.Is, but also looks at the cause of errors wrapped using
+// github.com/pkg/errors.
+func is(err, target error) bool {
+	return stderrors.Is(err, target) || stderrors.Is(errors.Cause(err), target)
+}
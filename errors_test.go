@@ -0,0 +1,118 @@
+package consulapi
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequestError_details(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusForbidden,
+		body:      "Permission denied\n",
+		headers:   map[string]string{headerRequestID: "req-1234"},
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"dc": {"dc2"},
+		},
+		hasBody: "1",
+	})
+	defer ts.Close()
+
+	err := client.Put(ctx, "config/a", "1", Query{DC: "dc2"})
+	require.EqualError(t, err, "PUT /v1/kv/config/a?dc=dc2: status code (403): Permission denied (request id req-1234)")
+	require.True(t, IsPermissionDenied(err))
+	require.False(t, IsNotFound(err))
+
+	var re *RequestError
+	require.True(t, stderrors.As(err, &re))
+	require.Equal(t, http.StatusForbidden, re.StatusCode())
+	require.Equal(t, http.MethodPut, re.Method())
+	require.Equal(t, "/v1/kv/config/a?dc=dc2", re.Path())
+	require.Equal(t, "Permission denied", re.Body())
+	require.Equal(t, "req-1234", re.RequestID())
+}
+
+func Test_RequestError_Error(t *testing.T) {
+	require.EqualError(t, &RequestError{statusCode: http.StatusConflict}, "status code (409)")
+
+	require.EqualError(t, &RequestError{
+		statusCode: http.StatusInternalServerError,
+		method:     http.MethodGet,
+		path:       "/v1/agent/self",
+		requestID:  "req-1234",
+	}, "GET /v1/agent/self: status code (500) (request id req-1234)")
+
+	err := &RequestError{
+		statusCode: http.StatusInternalServerError,
+		method:     http.MethodGet,
+		path:       "/v1/agent/self",
+		body:       strings.Repeat("x", 1000),
+	}
+	require.EqualError(t, err, "GET /v1/agent/self: status code (500): "+strings.Repeat("x", maxErrorMessageBody)+"...")
+	require.Len(t, err.Body(), 1000)
+}
+
+func Test_redact(t *testing.T) {
+	require.Equal(t, "/v1/kv/a?dc=dc2", redact("/v1/kv/a?dc=dc2"))
+	require.Equal(t, "/v1/kv/a?dc=dc2&token=redacted", redact("/v1/kv/a?dc=dc2&token=abc123"))
+}
+
+func Test_Is(t *testing.T) {
+	tests := []struct {
+		err   error
+		check func(error) bool
+		exp   bool
+	}{
+		{err: &RequestError{statusCode: http.StatusNotFound}, check: IsNotFound, exp: true},
+		{err: &RequestError{statusCode: http.StatusInternalServerError}, check: IsNotFound, exp: false},
+		{err: &KeyNotFoundError{Key: "config/a"}, check: IsNotFound, exp: true},
+		{err: &RequestError{statusCode: http.StatusForbidden}, check: IsPermissionDenied, exp: true},
+		{err: &RequestError{statusCode: http.StatusTooManyRequests}, check: IsRateLimited, exp: true},
+		{err: &RequestError{statusCode: http.StatusInternalServerError, body: "No cluster leader"}, check: IsNoLeader, exp: true},
+		{err: &RequestError{statusCode: http.StatusInternalServerError, body: "malfunction"}, check: IsNoLeader, exp: false},
+		{err: &NoLeaderError{Key: "service/leader"}, check: IsNoLeader, exp: true},
+		{err: &RequestError{statusCode: http.StatusConflict}, check: IsCASConflict, exp: true},
+		{err: TxnErrors{{OpIndex: 0, What: `failed to delete key "a", index is stale`}}, check: IsCASConflict, exp: true},
+		{err: TxnErrors{{OpIndex: 0, What: `key "a" doesn't exist`}}, check: IsCASConflict, exp: false},
+		{err: TxnErrors{{OpIndex: 0, What: `current modify index 2 for key "a" doesn't match 1`, verb: TxnCheckIndex}}, check: IsCASConflict, exp: true},
+		{err: TxnErrors{{OpIndex: 0, What: `failed to delete key "a"`, verb: TxnDeleteCAS}}, check: IsCASConflict, exp: true},
+		{err: TxnErrors{{OpIndex: 0, What: `key "a" doesn't exist`, verb: TxnGet}}, check: IsCASConflict, exp: false},
+		{err: errors.New("connection refused"), check: IsNotFound, exp: false},
+		{err: nil, check: IsNotFound, exp: false},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.exp, test.check(test.err), "%v", test.err)
+
+		// wrapped either way
+		require.Equal(t, test.exp, test.check(errors.Wrap(test.err, "wrapped")), "%v", test.err)
+		require.Equal(t, test.exp, test.check(fmt.Errorf("wrapped: %w", test.err)), "%v", test.err)
+	}
+}
+
+func Test_KeyNotFoundError(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusNotFound,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	_, _, err := client.Get(ctx, "config/a", Query{})
+	require.EqualError(t, err, `key "/v1/kv/config/a" does not exist`)
+	require.True(t, IsNotFound(err))
+
+	var knf *KeyNotFoundError
+	require.True(t, stderrors.As(err, &knf))
+	require.Equal(t, &KeyNotFoundError{Key: "config/a"}, knf)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"time"
 
 	"github.com/pkg/errors"
@@ -36,10 +37,13 @@ func main() {
 		LockDelay:   3 * time.Second,
 		TTL:         10 * time.Second,
 		ContactInfo: fmt.Sprintf("elector:%s", name),
+		WatchLock:   true,
+		Jitter:      0.1,
 	}
 
 	f := func(ctx context.Context) error {
-		log.Printf("--- leaderfunc called ---")
+		token, _ := consulapi.FencingToken(ctx)
+		log.Printf("--- leaderfunc called, with fencing token %d ---", token)
 
 		select {
 		case <-ctx.Done():
@@ -48,7 +52,9 @@ func main() {
 		}
 	}
 
-	ctx := context.Background()
+	// participate until interrupted
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
 	session, err := consul.Participate(ctx, leadershipConfig, f)
 	if err != nil {
@@ -57,18 +63,33 @@ func main() {
 
 	log.Printf("[elector %s] going to idle, with session id: %s", name, session.SessionID(ctx))
 
-	for range time.Tick(2 * time.Second) {
-		showLeader(session)
+	events := session.Events(ctx)
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			showLeader(event)
+		case <-ctx.Done():
+			log.Printf("[elector %s] interrupted, resigning", name)
+			if err := session.Resign(context.Background()); err != nil {
+				log.Printf("[elector %s] failed to resign: %v", name, err)
+			}
+			return
+		}
 	}
 }
 
-func showLeader(session consulapi.LeaderSession) {
-	ctx := context.Background()
-
-	leader, err := session.Current(ctx)
-	if err != nil {
-		log.Printf("[elector %s] could not get current leader: %v", name, err)
-	} else {
-		log.Printf("[elector %s] looked up current leader which is: %s", name, leader)
+func showLeader(event consulapi.LeadershipEvent) {
+	switch {
+	case event.IsLeader:
+		log.Printf("[elector %s] became the leader", name)
+	case event.Leader == "":
+		log.Printf("[elector %s] there is currently no leader", name)
+	default:
+		log.Printf("[elector %s] observed current leader which is: %s", name, event.Leader)
 	}
 }
@@ -0,0 +1,212 @@
+package consulapi
+
+import (
+	stderrors "errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultEjectionPeriod = 30 * time.Second
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Failover -s _mock.go
+
+// A Failover implementation is able to fail over between the addresses of
+// multiple consul agents or servers.
+type Failover interface {
+	// ActiveAddress returns the address currently being used for requests.
+	ActiveAddress() string
+
+	// AddressStatuses returns the health of every configured address, in
+	// order of preference.
+	AddressStatuses() []AddressStatus
+}
+
+// An assertion that client satisfies Failover
+var _ Failover = (*client)(nil)
+
+// AddressStatus describes the health of one configured consul address.
+type AddressStatus struct {
+	// Address is the configured address.
+	Address string
+
+	// Active indicates whether Address is currently being used for requests.
+	Active bool
+
+	// EjectedUntil is when Address will be used again, if it was ejected
+	// for being unhealthy, or the zero time otherwise.
+	EjectedUntil time.Time
+
+	// LastError is the error which caused Address to be ejected, if any.
+	LastError error
+}
+
+type endpoint struct {
+	address      string
+	base         string
+	ejectedUntil time.Time
+	lastError    error
+}
+
+// endpoints keeps track of the health of a list of addresses, in order of
+// preference. The active address is used until it becomes unhealthy, at
+// which point it is ejected for the ejection period, and the next healthy
+// address becomes active. Once the ejection period of the preferred address
+// passes, it becomes active again.
+type endpoints struct {
+	ejection time.Duration
+	now      func() time.Time
+	sockets  unixSockets
+
+	lock   sync.Mutex
+	list   []*endpoint
+	active int
+}
+
+func newEndpoints(addresses []string, ejection time.Duration) *endpoints {
+	if ejection <= 0 {
+		ejection = defaultEjectionPeriod
+	}
+
+	if len(addresses) == 0 {
+		addresses = []string{defaultAddress}
+	}
+
+	sockets := make(unixSockets)
+	seen := make(map[string]bool, len(addresses))
+	list := make([]*endpoint, 0, len(addresses))
+	for _, address := range addresses {
+		address = strings.TrimSuffix(address, "/")
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		list = append(list, &endpoint{
+			address: address,
+			base:    sockets.add(address),
+		})
+	}
+
+	return &endpoints{
+		ejection: ejection,
+		now:      time.Now,
+		sockets:  sockets,
+		list:     list,
+	}
+}
+
+func (e *endpoints) size() int {
+	return len(e.list)
+}
+
+// base returns the url which the path of a request to the endpoint of index
+// is appended to.
+func (e *endpoints) base(index int) string {
+	return e.list[index].base
+}
+
+// pick returns the index and address of the endpoint to use for a request.
+func (e *endpoints) pick() (int, string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	now := e.now()
+
+	// return to the most preferred healthy address
+	for i := 0; i < e.active; i++ {
+		if !e.list[i].ejectedUntil.After(now) {
+			e.activate(i)
+			break
+		}
+	}
+
+	return e.active, e.list[e.active].address
+}
+
+// eject marks the endpoint of index as unhealthy, because of err. If it is
+// the active endpoint, the next healthy endpoint becomes active, or the one
+// which will be re-admitted first if none are healthy.
+func (e *endpoints) eject(index int, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	now := e.now()
+	ejected := e.list[index]
+	ejected.ejectedUntil = now.Add(e.ejection)
+	ejected.lastError = err
+
+	if index != e.active {
+		return
+	}
+
+	next := -1
+	for i := 1; i < len(e.list); i++ {
+		candidate := (index + i) % len(e.list)
+		if !e.list[candidate].ejectedUntil.After(now) {
+			next = candidate
+			break
+		}
+		if next < 0 || e.list[candidate].ejectedUntil.Before(e.list[next].ejectedUntil) {
+			next = candidate
+		}
+	}
+
+	// there is nothing else to fail over to
+	if next < 0 {
+		return
+	}
+	e.activate(next)
+}
+
+func (e *endpoints) activate(index int) {
+	e.list[index].ejectedUntil = time.Time{}
+	e.list[index].lastError = nil
+	e.active = index
+}
+
+func (e *endpoints) statuses() []AddressStatus {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	statuses := make([]AddressStatus, 0, len(e.list))
+	for i, endpoint := range e.list {
+		statuses = append(statuses, AddressStatus{
+			Address:      endpoint.address,
+			Active:       i == e.active,
+			EjectedUntil: endpoint.ejectedUntil,
+			LastError:    endpoint.lastError,
+		})
+	}
+	return statuses
+}
+
+func (c *client) ActiveAddress() string {
+	_, address := c.endpoints.pick()
+	return address
+}
+
+func (c *client) AddressStatuses() []AddressStatus {
+	return c.endpoints.statuses()
+}
+
+// unhealthyStatus indicates whether a response with status code indicates
+// the address it came from is unhealthy, e.g. because a load balancer in
+// front of the address cannot reach it.
+func unhealthyStatus(code int) bool {
+	switch code {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isDialError indicates whether err was caused by failing to connect, in
+// which case the request was never sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return stderrors.As(err, &opErr) && opErr.Op == "dial"
+}
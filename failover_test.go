@@ -0,0 +1,137 @@
+package consulapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+func Test_newEndpoints(t *testing.T) {
+	e := newEndpoints([]string{"http://a:8500", "", "http://b:8500", "http://a:8500"}, 0)
+	require.Equal(t, 2, e.size())
+	require.Equal(t, defaultEjectionPeriod, e.ejection)
+
+	e = newEndpoints(nil, time.Minute)
+	require.Equal(t, 1, e.size())
+	_, address := e.pick()
+	require.Equal(t, defaultAddress, address)
+}
+
+func Test_endpoints_eject(t *testing.T) {
+	now := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+	e := newEndpoints([]string{"http://a:8500", "http://b:8500", "http://c:8500"}, 10*time.Second)
+	e.now = func() time.Time { return now }
+
+	// the preferred address is used first
+	index, address := e.pick()
+	require.Equal(t, 0, index)
+	require.Equal(t, "http://a:8500", address)
+
+	// fail over to the next address
+	e.eject(0, errors.New("connection refused"))
+	index, address = e.pick()
+	require.Equal(t, 1, index)
+	require.Equal(t, "http://b:8500", address)
+
+	statuses := e.statuses()
+	require.False(t, statuses[0].Active)
+	require.Equal(t, now.Add(10*time.Second), statuses[0].EjectedUntil)
+	require.EqualError(t, statuses[0].LastError, "connection refused")
+	require.True(t, statuses[1].Active)
+
+	// ejecting an address which is not active does not fail over
+	now = now.Add(2 * time.Second)
+	e.eject(2, errors.New("connection refused"))
+	_, address = e.pick()
+	require.Equal(t, "http://b:8500", address)
+
+	// with every address ejected, use the one re-admitted first
+	e.eject(1, errors.New("connection refused"))
+	_, address = e.pick()
+	require.Equal(t, "http://a:8500", address)
+
+	// the preferred address is sticky, once re-admitted
+	e.eject(0, errors.New("connection refused"))
+	_, address = e.pick()
+	require.Equal(t, "http://b:8500", address)
+
+	now = now.Add(11 * time.Second)
+	_, address = e.pick()
+	require.Equal(t, "http://a:8500", address)
+}
+
+func Test_Client_failover(t *testing.T) {
+	// an address which refuses connections
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	up := httptest.NewServer(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer up.Close()
+
+	c := New(ClientOptions{
+		Address:   down.URL,
+		Addresses: []string{up.URL},
+		Logger:    loggy.New("test-client"),
+	})
+	require.Equal(t, down.URL, c.ActiveAddress())
+
+	value, _, err := c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+	require.Equal(t, up.URL, c.ActiveAddress())
+
+	statuses := c.AddressStatuses()
+	require.Len(t, statuses, 2)
+	require.Equal(t, down.URL, statuses[0].Address)
+	require.False(t, statuses[0].Active)
+	require.Error(t, statuses[0].LastError)
+	require.True(t, statuses[1].Active)
+}
+
+func Test_Client_failover_unhealthy(t *testing.T) {
+	unavailable := httptest.NewServer(&responder{
+		t:         t,
+		code:      http.StatusServiceUnavailable,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer unavailable.Close()
+
+	up := httptest.NewServer(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer up.Close()
+
+	c := New(ClientOptions{
+		Address:     unavailable.URL,
+		Addresses:   []string{up.URL},
+		Logger:      loggy.New("test-client"),
+		RetryPolicy: BackoffRetryPolicy{MinBackoff: 1 * time.Millisecond},
+	})
+
+	// the retry goes to the next address
+	value, _, err := c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+	require.Equal(t, up.URL, c.ActiveAddress())
+}
@@ -0,0 +1,309 @@
+package consulapi
+
+import "time"
+
+// A CheckStatus is the state of a health check.
+type CheckStatus string
+
+const (
+	CheckPassing     CheckStatus = "passing"
+	CheckWarning     CheckStatus = "warning"
+	CheckCritical    CheckStatus = "critical"
+	CheckMaintenance CheckStatus = "maintenance"
+
+	// CheckAny is only useful with ChecksInState, to match checks in
+	// any state.
+	CheckAny CheckStatus = "any"
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Health -s _mock.go
+
+// Health provides access to the health information of nodes and services
+// in the consul catalog. Unlike the Catalog endpoints, the health endpoints
+// report the status of each health check, and can filter out instances of
+// services that are unhealthy.
+//
+// The method names differ from the Catalog equivalents, because every
+// interface is composed into Client.
+//
+// https://www.consul.io/api/health.html
+type Health interface {
+
+	// NodeChecks returns the health checks registered on node, including
+	// checks of the services running on node.
+	//
+	// https://www.consul.io/api/health.html#list-checks-for-node
+	NodeChecks(Ctx, string, ChecksQuery) ([]HealthCheck, QueryMeta, error)
+
+	// ServiceChecks returns the health checks associated with every
+	// instance of the named service.
+	//
+	// https://www.consul.io/api/health.html#list-checks-for-service
+	ServiceChecks(Ctx, string, ChecksQuery) ([]HealthCheck, QueryMeta, error)
+
+	// ServiceHealth returns the instances of the named service, along with
+	// the health checks of each instance. Set HealthServiceQuery.Passing to
+	// receive only instances with all checks passing.
+	//
+	// https://www.consul.io/api/health.html#list-nodes-for-service
+	ServiceHealth(Ctx, string, HealthServiceQuery) ([]ServiceEntry, QueryMeta, error)
+
+	// ConnectHealth is like ServiceHealth, but returns the instances of
+	// consul CONNECT capable services (i.e. sidecar proxies and native
+	// services) which can be used to reach the named service.
+	//
+	// https://www.consul.io/api/health.html#list-nodes-for-connect-capable-service
+	ConnectHealth(Ctx, string, HealthServiceQuery) ([]ServiceEntry, QueryMeta, error)
+
+	// IngressHealth is like ServiceHealth, but returns the instances of
+	// ingress gateways which are configured to route to the named service.
+	//
+	// https://www.consul.io/api/health.html#list-nodes-for-ingress-gateways-associated-to-a-service
+	IngressHealth(Ctx, string, HealthServiceQuery) ([]ServiceEntry, QueryMeta, error)
+
+	// ChecksInState returns every health check in the given state. Use
+	// CheckAny to match checks in every state.
+	//
+	// https://www.consul.io/api/health.html#list-checks-in-state
+	ChecksInState(Ctx, CheckStatus, ChecksQuery) ([]HealthCheck, QueryMeta, error)
+}
+
+// An assertion that client satisfies Health
+var _ Health = (*client)(nil)
+
+// A HealthCheck is the state of one check on a node or service.
+type HealthCheck struct {
+	Node        string      `json:"Node"`
+	CheckID     string      `json:"CheckID"`
+	Name        string      `json:"Name"`
+	Status      CheckStatus `json:"Status"`
+	Notes       string      `json:"Notes"`
+	Output      string      `json:"Output"`
+	ServiceID   string      `json:"ServiceID"`
+	ServiceName string      `json:"ServiceName"`
+	ServiceTags []string    `json:"ServiceTags"`
+	Type        string      `json:"Type"`
+	CreateIndex uint64      `json:"CreateIndex"`
+	ModifyIndex uint64      `json:"ModifyIndex"`
+}
+
+// A ServiceEntry is one instance of a service, the node it is running on,
+// and the health checks of both the node and the service instance.
+type ServiceEntry struct {
+	Node    Node          `json:"Node"`
+	Service AgentService  `json:"Service"`
+	Checks  []HealthCheck `json:"Checks"`
+}
+
+// Status returns the worst status among the checks of the entry, which is
+// how consul determines whether an instance is healthy.
+func (se ServiceEntry) Status() CheckStatus {
+	return worstStatus(se.Checks)
+}
+
+// worstStatus returns the worst status among checks, or CheckPassing if there
+// are no checks.
+func worstStatus(checks []HealthCheck) CheckStatus {
+	status := CheckPassing
+	for _, check := range checks {
+		switch check.Status {
+		case CheckMaintenance:
+			return CheckMaintenance
+		case CheckCritical:
+			status = CheckCritical
+		case CheckWarning:
+			if status == CheckPassing {
+				status = CheckWarning
+			}
+		}
+	}
+	return status
+}
+
+// ChecksQuery is used to define values for each of the optional parameters
+// to the health check endpoints.
+type ChecksQuery struct {
+	// DC indicates the datacenter to query.
+	//
+	// If blank, this will default to the datacenter that the queried agent is in.
+	DC string
+
+	// Near specifies which node should be treated as the "center" in terms of
+	// round-trip time for ordering the returned checks.
+	//
+	// Not supported by NodeChecks.
+	Near string
+
+	// NodeMeta creates a filter based on node metadata, using the given list
+	// of key:value pairs.
+	//
+	// Not supported by NodeChecks.
+	NodeMeta []Pair
+
+	// Filter specifies an advanced filtering expression.
+	//
+	// https://www.consul.io/api/features/filtering.html
+	Filter string
+
+	// WaitIndex turns the request into a blocking query. When set, consul will
+	// not respond until the index of the data being read is greater than
+	// WaitIndex, or until WaitTime has elapsed. Typically WaitIndex is set to
+	// the QueryMeta.LastIndex returned by a previous request.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
+}
+
+func (cq ChecksQuery) params() ([][2]string, time.Duration) {
+	var params [][2]string
+
+	if cq.DC != "" {
+		params = append(params, [2]string{"dc", cq.DC})
+	}
+
+	if cq.Near != "" {
+		params = append(params, [2]string{"near", cq.Near})
+	}
+
+	for _, pair := range cq.NodeMeta {
+		params = append(params, [2]string{"node-meta", pair.String()})
+	}
+
+	if cq.Filter != "" {
+		params = append(params, [2]string{"filter", cq.Filter})
+	}
+
+	bParams, wait := blocking(cq.WaitIndex, cq.WaitTime)
+	return append(params, bParams...), wait
+}
+
+// HealthServiceQuery is used to define values for each of the optional
+// parameters to the health service endpoints.
+type HealthServiceQuery struct {
+	// DC indicates the datacenter to query.
+	//
+	// If blank, this will default to the datacenter that the queried agent is in.
+	DC string
+
+	// Tags specifies a list of tags to filter on. Only instances matching all
+	// given tags will be returned.
+	Tags []string
+
+	// Near specifies which node should be treated as the "center" in terms of
+	// round-trip time for ordering the returned nodes. Nodes at the beginning
+	// of the list will have the shortest round-trip times to the given node.
+	//
+	// The value "_agent" will cause the agent's node to be used for the sort.
+	//
+	// If blank, no default behavior is defined.
+	Near string
+
+	// NodeMeta creates a filter based on node metadata, using the given list
+	// of key:value pairs.
+	//
+	// If blank, no node metadata filter is applied.
+	NodeMeta []Pair
+
+	// Filter specifies an advanced filtering expression.
+	//
+	// https://www.consul.io/api/features/filtering.html
+	Filter string
+
+	// Passing indicates only instances with all checks in the passing state
+	// should be returned.
+	Passing bool
+
+	// WaitIndex turns the request into a blocking query. When set, consul will
+	// not respond until the index of the data being read is greater than
+	// WaitIndex, or until WaitTime has elapsed. Typically WaitIndex is set to
+	// the QueryMeta.LastIndex returned by a previous request.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
+}
+
+func (c *client) NodeChecks(ctx Ctx, node string, cq ChecksQuery) ([]HealthCheck, QueryMeta, error) {
+	return c.checks(ctx, "/v1/health/node/", node, cq)
+}
+
+func (c *client) ServiceChecks(ctx Ctx, service string, cq ChecksQuery) ([]HealthCheck, QueryMeta, error) {
+	return c.checks(ctx, "/v1/health/checks/", service, cq)
+}
+
+func (c *client) ChecksInState(ctx Ctx, state CheckStatus, cq ChecksQuery) ([]HealthCheck, QueryMeta, error) {
+	return c.checks(ctx, "/v1/health/state/", string(state), cq)
+}
+
+func (c *client) checks(ctx Ctx, ep, name string, cq ChecksQuery) ([]HealthCheck, QueryMeta, error) {
+	params, wait := cq.params()
+	path := fixup(ep, name, params...)
+
+	checks := make([]HealthCheck, 0, 10)
+
+	meta, err := c.getMeta(ctx, path, wait, &checks)
+	if err != nil {
+		return nil, meta, err
+	}
+
+	return checks, meta, nil
+}
+
+func (c *client) ServiceHealth(ctx Ctx, service string, hq HealthServiceQuery) ([]ServiceEntry, QueryMeta, error) {
+	serviceEP := "/v1/health/service/"
+	return c.serviceHealth(ctx, serviceEP, service, hq)
+}
+
+func (c *client) ConnectHealth(ctx Ctx, service string, hq HealthServiceQuery) ([]ServiceEntry, QueryMeta, error) {
+	connectEP := "/v1/health/connect/"
+	return c.serviceHealth(ctx, connectEP, service, hq)
+}
+
+func (c *client) IngressHealth(ctx Ctx, service string, hq HealthServiceQuery) ([]ServiceEntry, QueryMeta, error) {
+	ingressEP := "/v1/health/ingress/"
+	return c.serviceHealth(ctx, ingressEP, service, hq)
+}
+
+func (c *client) serviceHealth(ctx Ctx, ep, service string, hq HealthServiceQuery) ([]ServiceEntry, QueryMeta, error) {
+	var params [][2]string
+
+	if hq.DC != "" {
+		params = append(params, [2]string{"dc", hq.DC})
+	}
+
+	for _, tag := range hq.Tags {
+		params = append(params, [2]string{"tag", tag})
+	}
+
+	if hq.Near != "" {
+		params = append(params, [2]string{"near", hq.Near})
+	}
+
+	for _, pair := range hq.NodeMeta {
+		params = append(params, [2]string{"node-meta", pair.String()})
+	}
+
+	if hq.Filter != "" {
+		params = append(params, [2]string{"filter", hq.Filter})
+	}
+
+	if hq.Passing {
+		params = append(params, [2]string{"passing", "true"})
+	}
+
+	bParams, wait := blocking(hq.WaitIndex, hq.WaitTime)
+	params = append(params, bParams...)
+
+	path := fixup(ep, service, params...)
+	entries := make([]ServiceEntry, 0, 100)
+
+	meta, err := c.getMeta(ctx, path, wait, &entries)
+	if err != nil {
+		return nil, meta, err
+	}
+
+	return entries, meta, nil
+}
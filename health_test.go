@@ -0,0 +1,221 @@
+package consulapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Health_NodeChecks(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_health_node.json"),
+		hasPath:   "/v1/health/node/dc1-node1",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	checks, _, err := client.NodeChecks(ctx, "dc1-node1", ChecksQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(checks))
+	require.Equal(t, "serfHealth", checks[0].CheckID)
+	require.Equal(t, CheckPassing, checks[0].Status)
+	require.Equal(t, "myapp-1", checks[1].ServiceID)
+	require.Equal(t, CheckCritical, checks[1].Status)
+	require.Equal(t, uint64(103), checks[1].ModifyIndex)
+}
+
+func Test_Health_NodeChecks_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/health/node/dc1-node1",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	_, _, err := client.NodeChecks(ctx, "dc1-node1", ChecksQuery{})
+	require.EqualError(t, err, "GET /v1/health/node/dc1-node1: status code (500): malfunction")
+}
+
+func Test_Health_ServiceChecks(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_health_node.json"),
+		hasPath:   "/v1/health/checks/myapp",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc":        {"dc1"},
+			"near":      {"_agent"},
+			"node-meta": {"k1:v1"},
+			"filter":    {`ServiceTags contains "primary"`},
+		},
+	})
+	defer ts.Close()
+
+	checks, _, err := client.ServiceChecks(ctx, "myapp", ChecksQuery{
+		DC:   "dc1",
+		Near: "_agent",
+		NodeMeta: []Pair{{
+			Key: "k1", Value: "v1",
+		}},
+		Filter: `ServiceTags contains "primary"`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(checks))
+}
+
+func Test_Health_ChecksInState(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_health_node.json"),
+		hasPath:   "/v1/health/state/critical",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"90"},
+		},
+		headers: map[string]string{
+			"X-Consul-Index": "103",
+		},
+	})
+	defer ts.Close()
+
+	checks, meta, err := client.ChecksInState(ctx, CheckCritical, ChecksQuery{
+		WaitIndex: 90,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(checks))
+	require.Equal(t, uint64(103), meta.LastIndex)
+}
+
+func Test_Health_ServiceHealth(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_health_service.json"),
+		hasPath:   "/v1/health/service/myapp",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	entries, _, err := client.ServiceHealth(ctx, "myapp", HealthServiceQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+
+	entry := entries[0]
+	require.Equal(t, "dc1-node1", entry.Node.Name)
+	require.Equal(t, "myapp-1", entry.Service.ID)
+	require.Equal(t, "myapp", entry.Service.Name)
+	require.Equal(t, 8000, entry.Service.Port)
+	require.Equal(t, Weights{Passing: 10, Warning: 1}, entry.Service.Weights)
+	require.Equal(t, Address{Address: "10.0.0.1", Port: 8000}, entry.Service.TaggedAddresses["lan"])
+	require.Equal(t, 2, len(entry.Checks))
+	require.Equal(t, CheckWarning, entry.Status())
+}
+
+func Test_Health_ServiceHealth_mix(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_health_service.json"),
+		hasPath:   "/v1/health/service/myapp",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc":        {"dc1"},
+			"tag":       {"tag1", "tag2"},
+			"near":      {"dc1-node7"},
+			"node-meta": {"k1:v1"},
+			"filter":    {"Service.Meta.env == qa"},
+			"passing":   {"true"},
+		},
+	})
+	defer ts.Close()
+
+	entries, _, err := client.ServiceHealth(ctx, "myapp", HealthServiceQuery{
+		DC:   "dc1",
+		Tags: []string{"tag1", "tag2"},
+		Near: "dc1-node7",
+		NodeMeta: []Pair{{
+			Key: "k1", Value: "v1",
+		}},
+		Filter:  "Service.Meta.env == qa",
+		Passing: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+}
+
+func Test_Health_ServiceHealth_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/health/service/myapp",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	_, _, err := client.ServiceHealth(ctx, "myapp", HealthServiceQuery{})
+	require.EqualError(t, err, "GET /v1/health/service/myapp: status code (500): malfunction")
+}
+
+func Test_Health_ConnectHealth(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_health_service.json"),
+		hasPath:   "/v1/health/connect/myapp",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"passing": {"true"},
+		},
+	})
+	defer ts.Close()
+
+	entries, _, err := client.ConnectHealth(ctx, "myapp", HealthServiceQuery{
+		Passing: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+}
+
+func Test_Health_IngressHealth(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_health_service.json"),
+		hasPath:   "/v1/health/ingress/myapp",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	entries, _, err := client.IngressHealth(ctx, "myapp", HealthServiceQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+}
+
+func Test_ServiceEntry_Status(t *testing.T) {
+	entry := func(statuses ...CheckStatus) ServiceEntry {
+		var checks []HealthCheck
+		for _, status := range statuses {
+			checks = append(checks, HealthCheck{Status: status})
+		}
+		return ServiceEntry{Checks: checks}
+	}
+
+	require.Equal(t, CheckPassing, entry().Status())
+	require.Equal(t, CheckPassing, entry(CheckPassing, CheckPassing).Status())
+	require.Equal(t, CheckWarning, entry(CheckPassing, CheckWarning).Status())
+	require.Equal(t, CheckCritical, entry(CheckWarning, CheckCritical, CheckWarning).Status())
+	require.Equal(t, CheckMaintenance, entry(CheckCritical, CheckMaintenance).Status())
+}
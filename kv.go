@@ -2,14 +2,155 @@ package consulapi
 
 import (
 	"encoding/base64"
-	"net/http"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+// Query is used to define values for each of the optional parameters
+// to the KV endpoints.
 type Query struct {
+	// DC indicates the datacenter to query.
+	//
+	// If blank, this will default to the datacenter that the queried agent is in.
 	DC string
+
+	// WaitIndex turns a read into a blocking query. When set, consul will not
+	// respond until the index of the data being read is greater than WaitIndex,
+	// or until WaitTime has elapsed. Typically WaitIndex is set to the
+	// QueryMeta.LastIndex returned by a previous read.
+	//
+	// Only used for reads.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
+}
+
+// A KVEntry is a value in the KV store, along with the metadata consul keeps
+// about the value.
+type KVEntry struct {
+	// Key is the full path of the entry.
+	Key string
+
+	// Value is the decoded value of the entry.
+	Value string
+
+	// CreateIndex is the index at which the entry was created.
+	CreateIndex uint64
+
+	// ModifyIndex is the index at which the entry was last modified. It may be
+	// used as the WriteQuery.ModifyIndex of a CAS write, to safely implement a
+	// read-modify-write.
+	ModifyIndex uint64
+
+	// LockIndex is the number of times the entry has been acquired as a lock.
+	LockIndex uint64
+
+	// Flags is the opaque number stored alongside the value.
+	Flags uint64
+
+	// Session is the ID of the session holding the entry as a lock, if any.
+	Session SessionID
+}
+
+type kvEntryFormat struct {
+	Key         string    `json:"Key"`
+	Value       string    `json:"Value"`
+	CreateIndex uint64    `json:"CreateIndex"`
+	ModifyIndex uint64    `json:"ModifyIndex"`
+	LockIndex   uint64    `json:"LockIndex"`
+	Flags       uint64    `json:"Flags"`
+	Session     SessionID `json:"Session"`
+}
+
+func (f kvEntryFormat) entry() (KVEntry, error) {
+	decoded, err := base64.StdEncoding.DecodeString(f.Value)
+	if err != nil {
+		return KVEntry{}, err
+	}
+
+	return KVEntry{
+		Key:         f.Key,
+		Value:       string(decoded),
+		CreateIndex: f.CreateIndex,
+		ModifyIndex: f.ModifyIndex,
+		LockIndex:   f.LockIndex,
+		Flags:       f.Flags,
+		Session:     f.Session,
+	}, nil
+}
+
+// A WriteQuery is used to define values for each of the optional parameters
+// of a conditional or lock-aware write to the KV store.
+//
+// At most one of CAS, Acquire, or Release may be set.
+type WriteQuery struct {
+	// DC indicates the datacenter to write to.
+	//
+	// If blank, this will default to the datacenter that the queried agent is in.
+	DC string
+
+	// Flags is an opaque number stored alongside the value, which may be
+	// used by clients for any purpose.
+	Flags uint64
+
+	// CAS turns the write into a check-and-set operation. The write is only
+	// applied if the current ModifyIndex of the key is equal to ModifyIndex.
+	// If ModifyIndex is 0, the write is only applied if the key does not
+	// already exist.
+	CAS bool
+
+	// ModifyIndex is the index that must match for a CAS write to be applied.
+	// Typically this is the ModifyIndex returned by a previous read.
+	ModifyIndex uint64
+
+	// Acquire turns the write into a lock acquisition attempt, using the
+	// given session. The write is only applied if the lock is not already
+	// held by a different session.
+	Acquire SessionID
+
+	// Release turns the write into a lock release, using the given session.
+	// The write is only applied if the lock is held by the given session.
+	Release SessionID
+}
+
+func (wq WriteQuery) params() ([][2]string, error) {
+	var params [][2]string
+
+	exclusive := 0
+
+	if wq.DC != "" {
+		params = append(params, [2]string{"dc", wq.DC})
+	}
+
+	if wq.Flags != 0 {
+		params = append(params, [2]string{"flags", strconv.FormatUint(wq.Flags, 10)})
+	}
+
+	if wq.CAS {
+		exclusive++
+		params = append(params, [2]string{"cas", strconv.FormatUint(wq.ModifyIndex, 10)})
+	}
+
+	if wq.Acquire != "" {
+		exclusive++
+		params = append(params, [2]string{"acquire", string(wq.Acquire)})
+	}
+
+	if wq.Release != "" {
+		exclusive++
+		params = append(params, [2]string{"release", string(wq.Release)})
+	}
+
+	if exclusive > 1 {
+		return nil, errors.New("write may only use one of cas, acquire, or release")
+	}
+
+	return params, nil
 }
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i KV -s _mock.go
@@ -27,11 +168,21 @@ type Query struct {
 type KV interface {
 
 	// Get will return the value defined at path, for dc.
-	Get(Ctx, string, Query) (string, error)
+	Get(Ctx, string, Query) (string, QueryMeta, error)
+
+	// GetEntry will return the value defined at path, for dc, along with the
+	// metadata of the value.
+	GetEntry(Ctx, string, Query) (KVEntry, QueryMeta, error)
 
 	// Put will set value at path, in dc.
 	Put(Ctx, string, string, Query) error
 
+	// Write will set value at path, in dc, subject to the conditions of
+	// the WriteQuery. The returned bool indicates whether the write was
+	// applied, e.g. a CAS write with a stale index, or an attempt to acquire
+	// a lock held by another session is not applied.
+	Write(Ctx, string, string, WriteQuery) (bool, error)
+
 	// Delete will remove the value at path, in dc.
 	Delete(Ctx, string, Query) error
 
@@ -39,39 +190,36 @@ type KV interface {
 	// The returned paths may be terminal (ie, the value is
 	// stored content) or they may be further traversable like
 	// a directory listing, in dc.
-	Keys(Ctx, string, Query) ([]string, error)
+	Keys(Ctx, string, Query) ([]string, QueryMeta, error)
 
 	// Recurse will recursively descend through path, collecting
 	// all KV pairs along the way, in dc.
-	Recurse(Ctx, string, Query) ([]Pair, error)
-}
+	Recurse(Ctx, string, Query) ([]Pair, QueryMeta, error)
 
-func (c *client) Get(ctx Ctx, path string, query Query) (string, error) {
-	var params [][2]string
+	// RecurseEntries will recursively descend through path, collecting
+	// all values and their metadata along the way, in dc.
+	RecurseEntries(Ctx, string, Query) ([]KVEntry, QueryMeta, error)
+}
 
-	if query.DC != "" {
-		params = append(params, [2]string{"dc", query.DC})
+func (c *client) Get(ctx Ctx, path string, query Query) (string, QueryMeta, error) {
+	entry, meta, err := c.GetEntry(ctx, path, query)
+	if err != nil {
+		return "", meta, err
 	}
+	return entry.Value, meta, nil
+}
 
-	path = fixup("/v1/kv", path, params...)
-
-	var values []Pair
-
-	if err := c.get(ctx, path, &values); err != nil {
-		if re, ok := err.(*RequestError); ok {
-			if re.StatusCode() == http.StatusNotFound {
-				return "", errors.Errorf("key %q does not exist", path)
-			}
-		}
-		return "", err
+func (c *client) GetEntry(ctx Ctx, path string, query Query) (KVEntry, QueryMeta, error) {
+	entries, meta, err := c.entries(ctx, path, query, false)
+	if err != nil {
+		return KVEntry{}, meta, err
 	}
 
-	bs, err := base64.StdEncoding.DecodeString(values[0].Value)
-	if err != nil {
-		return "", err
+	if len(entries) == 0 {
+		return KVEntry{}, meta, &KeyNotFoundError{Key: path, DC: query.DC}
 	}
 
-	return string(bs), nil
+	return entries[0], meta, nil
 }
 
 func (c *client) Put(ctx Ctx, path, value string, query Query) error {
@@ -90,6 +238,22 @@ func (c *client) Put(ctx Ctx, path, value string, query Query) error {
 	return nil
 }
 
+func (c *client) Write(ctx Ctx, path, value string, wq WriteQuery) (bool, error) {
+	params, err := wq.params()
+	if err != nil {
+		return false, err
+	}
+
+	path = fixup("/v1/kv", path, params...)
+
+	var applied bool
+	if err := c.put(ctx, path, value, &applied); err != nil {
+		return false, err
+	}
+
+	return applied, nil
+}
+
 func (c *client) Delete(ctx Ctx, path string, query Query) error {
 	var params [][2]string
 
@@ -106,7 +270,7 @@ func (c *client) Delete(ctx Ctx, path string, query Query) error {
 	return nil
 }
 
-func (c *client) Keys(ctx Ctx, path string, query Query) ([]string, error) {
+func (c *client) Keys(ctx Ctx, path string, query Query) ([]string, QueryMeta, error) {
 	var params [][2]string
 
 	if query.DC != "" {
@@ -115,56 +279,93 @@ func (c *client) Keys(ctx Ctx, path string, query Query) ([]string, error) {
 
 	params = append(params, [2]string{"keys", "true"})
 
+	bParams, wait := blocking(query.WaitIndex, query.WaitTime)
+	params = append(params, bParams...)
+
 	path = fixup("/v1/kv", path, params...)
 
 	var keys []string
-	if err := c.get(ctx, path, &keys); err != nil {
-		return nil, err
+	meta, err := c.getMeta(ctx, path, wait, &keys)
+	if err != nil {
+		return nil, meta, err
 	}
 
 	sort.Strings(keys)
-	return keys, nil
+	return keys, meta, nil
 }
 
-func (c *client) Recurse(ctx Ctx, path string, query Query) ([]Pair, error) {
+func (c *client) Recurse(ctx Ctx, path string, query Query) ([]Pair, QueryMeta, error) {
+	entries, meta, err := c.RecurseEntries(ctx, path, query)
+	if err != nil {
+		return nil, meta, err
+	}
+
+	kvPairs := make([]Pair, 0, len(entries))
+	for _, entry := range entries {
+		kvPairs = append(kvPairs, Pair{
+			Key:   entry.Key,
+			Value: entry.Value,
+		})
+	}
+
+	return kvPairs, meta, nil
+}
+
+func (c *client) RecurseEntries(ctx Ctx, path string, query Query) ([]KVEntry, QueryMeta, error) {
+	entries, meta, err := c.entries(ctx, path, query, true)
+	if err != nil {
+		return nil, meta, err
+	}
+
+	if entries == nil {
+		return nil, meta, &KeyNotFoundError{Key: path, Recurse: true}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return entries, meta, nil
+}
+
+// entries reads the entry at path, or every entry under path if recurse is
+// set. If nothing exists at path, the returned entries are nil rather than
+// an error, which is what a blocking query on a missing key expects.
+func (c *client) entries(ctx Ctx, path string, query Query, recurse bool) ([]KVEntry, QueryMeta, error) {
 	var params [][2]string
 
 	if query.DC != "" {
 		params = append(params, [2]string{"dc", query.DC})
 	}
 
-	params = append(params, [2]string{"recurse", "true"})
+	if recurse {
+		params = append(params, [2]string{"recurse", "true"})
+	}
+
+	bParams, wait := blocking(query.WaitIndex, query.WaitTime)
+	params = append(params, bParams...)
 
-	rPath := fixup("/v1/kv", path, params...)
+	path = fixup("/v1/kv", path, params...)
 
-	var values []Pair
+	var values []kvEntryFormat
 
-	if err := c.get(ctx, rPath, &values); err != nil {
-		if re, ok := err.(*RequestError); ok {
-			if re.StatusCode() == http.StatusNotFound {
-				return nil, errors.Errorf("key-space %q does not exist", path)
-			}
+	meta, err := c.getMeta(ctx, path, wait, &values)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, meta, nil
 		}
-		return nil, err
+		return nil, meta, err
 	}
 
-	kvPairs := make([]Pair, 0, len(values))
+	entries := make([]KVEntry, 0, len(values))
 
 	for _, value := range values {
-		decoded, err := base64.StdEncoding.DecodeString(value.Value)
+		entry, err := value.entry()
 		if err != nil {
-			return nil, err
+			return nil, meta, err
 		}
-
-		kvPairs = append(kvPairs, Pair{
-			Key:   value.Key,
-			Value: string(decoded),
-		})
+		entries = append(entries, entry)
 	}
 
-	sort.Slice(kvPairs, func(i, j int) bool {
-		return kvPairs[i].Key < kvPairs[j].Key
-	})
-
-	return kvPairs, nil
+	return entries, meta, nil
 }
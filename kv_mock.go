@@ -20,13 +20,19 @@ type KVMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mKVMockDelete
 
-	funcGet          func(c1 Ctx, s1 string, q1 Query) (s2 string, err error)
+	funcGet          func(c1 Ctx, s1 string, q1 Query) (s2 string, q2 QueryMeta, err error)
 	inspectFuncGet   func(c1 Ctx, s1 string, q1 Query)
 	afterGetCounter  uint64
 	beforeGetCounter uint64
 	GetMock          mKVMockGet
 
-	funcKeys          func(c1 Ctx, s1 string, q1 Query) (sa1 []string, err error)
+	funcGetEntry          func(c1 Ctx, s1 string, q1 Query) (k1 KVEntry, q2 QueryMeta, err error)
+	inspectFuncGetEntry   func(c1 Ctx, s1 string, q1 Query)
+	afterGetEntryCounter  uint64
+	beforeGetEntryCounter uint64
+	GetEntryMock          mKVMockGetEntry
+
+	funcKeys          func(c1 Ctx, s1 string, q1 Query) (sa1 []string, q2 QueryMeta, err error)
 	inspectFuncKeys   func(c1 Ctx, s1 string, q1 Query)
 	afterKeysCounter  uint64
 	beforeKeysCounter uint64
@@ -38,11 +44,23 @@ type KVMock struct {
 	beforePutCounter uint64
 	PutMock          mKVMockPut
 
-	funcRecurse          func(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, err error)
+	funcRecurse          func(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, q2 QueryMeta, err error)
 	inspectFuncRecurse   func(c1 Ctx, s1 string, q1 Query)
 	afterRecurseCounter  uint64
 	beforeRecurseCounter uint64
 	RecurseMock          mKVMockRecurse
+
+	funcRecurseEntries          func(c1 Ctx, s1 string, q1 Query) (ka1 []KVEntry, q2 QueryMeta, err error)
+	inspectFuncRecurseEntries   func(c1 Ctx, s1 string, q1 Query)
+	afterRecurseEntriesCounter  uint64
+	beforeRecurseEntriesCounter uint64
+	RecurseEntriesMock          mKVMockRecurseEntries
+
+	funcWrite          func(c1 Ctx, s1 string, s2 string, w1 WriteQuery) (b1 bool, err error)
+	inspectFuncWrite   func(c1 Ctx, s1 string, s2 string, w1 WriteQuery)
+	afterWriteCounter  uint64
+	beforeWriteCounter uint64
+	WriteMock          mKVMockWrite
 }
 
 // NewKVMock returns a mock for KV
@@ -58,6 +76,9 @@ func NewKVMock(t minimock.Tester) *KVMock {
 	m.GetMock = mKVMockGet{mock: m}
 	m.GetMock.callArgs = []*KVMockGetParams{}
 
+	m.GetEntryMock = mKVMockGetEntry{mock: m}
+	m.GetEntryMock.callArgs = []*KVMockGetEntryParams{}
+
 	m.KeysMock = mKVMockKeys{mock: m}
 	m.KeysMock.callArgs = []*KVMockKeysParams{}
 
@@ -67,6 +88,12 @@ func NewKVMock(t minimock.Tester) *KVMock {
 	m.RecurseMock = mKVMockRecurse{mock: m}
 	m.RecurseMock.callArgs = []*KVMockRecurseParams{}
 
+	m.RecurseEntriesMock = mKVMockRecurseEntries{mock: m}
+	m.RecurseEntriesMock.callArgs = []*KVMockRecurseEntriesParams{}
+
+	m.WriteMock = mKVMockWrite{mock: m}
+	m.WriteMock.callArgs = []*KVMockWriteParams{}
+
 	return m
 }
 
@@ -314,6 +341,7 @@ type KVMockGetParams struct {
 // KVMockGetResults contains results of the KV.Get
 type KVMockGetResults struct {
 	s2  string
+	q2  QueryMeta
 	err error
 }
 
@@ -349,7 +377,7 @@ func (mmGet *mKVMockGet) Inspect(f func(c1 Ctx, s1 string, q1 Query)) *mKVMockGe
 }
 
 // Return sets up results that will be returned by KV.Get
-func (mmGet *mKVMockGet) Return(s2 string, err error) *KVMock {
+func (mmGet *mKVMockGet) Return(s2 string, q2 QueryMeta, err error) *KVMock {
 	if mmGet.mock.funcGet != nil {
 		mmGet.mock.t.Fatalf("KVMock.Get mock is already set by Set")
 	}
@@ -357,12 +385,12 @@ func (mmGet *mKVMockGet) Return(s2 string, err error) *KVMock {
 	if mmGet.defaultExpectation == nil {
 		mmGet.defaultExpectation = &KVMockGetExpectation{mock: mmGet.mock}
 	}
-	mmGet.defaultExpectation.results = &KVMockGetResults{s2, err}
+	mmGet.defaultExpectation.results = &KVMockGetResults{s2, q2, err}
 	return mmGet.mock
 }
 
 //Set uses given function f to mock the KV.Get method
-func (mmGet *mKVMockGet) Set(f func(c1 Ctx, s1 string, q1 Query) (s2 string, err error)) *KVMock {
+func (mmGet *mKVMockGet) Set(f func(c1 Ctx, s1 string, q1 Query) (s2 string, q2 QueryMeta, err error)) *KVMock {
 	if mmGet.defaultExpectation != nil {
 		mmGet.mock.t.Fatalf("Default expectation is already set for the KV.Get method")
 	}
@@ -391,13 +419,13 @@ func (mmGet *mKVMockGet) When(c1 Ctx, s1 string, q1 Query) *KVMockGetExpectation
 }
 
 // Then sets up KV.Get return parameters for the expectation previously defined by the When method
-func (e *KVMockGetExpectation) Then(s2 string, err error) *KVMock {
-	e.results = &KVMockGetResults{s2, err}
+func (e *KVMockGetExpectation) Then(s2 string, q2 QueryMeta, err error) *KVMock {
+	e.results = &KVMockGetResults{s2, q2, err}
 	return e.mock
 }
 
 // Get implements KV
-func (mmGet *KVMock) Get(c1 Ctx, s1 string, q1 Query) (s2 string, err error) {
+func (mmGet *KVMock) Get(c1 Ctx, s1 string, q1 Query) (s2 string, q2 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
 	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
 
@@ -415,7 +443,7 @@ func (mmGet *KVMock) Get(c1 Ctx, s1 string, q1 Query) (s2 string, err error) {
 	for _, e := range mmGet.GetMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s2, e.results.err
+			return e.results.s2, e.results.q2, e.results.err
 		}
 	}
 
@@ -431,7 +459,7 @@ func (mmGet *KVMock) Get(c1 Ctx, s1 string, q1 Query) (s2 string, err error) {
 		if mm_results == nil {
 			mmGet.t.Fatal("No results are set for the KVMock.Get")
 		}
-		return (*mm_results).s2, (*mm_results).err
+		return (*mm_results).s2, (*mm_results).q2, (*mm_results).err
 	}
 	if mmGet.funcGet != nil {
 		return mmGet.funcGet(c1, s1, q1)
@@ -505,6 +533,225 @@ func (m *KVMock) MinimockGetInspect() {
 	}
 }
 
+type mKVMockGetEntry struct {
+	mock               *KVMock
+	defaultExpectation *KVMockGetEntryExpectation
+	expectations       []*KVMockGetEntryExpectation
+
+	callArgs []*KVMockGetEntryParams
+	mutex    sync.RWMutex
+}
+
+// KVMockGetEntryExpectation specifies expectation struct of the KV.GetEntry
+type KVMockGetEntryExpectation struct {
+	mock    *KVMock
+	params  *KVMockGetEntryParams
+	results *KVMockGetEntryResults
+	Counter uint64
+}
+
+// KVMockGetEntryParams contains parameters of the KV.GetEntry
+type KVMockGetEntryParams struct {
+	c1 Ctx
+	s1 string
+	q1 Query
+}
+
+// KVMockGetEntryResults contains results of the KV.GetEntry
+type KVMockGetEntryResults struct {
+	k1  KVEntry
+	q2  QueryMeta
+	err error
+}
+
+// Expect sets up expected params for KV.GetEntry
+func (mmGetEntry *mKVMockGetEntry) Expect(c1 Ctx, s1 string, q1 Query) *mKVMockGetEntry {
+	if mmGetEntry.mock.funcGetEntry != nil {
+		mmGetEntry.mock.t.Fatalf("KVMock.GetEntry mock is already set by Set")
+	}
+
+	if mmGetEntry.defaultExpectation == nil {
+		mmGetEntry.defaultExpectation = &KVMockGetEntryExpectation{}
+	}
+
+	mmGetEntry.defaultExpectation.params = &KVMockGetEntryParams{c1, s1, q1}
+	for _, e := range mmGetEntry.expectations {
+		if minimock.Equal(e.params, mmGetEntry.defaultExpectation.params) {
+			mmGetEntry.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEntry.defaultExpectation.params)
+		}
+	}
+
+	return mmGetEntry
+}
+
+// Inspect accepts an inspector function that has same arguments as the KV.GetEntry
+func (mmGetEntry *mKVMockGetEntry) Inspect(f func(c1 Ctx, s1 string, q1 Query)) *mKVMockGetEntry {
+	if mmGetEntry.mock.inspectFuncGetEntry != nil {
+		mmGetEntry.mock.t.Fatalf("Inspect function is already set for KVMock.GetEntry")
+	}
+
+	mmGetEntry.mock.inspectFuncGetEntry = f
+
+	return mmGetEntry
+}
+
+// Return sets up results that will be returned by KV.GetEntry
+func (mmGetEntry *mKVMockGetEntry) Return(k1 KVEntry, q2 QueryMeta, err error) *KVMock {
+	if mmGetEntry.mock.funcGetEntry != nil {
+		mmGetEntry.mock.t.Fatalf("KVMock.GetEntry mock is already set by Set")
+	}
+
+	if mmGetEntry.defaultExpectation == nil {
+		mmGetEntry.defaultExpectation = &KVMockGetEntryExpectation{mock: mmGetEntry.mock}
+	}
+	mmGetEntry.defaultExpectation.results = &KVMockGetEntryResults{k1, q2, err}
+	return mmGetEntry.mock
+}
+
+//Set uses given function f to mock the KV.GetEntry method
+func (mmGetEntry *mKVMockGetEntry) Set(f func(c1 Ctx, s1 string, q1 Query) (k1 KVEntry, q2 QueryMeta, err error)) *KVMock {
+	if mmGetEntry.defaultExpectation != nil {
+		mmGetEntry.mock.t.Fatalf("Default expectation is already set for the KV.GetEntry method")
+	}
+
+	if len(mmGetEntry.expectations) > 0 {
+		mmGetEntry.mock.t.Fatalf("Some expectations are already set for the KV.GetEntry method")
+	}
+
+	mmGetEntry.mock.funcGetEntry = f
+	return mmGetEntry.mock
+}
+
+// When sets expectation for the KV.GetEntry which will trigger the result defined by the following
+// Then helper
+func (mmGetEntry *mKVMockGetEntry) When(c1 Ctx, s1 string, q1 Query) *KVMockGetEntryExpectation {
+	if mmGetEntry.mock.funcGetEntry != nil {
+		mmGetEntry.mock.t.Fatalf("KVMock.GetEntry mock is already set by Set")
+	}
+
+	expectation := &KVMockGetEntryExpectation{
+		mock:   mmGetEntry.mock,
+		params: &KVMockGetEntryParams{c1, s1, q1},
+	}
+	mmGetEntry.expectations = append(mmGetEntry.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KV.GetEntry return parameters for the expectation previously defined by the When method
+func (e *KVMockGetEntryExpectation) Then(k1 KVEntry, q2 QueryMeta, err error) *KVMock {
+	e.results = &KVMockGetEntryResults{k1, q2, err}
+	return e.mock
+}
+
+// GetEntry implements KV
+func (mmGetEntry *KVMock) GetEntry(c1 Ctx, s1 string, q1 Query) (k1 KVEntry, q2 QueryMeta, err error) {
+	mm_atomic.AddUint64(&mmGetEntry.beforeGetEntryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetEntry.afterGetEntryCounter, 1)
+
+	if mmGetEntry.inspectFuncGetEntry != nil {
+		mmGetEntry.inspectFuncGetEntry(c1, s1, q1)
+	}
+
+	mm_params := &KVMockGetEntryParams{c1, s1, q1}
+
+	// Record call args
+	mmGetEntry.GetEntryMock.mutex.Lock()
+	mmGetEntry.GetEntryMock.callArgs = append(mmGetEntry.GetEntryMock.callArgs, mm_params)
+	mmGetEntry.GetEntryMock.mutex.Unlock()
+
+	for _, e := range mmGetEntry.GetEntryMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.k1, e.results.q2, e.results.err
+		}
+	}
+
+	if mmGetEntry.GetEntryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetEntry.GetEntryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetEntry.GetEntryMock.defaultExpectation.params
+		mm_got := KVMockGetEntryParams{c1, s1, q1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetEntry.t.Errorf("KVMock.GetEntry got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetEntry.GetEntryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetEntry.t.Fatal("No results are set for the KVMock.GetEntry")
+		}
+		return (*mm_results).k1, (*mm_results).q2, (*mm_results).err
+	}
+	if mmGetEntry.funcGetEntry != nil {
+		return mmGetEntry.funcGetEntry(c1, s1, q1)
+	}
+	mmGetEntry.t.Fatalf("Unexpected call to KVMock.GetEntry. %v %v %v", c1, s1, q1)
+	return
+}
+
+// GetEntryAfterCounter returns a count of finished KVMock.GetEntry invocations
+func (mmGetEntry *KVMock) GetEntryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEntry.afterGetEntryCounter)
+}
+
+// GetEntryBeforeCounter returns a count of KVMock.GetEntry invocations
+func (mmGetEntry *KVMock) GetEntryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEntry.beforeGetEntryCounter)
+}
+
+// Calls returns a list of arguments used in each call to KVMock.GetEntry.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetEntry *mKVMockGetEntry) Calls() []*KVMockGetEntryParams {
+	mmGetEntry.mutex.RLock()
+
+	argCopy := make([]*KVMockGetEntryParams, len(mmGetEntry.callArgs))
+	copy(argCopy, mmGetEntry.callArgs)
+
+	mmGetEntry.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetEntryDone returns true if the count of the GetEntry invocations corresponds
+// the number of defined expectations
+func (m *KVMock) MinimockGetEntryDone() bool {
+	for _, e := range m.GetEntryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEntryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetEntryCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEntry != nil && mm_atomic.LoadUint64(&m.afterGetEntryCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetEntryInspect logs each unmet expectation
+func (m *KVMock) MinimockGetEntryInspect() {
+	for _, e := range m.GetEntryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KVMock.GetEntry with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEntryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetEntryCounter) < 1 {
+		if m.GetEntryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to KVMock.GetEntry")
+		} else {
+			m.t.Errorf("Expected call to KVMock.GetEntry with params: %#v", *m.GetEntryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEntry != nil && mm_atomic.LoadUint64(&m.afterGetEntryCounter) < 1 {
+		m.t.Error("Expected call to KVMock.GetEntry")
+	}
+}
+
 type mKVMockKeys struct {
 	mock               *KVMock
 	defaultExpectation *KVMockKeysExpectation
@@ -532,6 +779,7 @@ type KVMockKeysParams struct {
 // KVMockKeysResults contains results of the KV.Keys
 type KVMockKeysResults struct {
 	sa1 []string
+	q2  QueryMeta
 	err error
 }
 
@@ -567,7 +815,7 @@ func (mmKeys *mKVMockKeys) Inspect(f func(c1 Ctx, s1 string, q1 Query)) *mKVMock
 }
 
 // Return sets up results that will be returned by KV.Keys
-func (mmKeys *mKVMockKeys) Return(sa1 []string, err error) *KVMock {
+func (mmKeys *mKVMockKeys) Return(sa1 []string, q2 QueryMeta, err error) *KVMock {
 	if mmKeys.mock.funcKeys != nil {
 		mmKeys.mock.t.Fatalf("KVMock.Keys mock is already set by Set")
 	}
@@ -575,12 +823,12 @@ func (mmKeys *mKVMockKeys) Return(sa1 []string, err error) *KVMock {
 	if mmKeys.defaultExpectation == nil {
 		mmKeys.defaultExpectation = &KVMockKeysExpectation{mock: mmKeys.mock}
 	}
-	mmKeys.defaultExpectation.results = &KVMockKeysResults{sa1, err}
+	mmKeys.defaultExpectation.results = &KVMockKeysResults{sa1, q2, err}
 	return mmKeys.mock
 }
 
 //Set uses given function f to mock the KV.Keys method
-func (mmKeys *mKVMockKeys) Set(f func(c1 Ctx, s1 string, q1 Query) (sa1 []string, err error)) *KVMock {
+func (mmKeys *mKVMockKeys) Set(f func(c1 Ctx, s1 string, q1 Query) (sa1 []string, q2 QueryMeta, err error)) *KVMock {
 	if mmKeys.defaultExpectation != nil {
 		mmKeys.mock.t.Fatalf("Default expectation is already set for the KV.Keys method")
 	}
@@ -609,13 +857,13 @@ func (mmKeys *mKVMockKeys) When(c1 Ctx, s1 string, q1 Query) *KVMockKeysExpectat
 }
 
 // Then sets up KV.Keys return parameters for the expectation previously defined by the When method
-func (e *KVMockKeysExpectation) Then(sa1 []string, err error) *KVMock {
-	e.results = &KVMockKeysResults{sa1, err}
+func (e *KVMockKeysExpectation) Then(sa1 []string, q2 QueryMeta, err error) *KVMock {
+	e.results = &KVMockKeysResults{sa1, q2, err}
 	return e.mock
 }
 
 // Keys implements KV
-func (mmKeys *KVMock) Keys(c1 Ctx, s1 string, q1 Query) (sa1 []string, err error) {
+func (mmKeys *KVMock) Keys(c1 Ctx, s1 string, q1 Query) (sa1 []string, q2 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmKeys.beforeKeysCounter, 1)
 	defer mm_atomic.AddUint64(&mmKeys.afterKeysCounter, 1)
 
@@ -633,7 +881,7 @@ func (mmKeys *KVMock) Keys(c1 Ctx, s1 string, q1 Query) (sa1 []string, err error
 	for _, e := range mmKeys.KeysMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.sa1, e.results.q2, e.results.err
 		}
 	}
 
@@ -649,7 +897,7 @@ func (mmKeys *KVMock) Keys(c1 Ctx, s1 string, q1 Query) (sa1 []string, err error
 		if mm_results == nil {
 			mmKeys.t.Fatal("No results are set for the KVMock.Keys")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).q2, (*mm_results).err
 	}
 	if mmKeys.funcKeys != nil {
 		return mmKeys.funcKeys(c1, s1, q1)
@@ -968,6 +1216,7 @@ type KVMockRecurseParams struct {
 // KVMockRecurseResults contains results of the KV.Recurse
 type KVMockRecurseResults struct {
 	pa1 []Pair
+	q2  QueryMeta
 	err error
 }
 
@@ -1003,7 +1252,7 @@ func (mmRecurse *mKVMockRecurse) Inspect(f func(c1 Ctx, s1 string, q1 Query)) *m
 }
 
 // Return sets up results that will be returned by KV.Recurse
-func (mmRecurse *mKVMockRecurse) Return(pa1 []Pair, err error) *KVMock {
+func (mmRecurse *mKVMockRecurse) Return(pa1 []Pair, q2 QueryMeta, err error) *KVMock {
 	if mmRecurse.mock.funcRecurse != nil {
 		mmRecurse.mock.t.Fatalf("KVMock.Recurse mock is already set by Set")
 	}
@@ -1011,12 +1260,12 @@ func (mmRecurse *mKVMockRecurse) Return(pa1 []Pair, err error) *KVMock {
 	if mmRecurse.defaultExpectation == nil {
 		mmRecurse.defaultExpectation = &KVMockRecurseExpectation{mock: mmRecurse.mock}
 	}
-	mmRecurse.defaultExpectation.results = &KVMockRecurseResults{pa1, err}
+	mmRecurse.defaultExpectation.results = &KVMockRecurseResults{pa1, q2, err}
 	return mmRecurse.mock
 }
 
 //Set uses given function f to mock the KV.Recurse method
-func (mmRecurse *mKVMockRecurse) Set(f func(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, err error)) *KVMock {
+func (mmRecurse *mKVMockRecurse) Set(f func(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, q2 QueryMeta, err error)) *KVMock {
 	if mmRecurse.defaultExpectation != nil {
 		mmRecurse.mock.t.Fatalf("Default expectation is already set for the KV.Recurse method")
 	}
@@ -1045,13 +1294,13 @@ func (mmRecurse *mKVMockRecurse) When(c1 Ctx, s1 string, q1 Query) *KVMockRecurs
 }
 
 // Then sets up KV.Recurse return parameters for the expectation previously defined by the When method
-func (e *KVMockRecurseExpectation) Then(pa1 []Pair, err error) *KVMock {
-	e.results = &KVMockRecurseResults{pa1, err}
+func (e *KVMockRecurseExpectation) Then(pa1 []Pair, q2 QueryMeta, err error) *KVMock {
+	e.results = &KVMockRecurseResults{pa1, q2, err}
 	return e.mock
 }
 
 // Recurse implements KV
-func (mmRecurse *KVMock) Recurse(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, err error) {
+func (mmRecurse *KVMock) Recurse(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, q2 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmRecurse.beforeRecurseCounter, 1)
 	defer mm_atomic.AddUint64(&mmRecurse.afterRecurseCounter, 1)
 
@@ -1069,7 +1318,7 @@ func (mmRecurse *KVMock) Recurse(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, err e
 	for _, e := range mmRecurse.RecurseMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.pa1, e.results.err
+			return e.results.pa1, e.results.q2, e.results.err
 		}
 	}
 
@@ -1085,7 +1334,7 @@ func (mmRecurse *KVMock) Recurse(c1 Ctx, s1 string, q1 Query) (pa1 []Pair, err e
 		if mm_results == nil {
 			mmRecurse.t.Fatal("No results are set for the KVMock.Recurse")
 		}
-		return (*mm_results).pa1, (*mm_results).err
+		return (*mm_results).pa1, (*mm_results).q2, (*mm_results).err
 	}
 	if mmRecurse.funcRecurse != nil {
 		return mmRecurse.funcRecurse(c1, s1, q1)
@@ -1159,6 +1408,444 @@ func (m *KVMock) MinimockRecurseInspect() {
 	}
 }
 
+type mKVMockRecurseEntries struct {
+	mock               *KVMock
+	defaultExpectation *KVMockRecurseEntriesExpectation
+	expectations       []*KVMockRecurseEntriesExpectation
+
+	callArgs []*KVMockRecurseEntriesParams
+	mutex    sync.RWMutex
+}
+
+// KVMockRecurseEntriesExpectation specifies expectation struct of the KV.RecurseEntries
+type KVMockRecurseEntriesExpectation struct {
+	mock    *KVMock
+	params  *KVMockRecurseEntriesParams
+	results *KVMockRecurseEntriesResults
+	Counter uint64
+}
+
+// KVMockRecurseEntriesParams contains parameters of the KV.RecurseEntries
+type KVMockRecurseEntriesParams struct {
+	c1 Ctx
+	s1 string
+	q1 Query
+}
+
+// KVMockRecurseEntriesResults contains results of the KV.RecurseEntries
+type KVMockRecurseEntriesResults struct {
+	ka1 []KVEntry
+	q2  QueryMeta
+	err error
+}
+
+// Expect sets up expected params for KV.RecurseEntries
+func (mmRecurseEntries *mKVMockRecurseEntries) Expect(c1 Ctx, s1 string, q1 Query) *mKVMockRecurseEntries {
+	if mmRecurseEntries.mock.funcRecurseEntries != nil {
+		mmRecurseEntries.mock.t.Fatalf("KVMock.RecurseEntries mock is already set by Set")
+	}
+
+	if mmRecurseEntries.defaultExpectation == nil {
+		mmRecurseEntries.defaultExpectation = &KVMockRecurseEntriesExpectation{}
+	}
+
+	mmRecurseEntries.defaultExpectation.params = &KVMockRecurseEntriesParams{c1, s1, q1}
+	for _, e := range mmRecurseEntries.expectations {
+		if minimock.Equal(e.params, mmRecurseEntries.defaultExpectation.params) {
+			mmRecurseEntries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecurseEntries.defaultExpectation.params)
+		}
+	}
+
+	return mmRecurseEntries
+}
+
+// Inspect accepts an inspector function that has same arguments as the KV.RecurseEntries
+func (mmRecurseEntries *mKVMockRecurseEntries) Inspect(f func(c1 Ctx, s1 string, q1 Query)) *mKVMockRecurseEntries {
+	if mmRecurseEntries.mock.inspectFuncRecurseEntries != nil {
+		mmRecurseEntries.mock.t.Fatalf("Inspect function is already set for KVMock.RecurseEntries")
+	}
+
+	mmRecurseEntries.mock.inspectFuncRecurseEntries = f
+
+	return mmRecurseEntries
+}
+
+// Return sets up results that will be returned by KV.RecurseEntries
+func (mmRecurseEntries *mKVMockRecurseEntries) Return(ka1 []KVEntry, q2 QueryMeta, err error) *KVMock {
+	if mmRecurseEntries.mock.funcRecurseEntries != nil {
+		mmRecurseEntries.mock.t.Fatalf("KVMock.RecurseEntries mock is already set by Set")
+	}
+
+	if mmRecurseEntries.defaultExpectation == nil {
+		mmRecurseEntries.defaultExpectation = &KVMockRecurseEntriesExpectation{mock: mmRecurseEntries.mock}
+	}
+	mmRecurseEntries.defaultExpectation.results = &KVMockRecurseEntriesResults{ka1, q2, err}
+	return mmRecurseEntries.mock
+}
+
+//Set uses given function f to mock the KV.RecurseEntries method
+func (mmRecurseEntries *mKVMockRecurseEntries) Set(f func(c1 Ctx, s1 string, q1 Query) (ka1 []KVEntry, q2 QueryMeta, err error)) *KVMock {
+	if mmRecurseEntries.defaultExpectation != nil {
+		mmRecurseEntries.mock.t.Fatalf("Default expectation is already set for the KV.RecurseEntries method")
+	}
+
+	if len(mmRecurseEntries.expectations) > 0 {
+		mmRecurseEntries.mock.t.Fatalf("Some expectations are already set for the KV.RecurseEntries method")
+	}
+
+	mmRecurseEntries.mock.funcRecurseEntries = f
+	return mmRecurseEntries.mock
+}
+
+// When sets expectation for the KV.RecurseEntries which will trigger the result defined by the following
+// Then helper
+func (mmRecurseEntries *mKVMockRecurseEntries) When(c1 Ctx, s1 string, q1 Query) *KVMockRecurseEntriesExpectation {
+	if mmRecurseEntries.mock.funcRecurseEntries != nil {
+		mmRecurseEntries.mock.t.Fatalf("KVMock.RecurseEntries mock is already set by Set")
+	}
+
+	expectation := &KVMockRecurseEntriesExpectation{
+		mock:   mmRecurseEntries.mock,
+		params: &KVMockRecurseEntriesParams{c1, s1, q1},
+	}
+	mmRecurseEntries.expectations = append(mmRecurseEntries.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KV.RecurseEntries return parameters for the expectation previously defined by the When method
+func (e *KVMockRecurseEntriesExpectation) Then(ka1 []KVEntry, q2 QueryMeta, err error) *KVMock {
+	e.results = &KVMockRecurseEntriesResults{ka1, q2, err}
+	return e.mock
+}
+
+// RecurseEntries implements KV
+func (mmRecurseEntries *KVMock) RecurseEntries(c1 Ctx, s1 string, q1 Query) (ka1 []KVEntry, q2 QueryMeta, err error) {
+	mm_atomic.AddUint64(&mmRecurseEntries.beforeRecurseEntriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecurseEntries.afterRecurseEntriesCounter, 1)
+
+	if mmRecurseEntries.inspectFuncRecurseEntries != nil {
+		mmRecurseEntries.inspectFuncRecurseEntries(c1, s1, q1)
+	}
+
+	mm_params := &KVMockRecurseEntriesParams{c1, s1, q1}
+
+	// Record call args
+	mmRecurseEntries.RecurseEntriesMock.mutex.Lock()
+	mmRecurseEntries.RecurseEntriesMock.callArgs = append(mmRecurseEntries.RecurseEntriesMock.callArgs, mm_params)
+	mmRecurseEntries.RecurseEntriesMock.mutex.Unlock()
+
+	for _, e := range mmRecurseEntries.RecurseEntriesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ka1, e.results.q2, e.results.err
+		}
+	}
+
+	if mmRecurseEntries.RecurseEntriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecurseEntries.RecurseEntriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecurseEntries.RecurseEntriesMock.defaultExpectation.params
+		mm_got := KVMockRecurseEntriesParams{c1, s1, q1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecurseEntries.t.Errorf("KVMock.RecurseEntries got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecurseEntries.RecurseEntriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecurseEntries.t.Fatal("No results are set for the KVMock.RecurseEntries")
+		}
+		return (*mm_results).ka1, (*mm_results).q2, (*mm_results).err
+	}
+	if mmRecurseEntries.funcRecurseEntries != nil {
+		return mmRecurseEntries.funcRecurseEntries(c1, s1, q1)
+	}
+	mmRecurseEntries.t.Fatalf("Unexpected call to KVMock.RecurseEntries. %v %v %v", c1, s1, q1)
+	return
+}
+
+// RecurseEntriesAfterCounter returns a count of finished KVMock.RecurseEntries invocations
+func (mmRecurseEntries *KVMock) RecurseEntriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecurseEntries.afterRecurseEntriesCounter)
+}
+
+// RecurseEntriesBeforeCounter returns a count of KVMock.RecurseEntries invocations
+func (mmRecurseEntries *KVMock) RecurseEntriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecurseEntries.beforeRecurseEntriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to KVMock.RecurseEntries.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecurseEntries *mKVMockRecurseEntries) Calls() []*KVMockRecurseEntriesParams {
+	mmRecurseEntries.mutex.RLock()
+
+	argCopy := make([]*KVMockRecurseEntriesParams, len(mmRecurseEntries.callArgs))
+	copy(argCopy, mmRecurseEntries.callArgs)
+
+	mmRecurseEntries.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecurseEntriesDone returns true if the count of the RecurseEntries invocations corresponds
+// the number of defined expectations
+func (m *KVMock) MinimockRecurseEntriesDone() bool {
+	for _, e := range m.RecurseEntriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecurseEntriesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRecurseEntriesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecurseEntries != nil && mm_atomic.LoadUint64(&m.afterRecurseEntriesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRecurseEntriesInspect logs each unmet expectation
+func (m *KVMock) MinimockRecurseEntriesInspect() {
+	for _, e := range m.RecurseEntriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KVMock.RecurseEntries with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecurseEntriesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRecurseEntriesCounter) < 1 {
+		if m.RecurseEntriesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to KVMock.RecurseEntries")
+		} else {
+			m.t.Errorf("Expected call to KVMock.RecurseEntries with params: %#v", *m.RecurseEntriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecurseEntries != nil && mm_atomic.LoadUint64(&m.afterRecurseEntriesCounter) < 1 {
+		m.t.Error("Expected call to KVMock.RecurseEntries")
+	}
+}
+
+type mKVMockWrite struct {
+	mock               *KVMock
+	defaultExpectation *KVMockWriteExpectation
+	expectations       []*KVMockWriteExpectation
+
+	callArgs []*KVMockWriteParams
+	mutex    sync.RWMutex
+}
+
+// KVMockWriteExpectation specifies expectation struct of the KV.Write
+type KVMockWriteExpectation struct {
+	mock    *KVMock
+	params  *KVMockWriteParams
+	results *KVMockWriteResults
+	Counter uint64
+}
+
+// KVMockWriteParams contains parameters of the KV.Write
+type KVMockWriteParams struct {
+	c1 Ctx
+	s1 string
+	s2 string
+	w1 WriteQuery
+}
+
+// KVMockWriteResults contains results of the KV.Write
+type KVMockWriteResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for KV.Write
+func (mmWrite *mKVMockWrite) Expect(c1 Ctx, s1 string, s2 string, w1 WriteQuery) *mKVMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("KVMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &KVMockWriteExpectation{}
+	}
+
+	mmWrite.defaultExpectation.params = &KVMockWriteParams{c1, s1, s2, w1}
+	for _, e := range mmWrite.expectations {
+		if minimock.Equal(e.params, mmWrite.defaultExpectation.params) {
+			mmWrite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWrite.defaultExpectation.params)
+		}
+	}
+
+	return mmWrite
+}
+
+// Inspect accepts an inspector function that has same arguments as the KV.Write
+func (mmWrite *mKVMockWrite) Inspect(f func(c1 Ctx, s1 string, s2 string, w1 WriteQuery)) *mKVMockWrite {
+	if mmWrite.mock.inspectFuncWrite != nil {
+		mmWrite.mock.t.Fatalf("Inspect function is already set for KVMock.Write")
+	}
+
+	mmWrite.mock.inspectFuncWrite = f
+
+	return mmWrite
+}
+
+// Return sets up results that will be returned by KV.Write
+func (mmWrite *mKVMockWrite) Return(b1 bool, err error) *KVMock {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("KVMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &KVMockWriteExpectation{mock: mmWrite.mock}
+	}
+	mmWrite.defaultExpectation.results = &KVMockWriteResults{b1, err}
+	return mmWrite.mock
+}
+
+//Set uses given function f to mock the KV.Write method
+func (mmWrite *mKVMockWrite) Set(f func(c1 Ctx, s1 string, s2 string, w1 WriteQuery) (b1 bool, err error)) *KVMock {
+	if mmWrite.defaultExpectation != nil {
+		mmWrite.mock.t.Fatalf("Default expectation is already set for the KV.Write method")
+	}
+
+	if len(mmWrite.expectations) > 0 {
+		mmWrite.mock.t.Fatalf("Some expectations are already set for the KV.Write method")
+	}
+
+	mmWrite.mock.funcWrite = f
+	return mmWrite.mock
+}
+
+// When sets expectation for the KV.Write which will trigger the result defined by the following
+// Then helper
+func (mmWrite *mKVMockWrite) When(c1 Ctx, s1 string, s2 string, w1 WriteQuery) *KVMockWriteExpectation {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("KVMock.Write mock is already set by Set")
+	}
+
+	expectation := &KVMockWriteExpectation{
+		mock:   mmWrite.mock,
+		params: &KVMockWriteParams{c1, s1, s2, w1},
+	}
+	mmWrite.expectations = append(mmWrite.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KV.Write return parameters for the expectation previously defined by the When method
+func (e *KVMockWriteExpectation) Then(b1 bool, err error) *KVMock {
+	e.results = &KVMockWriteResults{b1, err}
+	return e.mock
+}
+
+// Write implements KV
+func (mmWrite *KVMock) Write(c1 Ctx, s1 string, s2 string, w1 WriteQuery) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmWrite.beforeWriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmWrite.afterWriteCounter, 1)
+
+	if mmWrite.inspectFuncWrite != nil {
+		mmWrite.inspectFuncWrite(c1, s1, s2, w1)
+	}
+
+	mm_params := &KVMockWriteParams{c1, s1, s2, w1}
+
+	// Record call args
+	mmWrite.WriteMock.mutex.Lock()
+	mmWrite.WriteMock.callArgs = append(mmWrite.WriteMock.callArgs, mm_params)
+	mmWrite.WriteMock.mutex.Unlock()
+
+	for _, e := range mmWrite.WriteMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmWrite.WriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWrite.WriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmWrite.WriteMock.defaultExpectation.params
+		mm_got := KVMockWriteParams{c1, s1, s2, w1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWrite.t.Errorf("KVMock.Write got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWrite.WriteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWrite.t.Fatal("No results are set for the KVMock.Write")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmWrite.funcWrite != nil {
+		return mmWrite.funcWrite(c1, s1, s2, w1)
+	}
+	mmWrite.t.Fatalf("Unexpected call to KVMock.Write. %v %v %v %v", c1, s1, s2, w1)
+	return
+}
+
+// WriteAfterCounter returns a count of finished KVMock.Write invocations
+func (mmWrite *KVMock) WriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.afterWriteCounter)
+}
+
+// WriteBeforeCounter returns a count of KVMock.Write invocations
+func (mmWrite *KVMock) WriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.beforeWriteCounter)
+}
+
+// Calls returns a list of arguments used in each call to KVMock.Write.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWrite *mKVMockWrite) Calls() []*KVMockWriteParams {
+	mmWrite.mutex.RLock()
+
+	argCopy := make([]*KVMockWriteParams, len(mmWrite.callArgs))
+	copy(argCopy, mmWrite.callArgs)
+
+	mmWrite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteDone returns true if the count of the Write invocations corresponds
+// the number of defined expectations
+func (m *KVMock) MinimockWriteDone() bool {
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterWriteCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWrite != nil && mm_atomic.LoadUint64(&m.afterWriteCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockWriteInspect logs each unmet expectation
+func (m *KVMock) MinimockWriteInspect() {
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KVMock.Write with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterWriteCounter) < 1 {
+		if m.WriteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to KVMock.Write")
+		} else {
+			m.t.Errorf("Expected call to KVMock.Write with params: %#v", *m.WriteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWrite != nil && mm_atomic.LoadUint64(&m.afterWriteCounter) < 1 {
+		m.t.Error("Expected call to KVMock.Write")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *KVMock) MinimockFinish() {
 	if !m.minimockDone() {
@@ -1166,11 +1853,17 @@ func (m *KVMock) MinimockFinish() {
 
 		m.MinimockGetInspect()
 
+		m.MinimockGetEntryInspect()
+
 		m.MinimockKeysInspect()
 
 		m.MinimockPutInspect()
 
 		m.MinimockRecurseInspect()
+
+		m.MinimockRecurseEntriesInspect()
+
+		m.MinimockWriteInspect()
 		m.t.FailNow()
 	}
 }
@@ -1196,7 +1889,10 @@ func (m *KVMock) minimockDone() bool {
 	return done &&
 		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
+		m.MinimockGetEntryDone() &&
 		m.MinimockKeysDone() &&
 		m.MinimockPutDone() &&
-		m.MinimockRecurseDone()
+		m.MinimockRecurseDone() &&
+		m.MinimockRecurseEntriesDone() &&
+		m.MinimockWriteDone()
 }
@@ -3,6 +3,7 @@ package consulapi
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -36,7 +37,7 @@ func Test_KV_Get(t *testing.T) {
 	})
 	defer ts.Close()
 
-	v, err := client.Get(ctx, "config/baz/bar", Query{})
+	v, _, err := client.Get(ctx, "config/baz/bar", Query{})
 	require.NoError(t, err)
 	require.Equal(t, "myValue", v)
 }
@@ -54,7 +55,7 @@ func Test_KV_Get_dc(t *testing.T) {
 	})
 	defer ts.Close()
 
-	v, err := client.Get(ctx, "config/baz/bar", Query{DC: "dc1"})
+	v, _, err := client.Get(ctx, "config/baz/bar", Query{DC: "dc1"})
 	require.NoError(t, err)
 	require.Equal(t, "myValue", v)
 }
@@ -70,8 +71,8 @@ func Test_KV_Get_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Get(ctx, "config/baz/bar", Query{})
-	require.EqualError(t, err, "status code (500)")
+	_, _, err := client.Get(ctx, "config/baz/bar", Query{})
+	require.Contains(t, err.Error(), "GET /v1/kv/config/baz/bar: status code (500): ")
 }
 
 func Test_KV_Get_non_existent(t *testing.T) {
@@ -85,10 +86,100 @@ func Test_KV_Get_non_existent(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Get(ctx, "config/baz/bar", Query{})
+	_, _, err := client.Get(ctx, "config/baz/bar", Query{})
 	require.EqualError(t, err, `key "/v1/kv/config/baz/bar" does not exist`)
 }
 
+func Test_KV_Get_blocking(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:    t,
+		code: http.StatusOK,
+		body: load(t, "v1_kv_config_baz_bar.json"),
+		headers: map[string]string{
+			"X-Consul-Index":       "101",
+			"X-Consul-KnownLeader": "true",
+			"X-Consul-LastContact": "25",
+		},
+		hasPath:   "/v1/kv/config/baz/bar",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"100"},
+			"wait":  {"30000ms"},
+		},
+	})
+	defer ts.Close()
+
+	v, meta, err := client.Get(ctx, "config/baz/bar", Query{
+		WaitIndex: 100,
+		WaitTime:  30 * time.Second,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "myValue", v)
+	require.Equal(t, QueryMeta{
+		LastIndex:   101,
+		LastContact: 25 * time.Millisecond,
+		KnownLeader: true,
+	}, meta)
+}
+
+func Test_KV_Get_non_existent_index(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:    t,
+		code: http.StatusNotFound,
+		body: "",
+		headers: map[string]string{
+			"X-Consul-Index": "42",
+		},
+		hasPath:   "/v1/kv/config/baz/bar",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	_, meta, err := client.Get(ctx, "config/baz/bar", Query{})
+	require.EqualError(t, err, `key "/v1/kv/config/baz/bar" does not exist`)
+	require.Equal(t, uint64(42), meta.LastIndex)
+}
+
+func Test_KV_GetEntry(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_kv_service_leader.json"),
+		hasPath:   "/v1/kv/service/leader",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	entry, _, err := client.GetEntry(ctx, "service/leader", Query{})
+	require.NoError(t, err)
+	require.Equal(t, KVEntry{
+		Key:         "service/leader",
+		Value:       `"node1"`,
+		CreateIndex: 1080093,
+		ModifyIndex: 1080105,
+		LockIndex:   3,
+		Flags:       7,
+		Session:     "adf4238a-882b-9ddc-4a9d-5b6758e4159e",
+	}, entry)
+}
+
+func Test_KV_GetEntry_non_existent(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusNotFound,
+		body:      "",
+		hasPath:   "/v1/kv/service/leader",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	_, _, err := client.GetEntry(ctx, "service/leader", Query{})
+	require.EqualError(t, err, `key "/v1/kv/service/leader" does not exist`)
+}
+
 func Test_KV_Put(t *testing.T) {
 	ctx, ts, client := testClient(&responder{
 		t:         t,
@@ -136,7 +227,123 @@ func Test_KV_Put_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.Put(ctx, "config/baz/bar", "someValue", Query{})
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "PUT /v1/kv/config/baz/bar: status code (500): malfunction")
+}
+
+func Test_KV_Write_cas(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/config/baz/bar",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"cas":   {"1080093"},
+			"flags": {"42"},
+		},
+		hasBody: "someValue",
+	})
+	defer ts.Close()
+
+	applied, err := client.Write(ctx, "config/baz/bar", "someValue", WriteQuery{
+		Flags:       42,
+		CAS:         true,
+		ModifyIndex: 1080093,
+	})
+	require.NoError(t, err)
+	require.True(t, applied)
+}
+
+func Test_KV_Write_cas_create(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "false",
+		hasPath:   "/v1/kv/config/baz/bar",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"cas": {"0"},
+			"dc":  {"dc1"},
+		},
+		hasBody: "someValue",
+	})
+	defer ts.Close()
+
+	applied, err := client.Write(ctx, "config/baz/bar", "someValue", WriteQuery{
+		DC:  "dc1",
+		CAS: true,
+	})
+	require.NoError(t, err)
+	require.False(t, applied)
+}
+
+func Test_KV_Write_acquire(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/service/leader",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"acquire": {"abc123"},
+		},
+		hasBody: "node1",
+	})
+	defer ts.Close()
+
+	applied, err := client.Write(ctx, "service/leader", "node1", WriteQuery{
+		Acquire: "abc123",
+	})
+	require.NoError(t, err)
+	require.True(t, applied)
+}
+
+func Test_KV_Write_release(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/service/leader",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"release": {"abc123"},
+		},
+		hasBody: "",
+	})
+	defer ts.Close()
+
+	applied, err := client.Write(ctx, "service/leader", "", WriteQuery{
+		Release: "abc123",
+	})
+	require.NoError(t, err)
+	require.True(t, applied)
+}
+
+func Test_KV_Write_exclusive(t *testing.T) {
+	ctx, ts, client := testClient(&responder{t: t})
+	defer ts.Close()
+
+	_, err := client.Write(ctx, "service/leader", "", WriteQuery{
+		CAS:     true,
+		Acquire: "abc123",
+	})
+	require.EqualError(t, err, "write may only use one of cas, acquire, or release")
+}
+
+func Test_KV_Write_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/kv/config/baz/bar",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   "someValue",
+	})
+	defer ts.Close()
+
+	_, err := client.Write(ctx, "config/baz/bar", "someValue", WriteQuery{})
+	require.EqualError(t, err, "PUT /v1/kv/config/baz/bar: status code (500): malfunction")
 }
 
 func Test_KV_Delete(t *testing.T) {
@@ -183,7 +390,7 @@ func Test_KV_Delete_err(t *testing.T) {
 	defer ts.Close()
 
 	err := client.Delete(ctx, "config/baz/bar", Query{})
-	require.EqualError(t, err, "status code (500)")
+	require.EqualError(t, err, "DELETE /v1/kv/config/baz/bar: status code (500): malfunction")
 }
 
 func Test_KV_Keys(t *testing.T) {
@@ -199,7 +406,7 @@ func Test_KV_Keys(t *testing.T) {
 	})
 	defer ts.Close()
 
-	values, err := client.Keys(ctx, "config/baz", Query{})
+	values, _, err := client.Keys(ctx, "config/baz", Query{})
 	require.NoError(t, err)
 	require.Equal(t, 3, len(values))
 }
@@ -218,7 +425,7 @@ func Test_KV_Keys_dc(t *testing.T) {
 	})
 	defer ts.Close()
 
-	values, err := client.Keys(ctx, "config/baz", Query{DC: "dc1"})
+	values, _, err := client.Keys(ctx, "config/baz", Query{DC: "dc1"})
 	require.NoError(t, err)
 	require.Equal(t, 3, len(values))
 	require.Equal(t, []string{
@@ -239,8 +446,8 @@ func Test_KV_Keys_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Keys(ctx, "config/baz", Query{})
-	require.EqualError(t, err, "status code (500)")
+	_, _, err := client.Keys(ctx, "config/baz", Query{})
+	require.EqualError(t, err, "GET /v1/kv/config/baz?keys=true: status code (500): malfunction")
 }
 
 func Test_KV_Recurse(t *testing.T) {
@@ -256,7 +463,7 @@ func Test_KV_Recurse(t *testing.T) {
 	})
 	defer ts.Close()
 
-	values, err := client.Recurse(ctx, "config/baz", Query{})
+	values, _, err := client.Recurse(ctx, "config/baz", Query{})
 	require.NoError(t, err)
 	require.Equal(t, 8, len(values))
 	require.Equal(t, []Pair{
@@ -271,6 +478,58 @@ func Test_KV_Recurse(t *testing.T) {
 	}, values)
 }
 
+func Test_KV_Recurse_blocking(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:    t,
+		code: http.StatusOK,
+		body: load(t, "v1_kv_config_baz-recurse.json"),
+		headers: map[string]string{
+			"X-Consul-Index": "8",
+		},
+		hasPath:   "/v1/kv/config/baz",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"recurse": {"true"},
+			"index":   {"7"},
+		},
+	})
+	defer ts.Close()
+
+	values, meta, err := client.Recurse(ctx, "config/baz", Query{WaitIndex: 7})
+	require.NoError(t, err)
+	require.Equal(t, 8, len(values))
+	require.Equal(t, uint64(8), meta.LastIndex)
+}
+
+func Test_KV_RecurseEntries(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_kv_config_baz-recurse.json"),
+		hasPath:   "/v1/kv/config/baz",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"recurse": {"true"},
+		},
+	})
+	defer ts.Close()
+
+	entries, _, err := client.RecurseEntries(ctx, "config/baz", Query{})
+	require.NoError(t, err)
+	require.Equal(t, 8, len(entries))
+	require.Equal(t, KVEntry{
+		Key:         "config/baz",
+		CreateIndex: 1081501,
+		ModifyIndex: 1081501,
+	}, entries[0])
+	require.Equal(t, KVEntry{
+		Key:         "config/baz/bar",
+		Value:       "myValue",
+		CreateIndex: 1080093,
+		ModifyIndex: 1080170,
+	}, entries[2])
+}
+
 func Test_KV_Recurse_dc(t *testing.T) {
 	ctx, ts, client := testClient(&responder{
 		t:         t,
@@ -285,7 +544,7 @@ func Test_KV_Recurse_dc(t *testing.T) {
 	})
 	defer ts.Close()
 
-	values, err := client.Recurse(ctx, "config/baz", Query{DC: "dc1"})
+	values, _, err := client.Recurse(ctx, "config/baz", Query{DC: "dc1"})
 	require.NoError(t, err)
 	require.Equal(t, 8, len(values))
 	require.Equal(t, []Pair{
@@ -313,8 +572,8 @@ func Test_KV_Recurse_err(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Recurse(ctx, "config/baz", Query{})
-	require.EqualError(t, err, "status code (500)")
+	_, _, err := client.Recurse(ctx, "config/baz", Query{})
+	require.EqualError(t, err, "GET /v1/kv/config/baz?recurse=true: status code (500): malfunction")
 }
 
 func Test_KV_Recurse_non_existent(t *testing.T) {
@@ -330,6 +589,6 @@ func Test_KV_Recurse_non_existent(t *testing.T) {
 	})
 	defer ts.Close()
 
-	_, err := client.Recurse(ctx, "config/not-here", Query{})
+	_, _, err := client.Recurse(ctx, "config/not-here", Query{})
 	require.EqualError(t, err, `key-space "config/not-here" does not exist`)
 }
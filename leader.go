@@ -2,10 +2,11 @@ package consulapi
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -26,6 +27,10 @@ type LeadershipConfig struct {
 	// this string will in the form of a URI.
 	ContactInfo string
 
+	// Metadata (optional) is arbitrary information about the leader, which is
+	// published in the LeaderRecord alongside ContactInfo.
+	Metadata map[string]string
+
 	// Description is an arbitrary human-readable name for this leader election.
 	// If not set, Description defaults to "default-leader-session".
 	Description string
@@ -35,8 +40,72 @@ type LeadershipConfig struct {
 
 	// See SessionConfig.TTL.
 	TTL time.Duration
+
+	// Node (optional) is the node the session is associated with. If not set,
+	// the node of the queried agent is used.
+	Node string
+
+	// See SessionConfig.Checks. For example, a session associated with the
+	// health check of the service doing the work of the leader is invalidated
+	// if the service becomes critical, which causes the leader to lose
+	// leadership automatically.
+	Checks []string
+
+	// See SessionConfig.NodeChecks.
+	NodeChecks []string
+
+	// See SessionConfig.ServiceChecks.
+	ServiceChecks []SessionServiceCheck
+
+	// Behavior (optional) controls what happens to the leader key when the
+	// session of the leader is invalidated. If not set, Behavior defaults to
+	// SessionDelete. See SessionConfig.Behavior.
+	Behavior SessionTerminationBehavior
+
+	// RenewInterval (optional) is how often the session is renewed, which must
+	// be less than TTL. If not set, the session is renewed every TTL/2.
+	RenewInterval time.Duration
+
+	// RequestTimeout (optional) limits how long each request made to create
+	// the session, and to acquire or release leadership, may take. If not
+	// set, RequestTimeout defaults to 30 seconds.
+	RequestTimeout time.Duration
+
+	// CampaignInterval (optional) is how long a standby waits between attempts
+	// to acquire leadership. If not set, CampaignInterval defaults to TTL.
+	// Not used if WatchLock is set.
+	CampaignInterval time.Duration
+
+	// WatchLock (optional) makes a standby watch the leader key using blocking
+	// queries, and attempt to acquire leadership as soon as the key is
+	// released, rather than every CampaignInterval. Note that if the session
+	// of the leader is invalidated, the LockDelay of that session must still
+	// pass before leadership can be acquired.
+	WatchLock bool
+
+	// RetryInterval (optional) is how long to wait before trying again after
+	// failing to create a session, or to acquire leadership. The wait doubles
+	// with each consecutive failure, up to MaxRetryInterval. If not set,
+	// RetryInterval defaults to 1 second.
+	RetryInterval time.Duration
+
+	// MaxRetryInterval (optional) limits how long to wait after consecutive
+	// failures. If not set, MaxRetryInterval defaults to 30 seconds.
+	MaxRetryInterval time.Duration
+
+	// Jitter (optional) is the fraction by which every wait is randomly
+	// lengthened or shortened, so that participants do not act in lockstep.
+	// It must be between 0 and 1, e.g. a Jitter of 0.1 varies each wait by up
+	// to 10%. If not set, waits are not randomized.
+	Jitter float64
 }
 
+const (
+	defaultLeaderRequestTimeout   = 30 * time.Second
+	defaultLeaderRetryInterval    = 1 * time.Second
+	defaultLeaderMaxRetryInterval = 30 * time.Second
+)
+
 func (lc LeadershipConfig) name() string {
 	if lc.Description == "" {
 		return "default-leader-session"
@@ -44,6 +113,61 @@ func (lc LeadershipConfig) name() string {
 	return lc.Description
 }
 
+func (lc LeadershipConfig) behavior() SessionTerminationBehavior {
+	if lc.Behavior == "" {
+		return SessionDelete
+	}
+	return lc.Behavior
+}
+
+func (lc LeadershipConfig) validate() error {
+	switch {
+	case lc.RenewInterval < 0 || (lc.RenewInterval > 0 && lc.RenewInterval >= lc.TTL):
+		return errors.New("leadership renew interval must be less than ttl")
+	case lc.Jitter < 0 || lc.Jitter > 1:
+		return errors.New("leadership jitter must be between 0 and 1")
+	}
+	return nil
+}
+
+func (lc LeadershipConfig) requestTimeout() time.Duration {
+	if lc.RequestTimeout <= 0 {
+		return defaultLeaderRequestTimeout
+	}
+	return lc.RequestTimeout
+}
+
+func (lc LeadershipConfig) campaignInterval() time.Duration {
+	if lc.CampaignInterval <= 0 {
+		return lc.jitter(lc.TTL)
+	}
+	return lc.jitter(lc.CampaignInterval)
+}
+
+// retry returns how long to wait after the given number of consecutive
+// failures.
+func (lc LeadershipConfig) retry(failures int) time.Duration {
+	min := lc.RetryInterval
+	if min <= 0 {
+		min = defaultLeaderRetryInterval
+	}
+
+	max := lc.MaxRetryInterval
+	if max <= 0 {
+		max = defaultLeaderMaxRetryInterval
+	}
+
+	return lc.jitter(backoff(min, max, failures))
+}
+
+// jitter randomly lengthens or shortens d by up to the Jitter fraction of d.
+func (lc LeadershipConfig) jitter(d time.Duration) time.Duration {
+	if lc.Jitter <= 0 {
+		return d
+	}
+	return d + time.Duration((2*rand.Float64()-1)*lc.Jitter*float64(d))
+}
+
 // AsLeaderFunc is executed when the client is able to acquire
 // the underlying consul leader lock. When a value is sent on context.Done,
 // this client is no longer the elected leader and must cease any operations
@@ -53,12 +177,63 @@ func (lc LeadershipConfig) name() string {
 // If the implementation returns from the function before the context is
 // cancelled, leadership will be abdicated, the context will be cancelled,
 // and no further action should be taken until elected leader again.
+//
+// The context carries the fencing token of the current term, which can be
+// retrieved using FencingToken.
 type AsLeaderFunc func(Ctx) error
 
+// A LeaderRecord is the value stored in the leader key by the elected leader.
+type LeaderRecord struct {
+	// ContactInfo is the LeadershipConfig.ContactInfo of the leader.
+	ContactInfo string `json:"ContactInfo"`
+
+	// Metadata is the LeadershipConfig.Metadata of the leader.
+	Metadata map[string]string `json:"Metadata,omitempty"`
+
+	// ElectedAt is when the leader was elected.
+	ElectedAt time.Time `json:"ElectedAt"`
+
+	// Session is the ID of the session the leader was elected with.
+	Session SessionID `json:"Session"`
+}
+
+// decodeLeaderRecord decodes the value of the leader key. Values written by
+// older versions of this package, which contain only the json encoded
+// ContactInfo, or values written by something else entirely, are decoded
+// into a LeaderRecord with only the ContactInfo set.
+func decodeLeaderRecord(value string) LeaderRecord {
+	var record LeaderRecord
+	if err := json.Unmarshal([]byte(value), &record); err == nil {
+		return record
+	}
+
+	var contactInfo string
+	if err := json.Unmarshal([]byte(value), &contactInfo); err != nil {
+		// not written by a leadershipManager, use the raw value
+		return LeaderRecord{ContactInfo: value}
+	}
+	return LeaderRecord{ContactInfo: contactInfo}
+}
+
+type fencingTokenKey struct{}
+
+// FencingToken returns the fencing token of the leadership term from the
+// context of an AsLeaderFunc. The token is the LockIndex of the leader key,
+// which increases every time a new leader is elected. Downstream systems can
+// use it to reject writes from a deposed leader, by rejecting any write with
+// a lower token than one they have already seen.
+func FencingToken(ctx Ctx) (uint64, bool) {
+	token, ok := ctx.Value(fencingTokenKey{}).(uint64)
+	return token, ok
+}
+
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Candidate -s _mock.go
 
 // A Candidate implementation is able to Participate in leadership elections.
 type Candidate interface {
+	// Participate in leadership elections in the background, running the
+	// AsLeaderFunc whenever elected leader. Participation continues until
+	// the given context is cancelled, or LeaderSession.Resign is called.
 	Participate(Ctx, LeadershipConfig, AsLeaderFunc) (LeaderSession, error)
 }
 
@@ -68,81 +243,207 @@ type Candidate interface {
 // being used to participate in leadership elections.
 type LeaderSession interface {
 	Abdicate(Ctx) error
+
+	// Current returns the ContactInfo of the elected leader. If there is
+	// currently no leader, a *NoLeaderError is returned.
 	Current(Ctx) (string, error)
+
 	SessionID(Ctx) string
+
+	// Resign stops participating in leadership elections. If this client is
+	// the elected leader, the context of the AsLeaderFunc is cancelled, and
+	// the leader lock is released once the AsLeaderFunc returns. The session
+	// is then destroyed. Resign returns once all of that is complete, or when
+	// ctx is done, whichever happens first. Cancelling the context passed to
+	// Participate has the same effect, after which Resign may be used to wait
+	// for the shutdown to complete.
+	Resign(Ctx) error
+
+	// IsLeader indicates whether this client is currently the elected leader.
+	IsLeader() bool
+
+	// Events returns a channel on which a LeadershipEvent is sent whenever
+	// this client gains or loses leadership, or the observed leader changes.
+	// The current state is sent immediately. The channel is closed once ctx
+	// is done, or participation stops.
+	//
+	// Each event is a complete snapshot of the leadership state. A receiver
+	// which falls behind may miss intermediate events, but always receives
+	// the most recent one.
+	Events(Ctx) <-chan LeadershipEvent
+}
+
+// A LeadershipEvent describes the state of a leadership election, as seen by
+// one participant.
+type LeadershipEvent struct {
+	// IsLeader indicates whether this client is the elected leader.
+	IsLeader bool
+
+	// Leader is the ContactInfo of the elected leader, or empty if there is
+	// currently no leader.
+	Leader string
 }
 
 type leadershipManager struct {
 	client *client
 	key    string
 
-	self        AgentInfo
+	config      LeadershipConfig
+	node        string
 	contactInfo string
+	metadata    map[string]string
 	sessionTTL  time.Duration
 	sessionID   atomic.Value
 	isLeader    atomic.Value
 
 	asLeader AsLeaderFunc
+
+	// stop cancels the context of run, and done is closed once run returns
+	stop context.CancelFunc
+	done chan struct{}
+
+	lock        sync.Mutex
+	termCancel  context.CancelFunc // cancels the current term as leader
+	electedAt   time.Time          // start of the current or latest term
+	shutdownErr error
+	state       LeadershipEvent
+	subscribers map[chan LeadershipEvent]struct{}
+	stopped     bool
 }
 
 func (c *client) Participate(ctx Ctx, opts LeadershipConfig, f AsLeaderFunc) (LeaderSession, error) {
-	self, err := c.Self(ctx)
-	if err != nil {
+	if err := opts.validate(); err != nil {
 		return nil, err
 	}
 
+	node := opts.Node
+	if node == "" {
+		self, err := c.Self(ctx)
+		if err != nil {
+			return nil, err
+		}
+		node = self.Name
+	}
+
+	runCtx, stop := context.WithCancel(ctx)
+
 	manager := &leadershipManager{
 		client: c,
 		key:    strings.TrimPrefix(opts.Key, "/"),
 
-		self:        self,
+		config:      opts,
+		node:        node,
 		contactInfo: opts.ContactInfo,
+		metadata:    opts.Metadata,
 		sessionTTL:  opts.TTL,
 		asLeader:    f,
+
+		stop:        stop,
+		done:        make(chan struct{}),
+		subscribers: make(map[chan LeadershipEvent]struct{}),
 	}
 
-	go manager.maintainSession(opts)
-	go manager.maintainLeadership()
+	go manager.run(runCtx)
 
 	return manager, nil
 }
 
-func (lm *leadershipManager) maintainSession(opts LeadershipConfig) {
-	for {
+// run participates in leadership elections until ctx is done. Each session
+// is used until it is lost, at which point a new session is created.
+func (lm *leadershipManager) run(ctx Ctx) {
+	defer close(lm.done)
+	defer lm.closeSubscribers()
+
+	// initially we are not the leader
+	lm.setIsLeader(false)
 
-		if err := lm.createSession(opts); err != nil {
-			lm.client.log.Warnf("failed to create session, try again in 3s: %v", err)
-			time.Sleep(3 * time.Second)
+	// observe the leader key, so subscribers learn of the elected leader
+	observed := lm.client.WatchKey(ctx, lm.key, Query{}, WatchOptions{
+		Handler: lm.observe,
+	})
+	defer func() { <-observed }()
+
+	failures := 0
+	for ctx.Err() == nil {
+		if err := lm.createSession(ctx); err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			failures++
+			retry := lm.config.retry(failures)
+			lm.client.log.Warnf("failed to create session, try again in %v: %v", retry, err)
+			sleep(ctx, retry)
 			continue
 		}
+		failures = 0
+
+		lm.maintainSession(ctx)
+	}
+
+	lm.setSessionID("")
+	lm.client.log.Tracef("stopped participating in leadership of %s", lm.key)
+}
+
+// maintainSession renews the current session in the background, while
+// campaigning for leadership using the session. Once ctx is done the session
+// is destroyed, and maintainSession returns. If the session is lost,
+// maintainSession returns so a new session can be created.
+func (lm *leadershipManager) maintainSession(ctx Ctx) {
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// renewal is stopped separately, so the session outlives the leader lock
+	renewCtx, stopRenew := context.WithCancel(context.Background())
+	defer stopRenew()
 
+	renewed := make(chan error, 1)
+	go func() {
 		// (lock delay covers the fence post)
-		for range time.Tick(lm.sessionTTL) {
-			ctx := context.TODO()
-			if _, err := lm.client.RenewSession(ctx, SessionQuery{
-				DC: "",
-				ID: lm.getSessionID(),
-			}); err != nil {
-				lm.client.log.Warnf("failed to renew session, will need to create a new one")
-				break
-			}
+		err := lm.client.renewPeriodic(renewCtx, SessionQuery{
+			DC: "",
+			ID: lm.getSessionID(),
+		}, lm.sessionTTL, lm.config.RenewInterval)
+		if renewCtx.Err() == nil {
+			lm.client.log.Warnf("failed to renew session, will need to create a new one: %v", err)
+			cancel()
+			err = nil
+		}
+		renewed <- err
+	}()
+
+	releaseErr := lm.maintainLeadership(sessionCtx)
+
+	// the session is destroyed once the leader lock has been released
+	stopRenew()
+	destroyErr := <-renewed
+
+	// only errors while shutting down are reported by Resign
+	if ctx.Err() != nil {
+		if releaseErr != nil {
+			lm.setShutdownErr(releaseErr)
+		}
+		if destroyErr != nil {
+			lm.setShutdownErr(errors.Wrap(destroyErr, "failed to destroy session"))
 		}
 	}
 }
 
-func (lm *leadershipManager) createSession(opts LeadershipConfig) error {
+func (lm *leadershipManager) createSession(ctx Ctx) error {
 	lm.client.log.Tracef("attempting to establish new session")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, lm.config.requestTimeout())
 	defer cancel()
 
 	sessionID, err := lm.client.CreateSession(ctx, SessionConfig{
 		// DC not used
-		Node:      lm.self.Name,
-		Name:      opts.name(),
-		LockDelay: opts.LockDelay,
-		TTL:       opts.TTL,
-		Behavior:  SessionDelete,
+		Node:          lm.node,
+		Name:          lm.config.name(),
+		LockDelay:     lm.config.LockDelay,
+		TTL:           lm.config.TTL,
+		Behavior:      lm.config.behavior(),
+		Checks:        lm.config.Checks,
+		NodeChecks:    lm.config.NodeChecks,
+		ServiceChecks: lm.config.ServiceChecks,
 	})
 	if err != nil {
 		return err
@@ -166,119 +467,286 @@ func (lm *leadershipManager) getSessionID() SessionID {
 }
 
 func (lm *leadershipManager) Abdicate(ctx Ctx) error {
-	lm.isLeader.Store(false)
+	lm.setIsLeader(false)
 
-	path := fixup("/v1/kv/", lm.key, param("release", string(lm.getSessionID())))
+	// end the current term, so the AsLeaderFunc stops
+	lm.lock.Lock()
+	if lm.termCancel != nil {
+		lm.termCancel()
+	}
+	lm.lock.Unlock()
 
-	var response bool
-	if err := lm.client.put(ctx, path, lm.value(), &response); err != nil {
+	if _, err := lm.client.Write(ctx, lm.key, lm.value(lm.getElectedAt()), WriteQuery{
+		Release: lm.getSessionID(),
+	}); err != nil {
 		return errors.Wrap(err, "failed to abdicate leadership")
 	}
 
 	return nil
 }
 
-func (lm *leadershipManager) Current(ctx Ctx) (string, error) {
-	path := fixup("/v1/kv/", lm.key)
+func (lm *leadershipManager) Resign(ctx Ctx) error {
+	lm.stop()
 
-	var response []struct {
-		Value string `json:"value"`
+	select {
+	case <-lm.done:
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed to wait for resignation")
 	}
 
-	if err := lm.client.get(ctx, path, &response); err != nil {
-		return "", errors.Wrap(err, "failed to lookup leadership")
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+	return lm.shutdownErr
+}
+
+func (lm *leadershipManager) setShutdownErr(err error) {
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+	if lm.shutdownErr == nil {
+		lm.shutdownErr = err
 	}
+}
 
-	encoded := response[0].Value
-	decoded, err := base64.StdEncoding.DecodeString(encoded)
+func (lm *leadershipManager) Current(ctx Ctx) (string, error) {
+	entries, _, err := lm.client.entries(ctx, lm.key, Query{}, false)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to decode leadership identity")
+		return "", errors.Wrap(err, "failed to lookup leadership")
 	}
 
-	return string(decoded), nil
+	if len(entries) == 0 || entries[0].Session == "" {
+		return "", &NoLeaderError{Key: lm.key}
+	}
+
+	return decodeLeaderRecord(entries[0].Value).ContactInfo, nil
 }
 
 func (lm *leadershipManager) SessionID(_ Ctx) string {
 	return string(lm.getSessionID())
 }
 
-func (lm *leadershipManager) value() string {
-	bs, err := json.Marshal(lm.contactInfo)
+// value returns the json encoded LeaderRecord of a term started at electedAt
+func (lm *leadershipManager) value(electedAt time.Time) string {
+	bs, err := json.Marshal(LeaderRecord{
+		ContactInfo: lm.contactInfo,
+		Metadata:    lm.metadata,
+		ElectedAt:   electedAt,
+		Session:     lm.getSessionID(),
+	})
 	if err != nil {
 		panic(err)
 	}
 	return string(bs)
 }
 
-func (lm *leadershipManager) tryAcquire() (bool, error) {
-	id := string(lm.getSessionID())
+func (lm *leadershipManager) getElectedAt() time.Time {
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+	return lm.electedAt
+}
+
+func (lm *leadershipManager) tryAcquire(ctx Ctx, electedAt time.Time) (bool, error) {
+	id := lm.getSessionID()
 	if id == "" {
 		return false, errors.New("cannot acquire leader lock before establishing session")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, lm.config.requestTimeout())
 	defer cancel()
 
-	path := fixup("/v1/kv/", lm.key, param("acquire", id))
-	var response bool
-	if err := lm.client.put(ctx, path, lm.value(), &response); err != nil {
+	won, err := lm.client.Write(ctx, lm.key, lm.value(electedAt), WriteQuery{
+		Acquire: id,
+	})
+	if err != nil {
 		return false, errors.Wrap(err, "failed to acquire leadership")
 	}
 
-	return response, nil
+	return won, nil
 }
 
-func (lm *leadershipManager) maintainLeadership() {
-	// initially we are not the leader
-	lm.setIsLeader(false)
+// release releases the leader lock. A fresh context is used, because the
+// lock must be released even when participation is being stopped.
+func (lm *leadershipManager) release() error {
+	ctx, cancel := context.WithTimeout(context.Background(), lm.config.requestTimeout())
+	defer cancel()
+
+	if _, err := lm.client.Write(ctx, lm.key, lm.value(lm.getElectedAt()), WriteQuery{
+		Release: lm.getSessionID(),
+	}); err != nil {
+		return errors.Wrap(err, "failed to release leadership")
+	}
 
+	return nil
+}
+
+// maintainLeadership campaigns for leadership until ctx is done, running
+// the AsLeaderFunc each time leadership is acquired. If ctx is done while
+// leading, any error releasing the leader lock is returned.
+func (lm *leadershipManager) maintainLeadership(ctx Ctx) error {
 	// initial gap is very small so we can get started now
 	gap := 1 * time.Millisecond
+	failures := 0
 
-	for {
-		time.Sleep(gap)
-
+	for sleep(ctx, gap) {
 		// try to acquire leadership
-		won, err := lm.tryAcquire()
+		electedAt := time.Now().UTC()
+		won, err := lm.tryAcquire(ctx, electedAt)
 		switch {
+		case ctx.Err() != nil:
+			return nil
+
 		case err != nil:
-			gap = 1 * time.Second
-			lm.client.log.Warnf("encountered error while acquiring leadership, try again in 1 second: %v", err)
+			failures++
+			gap = lm.config.retry(failures)
+			lm.client.log.Warnf("encountered error while acquiring leadership, try again in %v: %v", gap, err)
 			continue
 
 		case !won:
-			gap = lm.sessionTTL
+			failures = 0
+			gap = lm.standby(ctx)
 			continue
 
 		case won:
-			ctx, cancel := context.WithCancel(context.Background())
-
-			// in the background, try to maintain leadership until we lose it
-			go func(ctx context.Context) {
-				ticker := time.NewTicker(lm.sessionTTL)
-				for {
-					select {
-					case <-ticker.C:
-						won, err := lm.tryAcquire()
-						if err != nil || !won {
-							cancel()
-							return
-						}
-					case <-ctx.Done():
-						return
-					}
-				}
-			}(ctx)
+			failures = 0
+			if err := lm.lead(ctx, electedAt); ctx.Err() != nil {
+				return err
+			}
+			gap = lm.config.campaignInterval()
+		}
+	}
+
+	return nil
+}
+
+// standby waits while another participant is the leader, and returns how
+// much longer to wait before trying to acquire leadership again.
+func (lm *leadershipManager) standby(ctx Ctx) time.Duration {
+	if !lm.config.WatchLock {
+		return lm.config.campaignInterval()
+	}
+
+	released, err := lm.awaitRelease(ctx)
+	switch {
+	case ctx.Err() != nil:
+		return 0
+	case err != nil:
+		retry := lm.config.retry(1)
+		lm.client.log.Warnf("failed to watch leader key, try again in %v: %v", retry, err)
+		return retry
+	case !released:
+		// the key was not held, but could not be acquired (e.g. because of
+		// the lock delay of the previous leader)
+		return lm.config.retry(1)
+	}
 
-			// in the foreground, run the AsLeaderFunc until it returns or ctx is cancelled
-			err := lm.asLeader(ctx)
-			lm.client.log.Errorf("provided AsLeaderFunc returned with error: %v", err)
-			lm.setIsLeader(false)
-			cancel() // probably why it returned, but we still need to run it in case
+	// the leader stepped down, try to take over right away
+	return 0
+}
+
+// awaitRelease blocks until the leader key is no longer held by any session,
+// using blocking queries. If the key was not held to begin with, false is
+// returned immediately.
+func (lm *leadershipManager) awaitRelease(ctx Ctx) (bool, error) {
+	index := uint64(0)
+
+	for {
+		entries, meta, err := lm.client.entries(ctx, lm.key, Query{
+			WaitIndex: index,
+		}, false)
+		if err != nil {
+			return false, err
+		}
+
+		if len(entries) == 0 || entries[0].Session == "" {
+			return index > 0, nil
+		}
+
+		index = meta.LastIndex
+		if index < 1 {
+			index = 1
 		}
 	}
 }
 
+// lead runs the AsLeaderFunc until it returns, or until leadership is lost,
+// or until ctx is done. The leader lock is released before returning, and
+// any error doing so is returned.
+func (lm *leadershipManager) lead(ctx Ctx, electedAt time.Time) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lm.lock.Lock()
+	lm.termCancel = cancel
+	lm.electedAt = electedAt
+	lm.lock.Unlock()
+
+	token, err := lm.fencingToken(ctx)
+	if err != nil {
+		lm.client.log.Warnf("failed to lookup fencing token, giving up leadership: %v", err)
+		lm.lock.Lock()
+		lm.termCancel = nil
+		lm.lock.Unlock()
+		return lm.release()
+	}
+	ctx = context.WithValue(ctx, fencingTokenKey{}, token)
+
+	lm.setIsLeader(true)
+
+	// in the background, try to maintain leadership until we lose it
+	maintained := make(chan struct{})
+	go func() {
+		defer close(maintained)
+
+		ticker := time.NewTicker(lm.sessionTTL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				won, err := lm.tryAcquire(ctx, electedAt)
+				if err != nil || !won {
+					cancel()
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// in the foreground, run the AsLeaderFunc until it returns or ctx is cancelled
+	err = lm.asLeader(ctx)
+	lm.client.log.Errorf("provided AsLeaderFunc returned with error: %v", err)
+	lm.setIsLeader(false)
+	cancel() // probably why it returned, but we still need to run it in case
+	<-maintained
+
+	lm.lock.Lock()
+	lm.termCancel = nil
+	lm.lock.Unlock()
+
+	if err := lm.release(); err != nil {
+		lm.client.log.Warnf("%v", err)
+		return err
+	}
+
+	return nil
+}
+
+// fencingToken returns the LockIndex of the leader key, which has just been
+// acquired using the current session.
+func (lm *leadershipManager) fencingToken(ctx Ctx) (uint64, error) {
+	entries, _, err := lm.client.entries(ctx, lm.key, Query{}, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(entries) == 0 || entries[0].Session != lm.getSessionID() {
+		return 0, errors.New("leader key is no longer held by session")
+	}
+
+	return entries[0].LockIndex, nil
+}
+
 func (lm *leadershipManager) IsLeader() bool {
 	isLeader, ok := lm.isLeader.Load().(bool)
 	if !ok {
@@ -290,6 +758,90 @@ func (lm *leadershipManager) IsLeader() bool {
 func (lm *leadershipManager) setIsLeader(b bool) {
 	lm.client.log.Tracef("setting leader status to: %v", b)
 	lm.isLeader.Store(b)
+	lm.update(func(state *LeadershipEvent) {
+		state.IsLeader = b
+	})
+}
+
+// observe is the handler of the watch on the leader key
+func (lm *leadershipManager) observe(event WatchEvent) {
+	leader := ""
+	if event.KV != nil && event.KV.Session != "" {
+		leader = decodeLeaderRecord(event.KV.Value).ContactInfo
+	}
+
+	lm.update(func(state *LeadershipEvent) {
+		state.Leader = leader
+	})
+}
+
+func (lm *leadershipManager) Events(ctx Ctx) <-chan LeadershipEvent {
+	events := make(chan LeadershipEvent, 1)
+
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+
+	if lm.stopped {
+		close(events)
+		return events
+	}
+
+	lm.subscribers[events] = struct{}{}
+	events <- lm.state
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-lm.done:
+			return
+		}
+
+		lm.lock.Lock()
+		defer lm.lock.Unlock()
+		if _, exists := lm.subscribers[events]; exists {
+			delete(lm.subscribers, events)
+			close(events)
+		}
+	}()
+
+	return events
+}
+
+// update modifies the leadership state, notifying subscribers if the state
+// changed.
+func (lm *leadershipManager) update(f func(*LeadershipEvent)) {
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+
+	previous := lm.state
+	f(&lm.state)
+	if lm.state == previous {
+		return
+	}
+
+	for events := range lm.subscribers {
+		// replace the event the subscriber has not yet received, if any
+		select {
+		case events <- lm.state:
+		default:
+			select {
+			case <-events:
+			default:
+			}
+			events <- lm.state
+		}
+	}
+}
+
+func (lm *leadershipManager) closeSubscribers() {
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+
+	lm.stopped = true
+	for events := range lm.subscribers {
+		delete(lm.subscribers, events)
+		close(events)
+	}
 }
 
 func (lm *leadershipManager) String() string {
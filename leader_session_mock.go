@@ -26,6 +26,24 @@ type LeaderSessionMock struct {
 	beforeCurrentCounter uint64
 	CurrentMock          mLeaderSessionMockCurrent
 
+	funcEvents          func(c1 Ctx) (ch1 <-chan LeadershipEvent)
+	inspectFuncEvents   func(c1 Ctx)
+	afterEventsCounter  uint64
+	beforeEventsCounter uint64
+	EventsMock          mLeaderSessionMockEvents
+
+	funcIsLeader          func() (b1 bool)
+	inspectFuncIsLeader   func()
+	afterIsLeaderCounter  uint64
+	beforeIsLeaderCounter uint64
+	IsLeaderMock          mLeaderSessionMockIsLeader
+
+	funcResign          func(c1 Ctx) (err error)
+	inspectFuncResign   func(c1 Ctx)
+	afterResignCounter  uint64
+	beforeResignCounter uint64
+	ResignMock          mLeaderSessionMockResign
+
 	funcSessionID          func(c1 Ctx) (s1 string)
 	inspectFuncSessionID   func(c1 Ctx)
 	afterSessionIDCounter  uint64
@@ -46,6 +64,14 @@ func NewLeaderSessionMock(t minimock.Tester) *LeaderSessionMock {
 	m.CurrentMock = mLeaderSessionMockCurrent{mock: m}
 	m.CurrentMock.callArgs = []*LeaderSessionMockCurrentParams{}
 
+	m.EventsMock = mLeaderSessionMockEvents{mock: m}
+	m.EventsMock.callArgs = []*LeaderSessionMockEventsParams{}
+
+	m.IsLeaderMock = mLeaderSessionMockIsLeader{mock: m}
+
+	m.ResignMock = mLeaderSessionMockResign{mock: m}
+	m.ResignMock.callArgs = []*LeaderSessionMockResignParams{}
+
 	m.SessionIDMock = mLeaderSessionMockSessionID{mock: m}
 	m.SessionIDMock.callArgs = []*LeaderSessionMockSessionIDParams{}
 
@@ -483,6 +509,578 @@ func (m *LeaderSessionMock) MinimockCurrentInspect() {
 	}
 }
 
+type mLeaderSessionMockEvents struct {
+	mock               *LeaderSessionMock
+	defaultExpectation *LeaderSessionMockEventsExpectation
+	expectations       []*LeaderSessionMockEventsExpectation
+
+	callArgs []*LeaderSessionMockEventsParams
+	mutex    sync.RWMutex
+}
+
+// LeaderSessionMockEventsExpectation specifies expectation struct of the LeaderSession.Events
+type LeaderSessionMockEventsExpectation struct {
+	mock    *LeaderSessionMock
+	params  *LeaderSessionMockEventsParams
+	results *LeaderSessionMockEventsResults
+	Counter uint64
+}
+
+// LeaderSessionMockEventsParams contains parameters of the LeaderSession.Events
+type LeaderSessionMockEventsParams struct {
+	c1 Ctx
+}
+
+// LeaderSessionMockEventsResults contains results of the LeaderSession.Events
+type LeaderSessionMockEventsResults struct {
+	ch1 <-chan LeadershipEvent
+}
+
+// Expect sets up expected params for LeaderSession.Events
+func (mmEvents *mLeaderSessionMockEvents) Expect(c1 Ctx) *mLeaderSessionMockEvents {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("LeaderSessionMock.Events mock is already set by Set")
+	}
+
+	if mmEvents.defaultExpectation == nil {
+		mmEvents.defaultExpectation = &LeaderSessionMockEventsExpectation{}
+	}
+
+	mmEvents.defaultExpectation.params = &LeaderSessionMockEventsParams{c1}
+	for _, e := range mmEvents.expectations {
+		if minimock.Equal(e.params, mmEvents.defaultExpectation.params) {
+			mmEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeaderSession.Events
+func (mmEvents *mLeaderSessionMockEvents) Inspect(f func(c1 Ctx)) *mLeaderSessionMockEvents {
+	if mmEvents.mock.inspectFuncEvents != nil {
+		mmEvents.mock.t.Fatalf("Inspect function is already set for LeaderSessionMock.Events")
+	}
+
+	mmEvents.mock.inspectFuncEvents = f
+
+	return mmEvents
+}
+
+// Return sets up results that will be returned by LeaderSession.Events
+func (mmEvents *mLeaderSessionMockEvents) Return(ch1 <-chan LeadershipEvent) *LeaderSessionMock {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("LeaderSessionMock.Events mock is already set by Set")
+	}
+
+	if mmEvents.defaultExpectation == nil {
+		mmEvents.defaultExpectation = &LeaderSessionMockEventsExpectation{mock: mmEvents.mock}
+	}
+	mmEvents.defaultExpectation.results = &LeaderSessionMockEventsResults{ch1}
+	return mmEvents.mock
+}
+
+//Set uses given function f to mock the LeaderSession.Events method
+func (mmEvents *mLeaderSessionMockEvents) Set(f func(c1 Ctx) (ch1 <-chan LeadershipEvent)) *LeaderSessionMock {
+	if mmEvents.defaultExpectation != nil {
+		mmEvents.mock.t.Fatalf("Default expectation is already set for the LeaderSession.Events method")
+	}
+
+	if len(mmEvents.expectations) > 0 {
+		mmEvents.mock.t.Fatalf("Some expectations are already set for the LeaderSession.Events method")
+	}
+
+	mmEvents.mock.funcEvents = f
+	return mmEvents.mock
+}
+
+// When sets expectation for the LeaderSession.Events which will trigger the result defined by the following
+// Then helper
+func (mmEvents *mLeaderSessionMockEvents) When(c1 Ctx) *LeaderSessionMockEventsExpectation {
+	if mmEvents.mock.funcEvents != nil {
+		mmEvents.mock.t.Fatalf("LeaderSessionMock.Events mock is already set by Set")
+	}
+
+	expectation := &LeaderSessionMockEventsExpectation{
+		mock:   mmEvents.mock,
+		params: &LeaderSessionMockEventsParams{c1},
+	}
+	mmEvents.expectations = append(mmEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeaderSession.Events return parameters for the expectation previously defined by the When method
+func (e *LeaderSessionMockEventsExpectation) Then(ch1 <-chan LeadershipEvent) *LeaderSessionMock {
+	e.results = &LeaderSessionMockEventsResults{ch1}
+	return e.mock
+}
+
+// Events implements LeaderSession
+func (mmEvents *LeaderSessionMock) Events(c1 Ctx) (ch1 <-chan LeadershipEvent) {
+	mm_atomic.AddUint64(&mmEvents.beforeEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmEvents.afterEventsCounter, 1)
+
+	if mmEvents.inspectFuncEvents != nil {
+		mmEvents.inspectFuncEvents(c1)
+	}
+
+	mm_params := &LeaderSessionMockEventsParams{c1}
+
+	// Record call args
+	mmEvents.EventsMock.mutex.Lock()
+	mmEvents.EventsMock.callArgs = append(mmEvents.EventsMock.callArgs, mm_params)
+	mmEvents.EventsMock.mutex.Unlock()
+
+	for _, e := range mmEvents.EventsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ch1
+		}
+	}
+
+	if mmEvents.EventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEvents.EventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmEvents.EventsMock.defaultExpectation.params
+		mm_got := LeaderSessionMockEventsParams{c1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEvents.t.Errorf("LeaderSessionMock.Events got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEvents.EventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEvents.t.Fatal("No results are set for the LeaderSessionMock.Events")
+		}
+		return (*mm_results).ch1
+	}
+	if mmEvents.funcEvents != nil {
+		return mmEvents.funcEvents(c1)
+	}
+	mmEvents.t.Fatalf("Unexpected call to LeaderSessionMock.Events. %v", c1)
+	return
+}
+
+// EventsAfterCounter returns a count of finished LeaderSessionMock.Events invocations
+func (mmEvents *LeaderSessionMock) EventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvents.afterEventsCounter)
+}
+
+// EventsBeforeCounter returns a count of LeaderSessionMock.Events invocations
+func (mmEvents *LeaderSessionMock) EventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEvents.beforeEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeaderSessionMock.Events.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEvents *mLeaderSessionMockEvents) Calls() []*LeaderSessionMockEventsParams {
+	mmEvents.mutex.RLock()
+
+	argCopy := make([]*LeaderSessionMockEventsParams, len(mmEvents.callArgs))
+	copy(argCopy, mmEvents.callArgs)
+
+	mmEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEventsDone returns true if the count of the Events invocations corresponds
+// the number of defined expectations
+func (m *LeaderSessionMock) MinimockEventsDone() bool {
+	for _, e := range m.EventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEventsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEvents != nil && mm_atomic.LoadUint64(&m.afterEventsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockEventsInspect logs each unmet expectation
+func (m *LeaderSessionMock) MinimockEventsInspect() {
+	for _, e := range m.EventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeaderSessionMock.Events with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EventsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEventsCounter) < 1 {
+		if m.EventsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeaderSessionMock.Events")
+		} else {
+			m.t.Errorf("Expected call to LeaderSessionMock.Events with params: %#v", *m.EventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEvents != nil && mm_atomic.LoadUint64(&m.afterEventsCounter) < 1 {
+		m.t.Error("Expected call to LeaderSessionMock.Events")
+	}
+}
+
+type mLeaderSessionMockIsLeader struct {
+	mock               *LeaderSessionMock
+	defaultExpectation *LeaderSessionMockIsLeaderExpectation
+	expectations       []*LeaderSessionMockIsLeaderExpectation
+}
+
+// LeaderSessionMockIsLeaderExpectation specifies expectation struct of the LeaderSession.IsLeader
+type LeaderSessionMockIsLeaderExpectation struct {
+	mock    *LeaderSessionMock
+	results *LeaderSessionMockIsLeaderResults
+	Counter uint64
+}
+
+// LeaderSessionMockIsLeaderResults contains results of the LeaderSession.IsLeader
+type LeaderSessionMockIsLeaderResults struct {
+	b1 bool
+}
+
+// Expect sets up expected params for LeaderSession.IsLeader
+func (mmIsLeader *mLeaderSessionMockIsLeader) Expect() *mLeaderSessionMockIsLeader {
+	if mmIsLeader.mock.funcIsLeader != nil {
+		mmIsLeader.mock.t.Fatalf("LeaderSessionMock.IsLeader mock is already set by Set")
+	}
+
+	if mmIsLeader.defaultExpectation == nil {
+		mmIsLeader.defaultExpectation = &LeaderSessionMockIsLeaderExpectation{}
+	}
+
+	return mmIsLeader
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeaderSession.IsLeader
+func (mmIsLeader *mLeaderSessionMockIsLeader) Inspect(f func()) *mLeaderSessionMockIsLeader {
+	if mmIsLeader.mock.inspectFuncIsLeader != nil {
+		mmIsLeader.mock.t.Fatalf("Inspect function is already set for LeaderSessionMock.IsLeader")
+	}
+
+	mmIsLeader.mock.inspectFuncIsLeader = f
+
+	return mmIsLeader
+}
+
+// Return sets up results that will be returned by LeaderSession.IsLeader
+func (mmIsLeader *mLeaderSessionMockIsLeader) Return(b1 bool) *LeaderSessionMock {
+	if mmIsLeader.mock.funcIsLeader != nil {
+		mmIsLeader.mock.t.Fatalf("LeaderSessionMock.IsLeader mock is already set by Set")
+	}
+
+	if mmIsLeader.defaultExpectation == nil {
+		mmIsLeader.defaultExpectation = &LeaderSessionMockIsLeaderExpectation{mock: mmIsLeader.mock}
+	}
+	mmIsLeader.defaultExpectation.results = &LeaderSessionMockIsLeaderResults{b1}
+	return mmIsLeader.mock
+}
+
+//Set uses given function f to mock the LeaderSession.IsLeader method
+func (mmIsLeader *mLeaderSessionMockIsLeader) Set(f func() (b1 bool)) *LeaderSessionMock {
+	if mmIsLeader.defaultExpectation != nil {
+		mmIsLeader.mock.t.Fatalf("Default expectation is already set for the LeaderSession.IsLeader method")
+	}
+
+	if len(mmIsLeader.expectations) > 0 {
+		mmIsLeader.mock.t.Fatalf("Some expectations are already set for the LeaderSession.IsLeader method")
+	}
+
+	mmIsLeader.mock.funcIsLeader = f
+	return mmIsLeader.mock
+}
+
+// IsLeader implements LeaderSession
+func (mmIsLeader *LeaderSessionMock) IsLeader() (b1 bool) {
+	mm_atomic.AddUint64(&mmIsLeader.beforeIsLeaderCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsLeader.afterIsLeaderCounter, 1)
+
+	if mmIsLeader.inspectFuncIsLeader != nil {
+		mmIsLeader.inspectFuncIsLeader()
+	}
+
+	if mmIsLeader.IsLeaderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsLeader.IsLeaderMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmIsLeader.IsLeaderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsLeader.t.Fatal("No results are set for the LeaderSessionMock.IsLeader")
+		}
+		return (*mm_results).b1
+	}
+	if mmIsLeader.funcIsLeader != nil {
+		return mmIsLeader.funcIsLeader()
+	}
+	mmIsLeader.t.Fatalf("Unexpected call to LeaderSessionMock.IsLeader.")
+	return
+}
+
+// IsLeaderAfterCounter returns a count of finished LeaderSessionMock.IsLeader invocations
+func (mmIsLeader *LeaderSessionMock) IsLeaderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsLeader.afterIsLeaderCounter)
+}
+
+// IsLeaderBeforeCounter returns a count of LeaderSessionMock.IsLeader invocations
+func (mmIsLeader *LeaderSessionMock) IsLeaderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsLeader.beforeIsLeaderCounter)
+}
+
+// MinimockIsLeaderDone returns true if the count of the IsLeader invocations corresponds
+// the number of defined expectations
+func (m *LeaderSessionMock) MinimockIsLeaderDone() bool {
+	for _, e := range m.IsLeaderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsLeaderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsLeaderCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsLeader != nil && mm_atomic.LoadUint64(&m.afterIsLeaderCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockIsLeaderInspect logs each unmet expectation
+func (m *LeaderSessionMock) MinimockIsLeaderInspect() {
+	for _, e := range m.IsLeaderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to LeaderSessionMock.IsLeader")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsLeaderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsLeaderCounter) < 1 {
+		m.t.Error("Expected call to LeaderSessionMock.IsLeader")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsLeader != nil && mm_atomic.LoadUint64(&m.afterIsLeaderCounter) < 1 {
+		m.t.Error("Expected call to LeaderSessionMock.IsLeader")
+	}
+}
+
+type mLeaderSessionMockResign struct {
+	mock               *LeaderSessionMock
+	defaultExpectation *LeaderSessionMockResignExpectation
+	expectations       []*LeaderSessionMockResignExpectation
+
+	callArgs []*LeaderSessionMockResignParams
+	mutex    sync.RWMutex
+}
+
+// LeaderSessionMockResignExpectation specifies expectation struct of the LeaderSession.Resign
+type LeaderSessionMockResignExpectation struct {
+	mock    *LeaderSessionMock
+	params  *LeaderSessionMockResignParams
+	results *LeaderSessionMockResignResults
+	Counter uint64
+}
+
+// LeaderSessionMockResignParams contains parameters of the LeaderSession.Resign
+type LeaderSessionMockResignParams struct {
+	c1 Ctx
+}
+
+// LeaderSessionMockResignResults contains results of the LeaderSession.Resign
+type LeaderSessionMockResignResults struct {
+	err error
+}
+
+// Expect sets up expected params for LeaderSession.Resign
+func (mmResign *mLeaderSessionMockResign) Expect(c1 Ctx) *mLeaderSessionMockResign {
+	if mmResign.mock.funcResign != nil {
+		mmResign.mock.t.Fatalf("LeaderSessionMock.Resign mock is already set by Set")
+	}
+
+	if mmResign.defaultExpectation == nil {
+		mmResign.defaultExpectation = &LeaderSessionMockResignExpectation{}
+	}
+
+	mmResign.defaultExpectation.params = &LeaderSessionMockResignParams{c1}
+	for _, e := range mmResign.expectations {
+		if minimock.Equal(e.params, mmResign.defaultExpectation.params) {
+			mmResign.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResign.defaultExpectation.params)
+		}
+	}
+
+	return mmResign
+}
+
+// Inspect accepts an inspector function that has same arguments as the LeaderSession.Resign
+func (mmResign *mLeaderSessionMockResign) Inspect(f func(c1 Ctx)) *mLeaderSessionMockResign {
+	if mmResign.mock.inspectFuncResign != nil {
+		mmResign.mock.t.Fatalf("Inspect function is already set for LeaderSessionMock.Resign")
+	}
+
+	mmResign.mock.inspectFuncResign = f
+
+	return mmResign
+}
+
+// Return sets up results that will be returned by LeaderSession.Resign
+func (mmResign *mLeaderSessionMockResign) Return(err error) *LeaderSessionMock {
+	if mmResign.mock.funcResign != nil {
+		mmResign.mock.t.Fatalf("LeaderSessionMock.Resign mock is already set by Set")
+	}
+
+	if mmResign.defaultExpectation == nil {
+		mmResign.defaultExpectation = &LeaderSessionMockResignExpectation{mock: mmResign.mock}
+	}
+	mmResign.defaultExpectation.results = &LeaderSessionMockResignResults{err}
+	return mmResign.mock
+}
+
+//Set uses given function f to mock the LeaderSession.Resign method
+func (mmResign *mLeaderSessionMockResign) Set(f func(c1 Ctx) (err error)) *LeaderSessionMock {
+	if mmResign.defaultExpectation != nil {
+		mmResign.mock.t.Fatalf("Default expectation is already set for the LeaderSession.Resign method")
+	}
+
+	if len(mmResign.expectations) > 0 {
+		mmResign.mock.t.Fatalf("Some expectations are already set for the LeaderSession.Resign method")
+	}
+
+	mmResign.mock.funcResign = f
+	return mmResign.mock
+}
+
+// When sets expectation for the LeaderSession.Resign which will trigger the result defined by the following
+// Then helper
+func (mmResign *mLeaderSessionMockResign) When(c1 Ctx) *LeaderSessionMockResignExpectation {
+	if mmResign.mock.funcResign != nil {
+		mmResign.mock.t.Fatalf("LeaderSessionMock.Resign mock is already set by Set")
+	}
+
+	expectation := &LeaderSessionMockResignExpectation{
+		mock:   mmResign.mock,
+		params: &LeaderSessionMockResignParams{c1},
+	}
+	mmResign.expectations = append(mmResign.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LeaderSession.Resign return parameters for the expectation previously defined by the When method
+func (e *LeaderSessionMockResignExpectation) Then(err error) *LeaderSessionMock {
+	e.results = &LeaderSessionMockResignResults{err}
+	return e.mock
+}
+
+// Resign implements LeaderSession
+func (mmResign *LeaderSessionMock) Resign(c1 Ctx) (err error) {
+	mm_atomic.AddUint64(&mmResign.beforeResignCounter, 1)
+	defer mm_atomic.AddUint64(&mmResign.afterResignCounter, 1)
+
+	if mmResign.inspectFuncResign != nil {
+		mmResign.inspectFuncResign(c1)
+	}
+
+	mm_params := &LeaderSessionMockResignParams{c1}
+
+	// Record call args
+	mmResign.ResignMock.mutex.Lock()
+	mmResign.ResignMock.callArgs = append(mmResign.ResignMock.callArgs, mm_params)
+	mmResign.ResignMock.mutex.Unlock()
+
+	for _, e := range mmResign.ResignMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResign.ResignMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResign.ResignMock.defaultExpectation.Counter, 1)
+		mm_want := mmResign.ResignMock.defaultExpectation.params
+		mm_got := LeaderSessionMockResignParams{c1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResign.t.Errorf("LeaderSessionMock.Resign got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResign.ResignMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResign.t.Fatal("No results are set for the LeaderSessionMock.Resign")
+		}
+		return (*mm_results).err
+	}
+	if mmResign.funcResign != nil {
+		return mmResign.funcResign(c1)
+	}
+	mmResign.t.Fatalf("Unexpected call to LeaderSessionMock.Resign. %v", c1)
+	return
+}
+
+// ResignAfterCounter returns a count of finished LeaderSessionMock.Resign invocations
+func (mmResign *LeaderSessionMock) ResignAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResign.afterResignCounter)
+}
+
+// ResignBeforeCounter returns a count of LeaderSessionMock.Resign invocations
+func (mmResign *LeaderSessionMock) ResignBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResign.beforeResignCounter)
+}
+
+// Calls returns a list of arguments used in each call to LeaderSessionMock.Resign.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResign *mLeaderSessionMockResign) Calls() []*LeaderSessionMockResignParams {
+	mmResign.mutex.RLock()
+
+	argCopy := make([]*LeaderSessionMockResignParams, len(mmResign.callArgs))
+	copy(argCopy, mmResign.callArgs)
+
+	mmResign.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResignDone returns true if the count of the Resign invocations corresponds
+// the number of defined expectations
+func (m *LeaderSessionMock) MinimockResignDone() bool {
+	for _, e := range m.ResignMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResignMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResignCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResign != nil && mm_atomic.LoadUint64(&m.afterResignCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockResignInspect logs each unmet expectation
+func (m *LeaderSessionMock) MinimockResignInspect() {
+	for _, e := range m.ResignMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LeaderSessionMock.Resign with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResignMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResignCounter) < 1 {
+		if m.ResignMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LeaderSessionMock.Resign")
+		} else {
+			m.t.Errorf("Expected call to LeaderSessionMock.Resign with params: %#v", *m.ResignMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResign != nil && mm_atomic.LoadUint64(&m.afterResignCounter) < 1 {
+		m.t.Error("Expected call to LeaderSessionMock.Resign")
+	}
+}
+
 type mLeaderSessionMockSessionID struct {
 	mock               *LeaderSessionMock
 	defaultExpectation *LeaderSessionMockSessionIDExpectation
@@ -705,6 +1303,12 @@ func (m *LeaderSessionMock) MinimockFinish() {
 
 		m.MinimockCurrentInspect()
 
+		m.MinimockEventsInspect()
+
+		m.MinimockIsLeaderInspect()
+
+		m.MinimockResignInspect()
+
 		m.MinimockSessionIDInspect()
 		m.t.FailNow()
 	}
@@ -731,5 +1335,8 @@ func (m *LeaderSessionMock) minimockDone() bool {
 	return done &&
 		m.MinimockAbdicateDone() &&
 		m.MinimockCurrentDone() &&
+		m.MinimockEventsDone() &&
+		m.MinimockIsLeaderDone() &&
+		m.MinimockResignDone() &&
 		m.MinimockSessionIDDone()
 }
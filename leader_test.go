@@ -2,28 +2,83 @@ package consulapi
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
+// leadershipHandler acts as a consul agent for the purpose of a leadership
+// election using the key my/key, including blocking queries on the key.
 type leadershipHandler struct {
 	t *testing.T
+
+	lock     sync.Mutex
+	requests []string
+
+	// state of my/key, where changed is closed whenever the key is modified
+	index     uint64
+	lockIndex uint64
+	value     string
+	session   string
+	changed   chan struct{}
+
+	// body of the most recent session create request
+	created string
+}
+
+// hold modifies my/key to be held by session, as if by another participant
+func (lh *leadershipHandler) hold(session string) {
+	lh.lock.Lock()
+	defer lh.lock.Unlock()
+
+	if lh.session == "" && session != "" {
+		lh.lockIndex++
+	}
+	lh.session = session
+	lh.index++
+	close(lh.changed)
+	lh.changed = make(chan struct{})
+}
+
+func newLeadershipHandler(t *testing.T) *leadershipHandler {
+	return &leadershipHandler{
+		t:         t,
+		index:     1080093,
+		lockIndex: 2,
+		value:     "myValue",
+		changed:   make(chan struct{}),
+	}
 }
 
 func (lh *leadershipHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	switch r.URL.Path {
-	case "/v1/agent/self":
+	lh.lock.Lock()
+	lh.requests = append(lh.requests, r.Method+" "+r.URL.String())
+	lh.lock.Unlock()
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET /v1/agent/self":
 		lh.rxSelf(w)
-	case "/v1/kv/my/key":
-		lh.rxMyKey(w)
-	case "/v1/session/create":
-		lh.rxCreate(w)
+	case "GET /v1/kv/my/key":
+		lh.rxMyKey(w, r)
+	case "PUT /v1/kv/my/key":
+		lh.rxWriteMyKey(w, r)
+	case "PUT /v1/session/create":
+		lh.rxCreate(w, r)
+	case "PUT /v1/session/destroy/adf4238a-882b-9ddc-4a9d-5b6758e4159e":
+		lh.rxWrite(w)
+	case "GET /v1/session/info/adf4238a-882b-9ddc-4a9d-5b6758e4159e":
+		lh.rxSessionInfo(w)
 	default:
-		lh.t.Fatal("unexpected path:", r.URL.Path)
+		lh.t.Fatal("unexpected request:", r.Method, r.URL.Path)
 	}
 }
 
@@ -32,26 +87,117 @@ func (lh *leadershipHandler) rxSelf(w http.ResponseWriter) {
 	_, _ = io.WriteString(w, response)
 }
 
-func (lh *leadershipHandler) rxMyKey(w http.ResponseWriter) {
-	response := load(lh.t, "test_leadership_myKey.json")
+func (lh *leadershipHandler) rxSessionInfo(w http.ResponseWriter) {
+	response := load(lh.t, "v1_session_info.json")
 	_, _ = io.WriteString(w, response)
 }
 
-func (lh *leadershipHandler) rxCreate(w http.ResponseWriter) {
+func (lh *leadershipHandler) rxMyKey(w http.ResponseWriter, r *http.Request) {
+	index, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+
+	lh.lock.Lock()
+	for index >= lh.index {
+		changed := lh.changed
+		lh.lock.Unlock()
+		select {
+		case <-changed:
+		case <-r.Context().Done():
+			return
+		}
+		lh.lock.Lock()
+	}
+
+	bs, err := json.Marshal([]kvEntryFormat{{
+		Key:         "my/key",
+		Value:       base64.StdEncoding.EncodeToString([]byte(lh.value)),
+		Session:     SessionID(lh.session),
+		LockIndex:   lh.lockIndex,
+		CreateIndex: 1080093,
+		ModifyIndex: lh.index,
+	}})
+	require.NoError(lh.t, err)
+	w.Header().Set(headerIndex, strconv.FormatUint(lh.index, 10))
+	lh.lock.Unlock()
+
+	_, _ = w.Write(bs)
+}
+
+func (lh *leadershipHandler) rxWriteMyKey(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	require.NoError(lh.t, err)
+
+	lh.lock.Lock()
+	defer lh.lock.Unlock()
+
+	applied := false
+	query := r.URL.Query()
+
+	switch {
+	case query.Get("acquire") != "":
+		if lh.session == "" || lh.session == query.Get("acquire") {
+			if lh.session == "" {
+				lh.lockIndex++
+			}
+			lh.session = query.Get("acquire")
+			lh.value = string(body)
+			applied = true
+		}
+	case query.Get("release") != "":
+		if lh.session == query.Get("release") {
+			lh.session = ""
+			lh.value = string(body)
+			applied = true
+		}
+	}
+
+	if applied {
+		lh.index++
+		close(lh.changed)
+		lh.changed = make(chan struct{})
+	}
+
+	_, _ = io.WriteString(w, strconv.FormatBool(applied))
+}
+
+func (lh *leadershipHandler) rxCreate(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	require.NoError(lh.t, err)
+
+	lh.lock.Lock()
+	lh.created = string(body)
+	lh.lock.Unlock()
+
 	response := load(lh.t, "test_leadership_create.json")
 	_, _ = io.WriteString(w, response)
 }
 
+func (lh *leadershipHandler) rxWrite(w http.ResponseWriter) {
+	_, _ = io.WriteString(w, "true")
+}
+
+// received returns the requests received, other than reads of my/key
+func (lh *leadershipHandler) received() []string {
+	lh.lock.Lock()
+	defer lh.lock.Unlock()
+
+	var requests []string
+	for _, request := range lh.requests {
+		if !strings.HasPrefix(request, "GET /v1/kv/my/key") {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
 func Test_Leadership_Participate(t *testing.T) {
 
-	ctx, ts, client := testClient(&leadershipHandler{
-		t: t,
-	})
+	ctx, ts, client := testClient(newLeadershipHandler(t))
 	defer ts.Close()
 
-	var alf AsLeaderFunc = func(Ctx) error {
+	var alf AsLeaderFunc = func(ctx Ctx) error {
 		t.Log("this is as leader func")
-		return nil
+		<-ctx.Done()
+		return ctx.Err()
 	}
 
 	session, err := client.Participate(ctx, LeadershipConfig{
@@ -65,14 +211,21 @@ func Test_Leadership_Participate(t *testing.T) {
 	require.NoError(t, err)
 	t.Log("session:", session)
 
-	current, err := session.Current(ctx)
-	require.NoError(t, err)
-	t.Log("current:", current)
-
 	// i only feel a little bad about this
 	for i := 0; wait(t, i, session); i++ {
 		time.Sleep(10 * time.Millisecond)
 	}
+
+	for !session.IsLeader() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	current, err := session.Current(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "node1", current)
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
 }
 
 func wait(t *testing.T, i int, session LeaderSession) bool {
@@ -83,3 +236,350 @@ func wait(t *testing.T, i int, session LeaderSession) bool {
 	t.Logf("waiting for session [%d]: %s", i, id)
 	return id == ""
 }
+
+func Test_Leadership_Resign(t *testing.T) {
+	handler := newLeadershipHandler(t)
+	ctx, ts, client := testClient(handler)
+	defer ts.Close()
+
+	elected := make(chan struct{})
+	exited := make(chan struct{})
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key:         "/my/key",
+		ContactInfo: "node1",
+		TTL:         30 * time.Second,
+	}, func(ctx Ctx) error {
+		close(elected)
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		close(exited)
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-elected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected to be elected leader")
+	}
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+
+	// the AsLeaderFunc has exited by the time Resign returns
+	select {
+	case <-exited:
+	default:
+		t.Fatal("expected AsLeaderFunc to have exited")
+	}
+
+	require.Equal(t, "", session.SessionID(ctx))
+	require.Equal(t, []string{
+		"GET /v1/agent/self",
+		"PUT /v1/session/create",
+		"PUT /v1/kv/my/key?acquire=adf4238a-882b-9ddc-4a9d-5b6758e4159e",
+		"PUT /v1/kv/my/key?release=adf4238a-882b-9ddc-4a9d-5b6758e4159e",
+		"PUT /v1/session/destroy/adf4238a-882b-9ddc-4a9d-5b6758e4159e",
+	}, handler.received())
+
+	// resigning again is harmless
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+}
+
+func Test_Leadership_context_cancelled(t *testing.T) {
+	handler := newLeadershipHandler(t)
+	_, ts, client := testClient(handler)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	elected := make(chan struct{})
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key: "my/key",
+		TTL: 30 * time.Second,
+	}, func(ctx Ctx) error {
+		close(elected)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-elected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected to be elected leader")
+	}
+
+	cancel()
+
+	// wait for the shutdown to complete
+	err = session.Resign(context.Background())
+	require.NoError(t, err)
+
+	requests := handler.received()
+	require.Equal(t, "PUT /v1/session/destroy/adf4238a-882b-9ddc-4a9d-5b6758e4159e", requests[len(requests)-1])
+}
+
+func Test_Leadership_Events(t *testing.T) {
+	ctx, ts, client := testClient(newLeadershipHandler(t))
+	defer ts.Close()
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key:         "my/key",
+		ContactInfo: "node1",
+		TTL:         30 * time.Second,
+	}, func(ctx Ctx) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	events := session.Events(ctx)
+
+	// wait until the event matching exp is received
+	await := func(exp LeadershipEvent) {
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case event := <-events:
+				if event == exp {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("expected event %#v", exp)
+			}
+		}
+	}
+
+	await(LeadershipEvent{IsLeader: true, Leader: "node1"})
+	require.True(t, session.IsLeader())
+
+	err = session.Abdicate(ctx)
+	require.NoError(t, err)
+
+	await(LeadershipEvent{IsLeader: false, Leader: ""})
+	require.False(t, session.IsLeader())
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+
+	// the channel is closed once participation stops
+	for range events {
+	}
+
+	// subscribing after participation stops yields a closed channel
+	_, open := <-session.Events(ctx)
+	require.False(t, open)
+}
+
+func Test_Leadership_Events_unsubscribe(t *testing.T) {
+	ctx, ts, client := testClient(newLeadershipHandler(t))
+	defer ts.Close()
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key: "my/key",
+		TTL: 30 * time.Second,
+	}, func(ctx Ctx) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	events := session.Events(subCtx)
+
+	// the current state is sent immediately
+	_, open := <-events
+	require.True(t, open)
+
+	cancel()
+	for range events {
+	}
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+}
+
+func Test_Leadership_decodeLeaderRecord(t *testing.T) {
+	electedAt := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	require.Equal(t, LeaderRecord{
+		ContactInfo: "node1",
+		Metadata:    map[string]string{"zone": "a"},
+		ElectedAt:   electedAt,
+		Session:     testLockSession,
+	}, decodeLeaderRecord(`{"ContactInfo":"node1","Metadata":{"zone":"a"},"ElectedAt":"2020-03-04T05:06:07Z","Session":"`+testLockSession+`"}`))
+
+	// written by older versions of this package
+	require.Equal(t, LeaderRecord{ContactInfo: "node1"}, decodeLeaderRecord(`"node1"`))
+
+	// written by something else
+	require.Equal(t, LeaderRecord{ContactInfo: "node1"}, decodeLeaderRecord(`node1`))
+}
+
+func Test_Leadership_FencingToken(t *testing.T) {
+	handler := newLeadershipHandler(t)
+	ctx, ts, client := testClient(handler)
+	defer ts.Close()
+
+	tokens := make(chan uint64, 1)
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key:         "my/key",
+		ContactInfo: "node1",
+		Metadata:    map[string]string{"zone": "a"},
+		TTL:         30 * time.Second,
+	}, func(ctx Ctx) error {
+		token, ok := FencingToken(ctx)
+		require.True(t, ok)
+		tokens <- token
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	select {
+	case token := <-tokens:
+		require.Equal(t, uint64(3), token)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected to be elected leader")
+	}
+
+	// the leader key holds the leader record
+	handler.lock.Lock()
+	record := decodeLeaderRecord(handler.value)
+	handler.lock.Unlock()
+	require.Equal(t, "node1", record.ContactInfo)
+	require.Equal(t, map[string]string{"zone": "a"}, record.Metadata)
+	require.Equal(t, SessionID(testLockSession), record.Session)
+	require.False(t, record.ElectedAt.IsZero())
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+
+	_, ok := FencingToken(ctx)
+	require.False(t, ok)
+}
+
+func Test_LeadershipConfig_validate(t *testing.T) {
+	require.NoError(t, LeadershipConfig{TTL: 10 * time.Second}.validate())
+	require.NoError(t, LeadershipConfig{TTL: 10 * time.Second, RenewInterval: 9 * time.Second, Jitter: 1}.validate())
+
+	err := LeadershipConfig{TTL: 10 * time.Second, RenewInterval: 10 * time.Second}.validate()
+	require.EqualError(t, err, "leadership renew interval must be less than ttl")
+
+	err = LeadershipConfig{TTL: 10 * time.Second, Jitter: 1.5}.validate()
+	require.EqualError(t, err, "leadership jitter must be between 0 and 1")
+
+	_, ts, client := testClient(&sequence{t: t})
+	defer ts.Close()
+
+	_, err = client.Participate(context.Background(), LeadershipConfig{Jitter: -1}, nil)
+	require.EqualError(t, err, "leadership jitter must be between 0 and 1")
+}
+
+func Test_LeadershipConfig_timing(t *testing.T) {
+	lc := LeadershipConfig{TTL: 20 * time.Second}
+	require.Equal(t, 30*time.Second, lc.requestTimeout())
+	require.Equal(t, 20*time.Second, lc.campaignInterval())
+	require.Equal(t, 1*time.Second, lc.retry(1))
+	require.Equal(t, 16*time.Second, lc.retry(5))
+	require.Equal(t, 30*time.Second, lc.retry(6))
+
+	lc = LeadershipConfig{
+		TTL:              20 * time.Second,
+		RequestTimeout:   5 * time.Second,
+		CampaignInterval: 2 * time.Second,
+		RetryInterval:    100 * time.Millisecond,
+		MaxRetryInterval: 300 * time.Millisecond,
+	}
+	require.Equal(t, 5*time.Second, lc.requestTimeout())
+	require.Equal(t, 2*time.Second, lc.campaignInterval())
+	require.Equal(t, 200*time.Millisecond, lc.retry(2))
+	require.Equal(t, 300*time.Millisecond, lc.retry(3))
+
+	lc.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		wait := lc.campaignInterval()
+		require.True(t, wait >= 1*time.Second && wait <= 3*time.Second, "wait: %v", wait)
+	}
+}
+
+func Test_Leadership_WatchLock(t *testing.T) {
+	handler := newLeadershipHandler(t)
+	ctx, ts, client := testClient(handler)
+	defer ts.Close()
+
+	// another participant is the leader
+	handler.hold(testOtherSession)
+
+	elected := make(chan struct{})
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key:       "my/key",
+		TTL:       30 * time.Second,
+		WatchLock: true,
+	}, func(ctx Ctx) error {
+		close(elected)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	// wait for the standby to start watching the leader key
+	time.Sleep(100 * time.Millisecond)
+	require.False(t, session.IsLeader())
+
+	// the leader steps down, and the standby takes over well before the
+	// campaign interval (of 30s) elapses
+	handler.hold("")
+
+	select {
+	case <-elected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected to be elected leader")
+	}
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+}
+
+func Test_Leadership_session_options(t *testing.T) {
+	handler := newLeadershipHandler(t)
+	ctx, ts, client := testClient(handler)
+	defer ts.Close()
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key:        "my/key",
+		TTL:        30 * time.Second,
+		Node:       "node2",
+		NodeChecks: []string{"serfHealth"},
+		ServiceChecks: []SessionServiceCheck{{
+			ID: "service:elector",
+		}},
+		Behavior: SessionRelease,
+	}, func(ctx Ctx) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	for session.SessionID(ctx) == "" {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+
+	// the agent is not queried for its node, because the node is set
+	require.Equal(t, "PUT /v1/session/create", handler.received()[0])
+
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	require.Equal(t, `{"Node":"node2","Name":"default-leader-session","LockDelay":"0s","TTL":"30s","Behavior":"release",`+
+		`"NodeChecks":["serfHealth"],"ServiceChecks":[{"ID":"service:elector"}]}`, handler.created)
+}
@@ -0,0 +1,398 @@
+package consulapi
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLockSessionName = "default-lock-session"
+	defaultLockTTL         = 15 * time.Second
+	defaultLockRetry       = 5 * time.Second
+)
+
+// LockConfig is used to configure a Lock.
+type LockConfig struct {
+	// Key is the path in the consul KV store used as the lock. This must be
+	// set. Typically this value will look something like
+	// "service/<service name>/lock".
+	Key string
+
+	// Value (optional) is stored in Key while the lock is held. It can be
+	// used to indicate which process is holding the lock.
+	Value string
+
+	// DC (optional) indicates the datacenter of the lock.
+	//
+	// If blank, this will default to the datacenter that the queried agent is in.
+	DC string
+
+	// Session (optional) is an existing session to acquire the lock with. The
+	// caller is responsible for keeping the session alive, and destroying it.
+	//
+	// If not set, a new session is created by each call to Lock. The session
+	// is renewed while it is in use, and destroyed by Unlock.
+	Session SessionID
+
+	// SessionName (optional) is the name of the session created by Lock.
+	// If not set, SessionName defaults to "default-lock-session".
+	SessionName string
+
+	// Node (optional) is the node to associate the session created by Lock
+	// with. If not set, the node of the queried agent is used.
+	Node string
+
+	// TTL (optional) is the TTL of the session created by Lock. If not set,
+	// TTL defaults to 15 seconds. See SessionConfig.TTL.
+	TTL time.Duration
+
+	// LockDelay (optional) is the lock delay of the session created by Lock.
+	// See SessionConfig.LockDelay.
+	LockDelay time.Duration
+
+	// RetryInterval (optional) is how long to wait before trying to acquire
+	// the lock again after an error, or after the lock was free but could not
+	// be acquired (e.g. because of the lock delay of a previous holder). If
+	// not set, RetryInterval defaults to 5 seconds.
+	RetryInterval time.Duration
+}
+
+func (lc LockConfig) session() lockSession {
+	return lockSession{
+		existing:  lc.Session,
+		dc:        lc.DC,
+		node:      lc.Node,
+		name:      lc.SessionName,
+		ttl:       lc.TTL,
+		lockDelay: lc.LockDelay,
+	}
+}
+
+func (lc LockConfig) retry() time.Duration {
+	if lc.RetryInterval <= 0 {
+		return defaultLockRetry
+	}
+	return lc.RetryInterval
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Locker -s _mock.go
+
+// A Locker is able to create distributed locks and semaphores, for providing
+// mutual exclusion of a critical section across processes. This is what the
+// consul lock command does. Unlike Participate, a Lock or Semaphore is
+// acquired and released explicitly.
+//
+// https://learn.hashicorp.com/tutorials/consul/distributed-semaphore
+type Locker interface {
+	// NewLock creates a Lock using the key of config. The lock is not
+	// acquired until Lock is called.
+	NewLock(LockConfig) (Lock, error)
+
+	// NewSemaphore creates a Semaphore using the prefix of config. The
+	// semaphore is not acquired until Acquire is called.
+	NewSemaphore(SemaphoreConfig) (Semaphore, error)
+}
+
+// An assertion that client satisfies Locker
+var _ Locker = (*client)(nil)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Lock -s _mock.go
+
+// A Lock is a distributed lock, backed by a key in the consul KV store which
+// is acquired using a session.
+type Lock interface {
+	// Lock blocks until the lock is acquired, or until ctx is done. Once the
+	// lock is acquired, the returned channel is closed when the lock is lost,
+	// which happens if the session is invalidated, if the key is modified to
+	// no longer be held by the session, or once Unlock is called. The critical
+	// section must be abandoned as soon as the channel is closed.
+	Lock(Ctx) (<-chan struct{}, error)
+
+	// Unlock releases the lock, and destroys the session created by Lock.
+	Unlock(Ctx) error
+}
+
+type lock struct {
+	client *client
+	config LockConfig
+
+	mutex   sync.Mutex
+	held    bool
+	session SessionID
+	stop    context.CancelFunc
+	destroy func() error
+	lost    *signal
+}
+
+func (c *client) NewLock(config LockConfig) (Lock, error) {
+	config.Key = strings.TrimPrefix(config.Key, "/")
+	if config.Key == "" {
+		return nil, errors.New("lock key required")
+	}
+
+	return &lock{
+		client: c,
+		config: config,
+	}, nil
+}
+
+func (l *lock) Lock(ctx Ctx) (<-chan struct{}, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.held {
+		return nil, errors.New("lock is already held")
+	}
+
+	lost := newSignal()
+
+	session, destroy, err := l.client.establishLockSession(ctx, l.config.session(), lost)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to acquire lock")
+	}
+
+	if err := l.acquire(ctx, session, lost); err != nil {
+		if destroyErr := destroy(); destroyErr != nil {
+			l.client.log.Warnf("failed to destroy lock session %s: %v", session, destroyErr)
+		}
+		return nil, errors.Wrap(err, "failed to acquire lock")
+	}
+
+	bgCtx, stop := context.WithCancel(context.Background())
+
+	l.held = true
+	l.session = session
+	l.stop = stop
+	l.destroy = destroy
+	l.lost = lost
+
+	go l.monitor(bgCtx, session, lost)
+
+	return lost.C(), nil
+}
+
+func (l *lock) Unlock(ctx Ctx) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.held {
+		return errors.New("lock is not held")
+	}
+
+	l.held = false
+	l.stop()
+	l.lost.fire()
+
+	// release the lock even if it was lost, in case the key was modified
+	// but the session is still holding it
+	_, releaseErr := l.client.Write(ctx, l.config.Key, l.config.Value, WriteQuery{
+		DC:      l.config.DC,
+		Release: l.session,
+	})
+
+	// destroy the session even if the release failed, which releases the
+	// lock anyway
+	if err := l.destroy(); err != nil && releaseErr == nil {
+		return errors.Wrap(err, "failed to destroy lock session")
+	}
+
+	if releaseErr != nil {
+		return errors.Wrap(releaseErr, "failed to release lock")
+	}
+
+	return nil
+}
+
+// acquire blocks until the lock is acquired using session, waiting for the
+// key to change whenever it is held by a different session.
+func (l *lock) acquire(ctx Ctx, session SessionID, lost *signal) error {
+	index := uint64(0)
+
+	for {
+		entries, meta, err := l.client.entries(ctx, l.config.Key, Query{
+			DC:        l.config.DC,
+			WaitIndex: index,
+		}, false)
+
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+
+		case err != nil:
+			l.client.log.Warnf("failed to read lock %s, try again in %v: %v", l.config.Key, l.config.retry(), err)
+			index = 0
+
+		case len(entries) > 0 && entries[0].Session != "" && entries[0].Session != session:
+			// held by someone else, wait for the key to change
+			index = meta.LastIndex
+			if index < 1 {
+				index = 1
+			}
+			continue
+
+		default:
+			acquired, err := l.client.Write(ctx, l.config.Key, l.config.Value, WriteQuery{
+				DC:      l.config.DC,
+				Acquire: session,
+			})
+			switch {
+			case ctx.Err() != nil:
+				return ctx.Err()
+			case err != nil:
+				l.client.log.Warnf("failed to acquire lock %s, try again in %v: %v", l.config.Key, l.config.retry(), err)
+			case acquired:
+				return nil
+			}
+			index = 0
+		}
+
+		select {
+		case <-time.After(l.config.retry()):
+		case <-lost.C():
+			return errors.New("session was invalidated")
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// monitor watches the key of the lock, firing lost once the key is no longer
+// held by session.
+func (l *lock) monitor(ctx Ctx, session SessionID, lost *signal) {
+	index := uint64(0)
+
+	for {
+		entries, meta, err := l.client.entries(ctx, l.config.Key, Query{
+			DC:        l.config.DC,
+			WaitIndex: index,
+		}, false)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			l.client.log.Warnf("failed to monitor lock %s, try again in %v: %v", l.config.Key, l.config.retry(), err)
+			if !sleep(ctx, l.config.retry()) {
+				return
+			}
+			continue
+		}
+
+		if len(entries) == 0 || entries[0].Session != session {
+			l.client.log.Tracef("lock %s is no longer held by session %s", l.config.Key, session)
+			lost.fire()
+			return
+		}
+
+		index = meta.LastIndex
+		if index < 1 {
+			index = 1
+		}
+	}
+}
+
+// A lockSession describes the session used to acquire a Lock or Semaphore.
+type lockSession struct {
+	// existing is the session provided by the caller, if any
+	existing SessionID
+
+	dc        string
+	node      string
+	name      string
+	ttl       time.Duration
+	lockDelay time.Duration
+}
+
+func (ls lockSession) ttlOrDefault() time.Duration {
+	if ls.ttl == 0 {
+		return defaultLockTTL
+	}
+	return ls.ttl
+}
+
+// establishLockSession returns the existing session of ls, or creates a new
+// session which is renewed in the background, firing lost if the session is
+// lost. The returned function stops renewing the session and destroys it,
+// and does nothing for an existing session.
+func (c *client) establishLockSession(ctx Ctx, ls lockSession, lost *signal) (SessionID, func() error, error) {
+	if ls.existing != "" {
+		return ls.existing, func() error { return nil }, nil
+	}
+
+	node := ls.node
+	if node == "" {
+		self, err := c.Self(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		node = self.Name
+	}
+
+	name := ls.name
+	if name == "" {
+		name = defaultLockSessionName
+	}
+
+	ttl := ls.ttlOrDefault()
+
+	session, err := c.CreateSession(ctx, SessionConfig{
+		DC:        ls.dc,
+		Node:      node,
+		Name:      name,
+		LockDelay: ls.lockDelay,
+		TTL:       ttl,
+		Behavior:  SessionRelease,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	renewCtx, stop := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+
+	go func() {
+		err := c.RenewPeriodic(renewCtx, SessionQuery{
+			ID: session,
+			DC: ls.dc,
+		}, ttl)
+
+		// the session was lost, rather than destroyed
+		if renewCtx.Err() == nil {
+			c.log.Warnf("lock session %s was lost: %v", session, err)
+			lost.fire()
+			err = nil
+		}
+
+		result <- err
+	}()
+
+	return session, func() error {
+		stop()
+		return <-result
+	}, nil
+}
+
+// A signal is a channel which is closed at most once.
+type signal struct {
+	once sync.Once
+	c    chan struct{}
+}
+
+func newSignal() *signal {
+	return &signal{c: make(chan struct{})}
+}
+
+func (s *signal) fire() {
+	s.once.Do(func() {
+		close(s.c)
+	})
+}
+
+func (s *signal) C() <-chan struct{} {
+	return s.c
+}
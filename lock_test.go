@@ -0,0 +1,239 @@
+package consulapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLockSession  = "adf4238a-882b-9ddc-4a9d-5b6758e4159e"
+	testOtherSession = "b2b1a3d4-5d8e-11ea-bc55-0242ac130003"
+)
+
+func lockEntry(session, index string) string {
+	return `[{"Key":"locks/job","Value":"am9iLTE=","Session":"` + session + `","LockIndex":1,"ModifyIndex":` + index + `}]`
+}
+
+func Test_Lock_NewLock(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t})
+	defer ts.Close()
+
+	_, err := client.NewLock(LockConfig{Key: "/"})
+	require.EqualError(t, err, "lock key required")
+}
+
+func Test_Lock_lost(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_agent_self.json"),
+		hasPath:   "/v1/agent/self",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_session_create.json"),
+		hasPath:   "/v1/session/create",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{"Node":"mydc-mynode1","Name":"default-lock-session","LockDelay":"0s","TTL":"15s","Behavior":"release"}`,
+	}, {
+		// held by a different session
+		t:         t,
+		code:      http.StatusOK,
+		body:      lockEntry(testOtherSession, "5"),
+		headers:   indexed("5"),
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		// released by the different session
+		t:         t,
+		code:      http.StatusOK,
+		body:      lockEntry("", "7"),
+		headers:   indexed("7"),
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"5"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"acquire": {testLockSession},
+		},
+		hasBody: "job-1",
+	}, {
+		// monitor sees the lock is held
+		t:         t,
+		code:      http.StatusOK,
+		body:      lockEntry(testLockSession, "8"),
+		headers:   indexed("8"),
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		// monitor sees the lock was lost
+		t:         t,
+		code:      http.StatusOK,
+		body:      lockEntry(testOtherSession, "9"),
+		headers:   indexed("9"),
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"8"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "false",
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"release": {testLockSession},
+		},
+		hasBody: "job-1",
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/session/destroy/" + testLockSession,
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	l, err := client.NewLock(LockConfig{
+		Key:   "/locks/job",
+		Value: "job-1",
+	})
+	require.NoError(t, err)
+
+	lost, err := l.Lock(ctx)
+	require.NoError(t, err)
+
+	_, err = l.Lock(ctx)
+	require.EqualError(t, err, "lock is already held")
+
+	select {
+	case <-lost:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected lock to be lost")
+	}
+
+	err = l.Unlock(ctx)
+	require.NoError(t, err)
+
+	err = l.Unlock(ctx)
+	require.EqualError(t, err, "lock is not held")
+}
+
+func Test_Lock_existing_session(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusNotFound,
+		headers:   indexed("3"),
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc": {"dc2"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"dc":      {"dc2"},
+			"acquire": {testOtherSession},
+		},
+	}, nil, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"dc":      {"dc2"},
+			"release": {testOtherSession},
+		},
+	}}})
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	l, err := client.NewLock(LockConfig{
+		Key:     "locks/job",
+		DC:      "dc2",
+		Session: testOtherSession,
+	})
+	require.NoError(t, err)
+
+	lost, err := l.Lock(ctx)
+	require.NoError(t, err)
+
+	// wait for the monitor to start its blocking query, so the order of
+	// requests is deterministic
+	time.Sleep(100 * time.Millisecond)
+
+	// the session is not destroyed, because it was not created by the lock
+	err = l.Unlock(ctx)
+	require.NoError(t, err)
+
+	_, open := <-lost
+	require.False(t, open)
+}
+
+func Test_Lock_cancel(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_session_create.json"),
+		hasPath:   "/v1/session/create",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{"Node":"node1","Name":"job-lock","LockDelay":"0s","TTL":"30s","Behavior":"release"}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      lockEntry(testOtherSession, "5"),
+		headers:   indexed("5"),
+		hasPath:   "/v1/kv/locks/job",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, nil, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/session/destroy/" + testLockSession,
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	l, err := client.NewLock(LockConfig{
+		Key:         "locks/job",
+		SessionName: "job-lock",
+		Node:        "node1",
+		TTL:         30 * time.Second,
+	})
+	require.NoError(t, err)
+
+	_, err = l.Lock(ctx)
+	require.EqualError(t, err, "failed to acquire lock: context deadline exceeded")
+}
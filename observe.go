@@ -0,0 +1,276 @@
+package consulapi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A NoLeaderError indicates there is currently no elected leader, because the
+// leader key does not exist, or is not held by any session.
+type NoLeaderError struct {
+	// Key is the leader key of the election.
+	Key string
+}
+
+func (e *NoLeaderError) Error() string {
+	return fmt.Sprintf("no leader elected for %s", e.Key)
+}
+
+// Is indicates whether target is ErrNoLeader.
+func (e *NoLeaderError) Is(target error) bool {
+	return target == ErrNoLeader
+}
+
+// A Leader describes the elected leader of a leadership election.
+type Leader struct {
+	// ContactInfo is the LeadershipConfig.ContactInfo of the leader.
+	ContactInfo string
+
+	// Metadata is the LeadershipConfig.Metadata of the leader.
+	Metadata map[string]string
+
+	// ElectedAt is when the leader was elected. It is the zero time if the
+	// leader did not publish a LeaderRecord.
+	ElectedAt time.Time
+
+	// Session is the ID of the session holding the leader key.
+	Session SessionID
+
+	// Node is the node the session of the leader is associated with.
+	Node string
+
+	// LockIndex is the fencing token of the term of the leader. See
+	// FencingToken.
+	LockIndex uint64
+}
+
+// leaderOf returns the leader described by kv, which holds the leader key.
+func leaderOf(kv *KVEntry) Leader {
+	record := decodeLeaderRecord(kv.Value)
+	return Leader{
+		ContactInfo: record.ContactInfo,
+		Metadata:    record.Metadata,
+		ElectedAt:   record.ElectedAt,
+		Session:     kv.Session,
+		LockIndex:   kv.LockIndex,
+	}
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Observer -s _mock.go
+
+// An Observer is able to follow the leader of a leadership election, without
+// participating in the election. This is useful for services which only need
+// to know where the leader is, for example to forward requests to it.
+type Observer interface {
+	// Observe follows the leader of the election using the leader key, which
+	// is the LeadershipConfig.Key used by participants. The current leader is
+	// read before Observe returns, and is then kept up to date in the
+	// background until ctx is done.
+	Observe(ctx Ctx, key string) (LeaderObservation, error)
+}
+
+// An assertion that client satisfies Observer
+var _ Observer = (*client)(nil)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i LeaderObservation -s _mock.go
+
+// A LeaderObservation provides the leader of an election, as followed by
+// Observe.
+type LeaderObservation interface {
+	// Leader returns the most recently observed leader. If there is currently
+	// no leader, a *NoLeaderError is returned.
+	Leader() (Leader, error)
+
+	// Changes returns a channel on which the observed leader is sent whenever
+	// it changes. The zero Leader is sent when there is no longer a leader.
+	// The current leader is sent immediately. The channel is closed once ctx
+	// is done, or the observation stops.
+	//
+	// A receiver which falls behind may miss intermediate changes, but always
+	// receives the most recent leader.
+	Changes(Ctx) <-chan Leader
+}
+
+type leaderObserver struct {
+	client *client
+	key    string
+	done   chan struct{}
+
+	lock        sync.Mutex
+	leader      Leader
+	subscribers map[chan Leader]struct{}
+	stopped     bool
+}
+
+func (c *client) Observe(ctx Ctx, key string) (LeaderObservation, error) {
+	key = strings.TrimPrefix(key, "/")
+	if key == "" {
+		return nil, errors.New("leader key required")
+	}
+
+	lo := &leaderObserver{
+		client:      c,
+		key:         key,
+		done:        make(chan struct{}),
+		subscribers: make(map[chan Leader]struct{}),
+	}
+
+	entries, meta, err := c.entries(ctx, key, Query{}, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to lookup leadership")
+	}
+
+	var kv *KVEntry
+	if len(entries) > 0 {
+		kv = &entries[0]
+	}
+
+	leader, err := lo.resolve(ctx, kv)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to lookup leader session")
+	}
+	lo.leader = leader
+
+	// continue from the index of the initial read, so the watch only reports
+	// changes to the leader key
+	index := meta.LastIndex
+	if index < 1 {
+		index = 1
+	}
+
+	watched := c.WatchKey(ctx, key, Query{WaitIndex: index}, WatchOptions{
+		Handler: func(event WatchEvent) {
+			lo.observe(ctx, event)
+		},
+	})
+
+	go func() {
+		<-watched
+		lo.closeSubscribers()
+		close(lo.done)
+	}()
+
+	return lo, nil
+}
+
+// resolve returns the leader described by kv, looking up the node of the
+// session holding the leader key.
+func (lo *leaderObserver) resolve(ctx Ctx, kv *KVEntry) (Leader, error) {
+	if kv == nil || kv.Session == "" {
+		return Leader{}, nil
+	}
+
+	leader := leaderOf(kv)
+
+	// the node does not change for the lifetime of a session
+	lo.lock.Lock()
+	previous := lo.leader
+	lo.lock.Unlock()
+	if previous.Session == leader.Session {
+		leader.Node = previous.Node
+		return leader, nil
+	}
+
+	session, _, err := lo.client.ReadSession(ctx, SessionQuery{ID: kv.Session})
+	switch {
+	case IsNotFound(err):
+		// the session was invalidated, and the key is about to be released
+		return Leader{}, nil
+	case err != nil:
+		return Leader{}, err
+	}
+
+	leader.Node = session.Node
+	return leader, nil
+}
+
+// observe is the handler of the watch on the leader key
+func (lo *leaderObserver) observe(ctx Ctx, event WatchEvent) {
+	leader, err := lo.resolve(ctx, event.KV)
+	if err != nil {
+		if ctx.Err() == nil {
+			lo.client.log.Warnf("failed to lookup leader session of %s: %v", lo.key, err)
+		}
+		// the node of the leader is unknown, but the leader is not
+		leader = leaderOf(event.KV)
+	}
+
+	lo.lock.Lock()
+	defer lo.lock.Unlock()
+
+	if reflect.DeepEqual(leader, lo.leader) {
+		return
+	}
+	lo.leader = leader
+
+	for changes := range lo.subscribers {
+		// replace the leader the subscriber has not yet received, if any
+		select {
+		case changes <- leader:
+		default:
+			select {
+			case <-changes:
+			default:
+			}
+			changes <- leader
+		}
+	}
+}
+
+func (lo *leaderObserver) Leader() (Leader, error) {
+	lo.lock.Lock()
+	defer lo.lock.Unlock()
+
+	if lo.leader.Session == "" {
+		return Leader{}, &NoLeaderError{Key: lo.key}
+	}
+	return lo.leader, nil
+}
+
+func (lo *leaderObserver) Changes(ctx Ctx) <-chan Leader {
+	changes := make(chan Leader, 1)
+
+	lo.lock.Lock()
+	defer lo.lock.Unlock()
+
+	if lo.stopped {
+		close(changes)
+		return changes
+	}
+
+	lo.subscribers[changes] = struct{}{}
+	changes <- lo.leader
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-lo.done:
+			return
+		}
+
+		lo.lock.Lock()
+		defer lo.lock.Unlock()
+		if _, exists := lo.subscribers[changes]; exists {
+			delete(lo.subscribers, changes)
+			close(changes)
+		}
+	}()
+
+	return changes
+}
+
+func (lo *leaderObserver) closeSubscribers() {
+	lo.lock.Lock()
+	defer lo.lock.Unlock()
+
+	lo.stopped = true
+	for changes := range lo.subscribers {
+		delete(lo.subscribers, changes)
+		close(changes)
+	}
+}
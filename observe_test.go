@@ -0,0 +1,115 @@
+package consulapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Observe_key_required(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t})
+	defer ts.Close()
+
+	_, err := client.Observe(ctx, "/")
+	require.EqualError(t, err, "leader key required")
+}
+
+func Test_Observe_no_leader(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusNotFound,
+		headers:   indexed("12"),
+		hasPath:   "/v1/kv/my/key",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, nil}})
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	observation, err := client.Observe(ctx, "my/key")
+	require.NoError(t, err)
+
+	_, err = observation.Leader()
+	require.EqualError(t, err, "no leader elected for my/key")
+	require.True(t, IsNoLeader(err))
+
+	changes := observation.Changes(ctx)
+	require.Equal(t, Leader{}, <-changes)
+
+	cancel()
+
+	_, open := <-changes
+	require.False(t, open)
+}
+
+func Test_Observe_follows_leader(t *testing.T) {
+	_, ts, client := testClient(newLeadershipHandler(t))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	observation, err := client.Observe(ctx, "/my/key")
+	require.NoError(t, err)
+
+	_, err = observation.Leader()
+	require.True(t, IsNoLeader(err))
+
+	changes := observation.Changes(ctx)
+	require.Equal(t, Leader{}, <-changes)
+
+	session, err := client.Participate(ctx, LeadershipConfig{
+		Key:         "my/key",
+		ContactInfo: "node1",
+		Metadata:    map[string]string{"zone": "a"},
+		TTL:         30 * time.Second,
+	}, func(ctx Ctx) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	// wait until a leader matching f is received
+	await := func(f func(Leader) bool) Leader {
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case leader := <-changes:
+				if f(leader) {
+					return leader
+				}
+			case <-timeout:
+				t.Fatal("expected leader change")
+			}
+		}
+	}
+
+	elected := await(func(leader Leader) bool {
+		return leader.Session != ""
+	})
+	require.Equal(t, "node1", elected.ContactInfo)
+	require.Equal(t, map[string]string{"zone": "a"}, elected.Metadata)
+	require.Equal(t, SessionID(testLockSession), elected.Session)
+	require.Equal(t, "node1", elected.Node)
+	require.Equal(t, uint64(3), elected.LockIndex)
+	require.False(t, elected.ElectedAt.IsZero())
+
+	leader, err := observation.Leader()
+	require.NoError(t, err)
+	require.Equal(t, elected, leader)
+
+	err = session.Resign(ctx)
+	require.NoError(t, err)
+
+	await(func(leader Leader) bool {
+		return leader.Session == ""
+	})
+
+	_, err = observation.Leader()
+	require.True(t, IsNoLeader(err))
+}
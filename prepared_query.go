@@ -0,0 +1,510 @@
+package consulapi
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PreparedQueryTemplateType is the kind of template of a prepared query.
+type PreparedQueryTemplateType string
+
+const (
+	// PreparedQueryNamePrefixMatch templates match any query whose name
+	// starts with the name of the template.
+	PreparedQueryNamePrefixMatch PreparedQueryTemplateType = "name_prefix_match"
+)
+
+// A PreparedQueryTemplate turns a prepared query into a template, which is
+// used to execute any query whose name matches the template, rather than only
+// the query of its own name.
+//
+// https://www.consul.io/api-docs/query#prepared-query-templates
+type PreparedQueryTemplate struct {
+	// Type is the kind of template.
+	Type PreparedQueryTemplateType `json:"Type,omitempty"`
+
+	// Regexp (optional) is matched against the name of the executed query.
+	// Its capture groups may be interpolated into the query using
+	// ${match(N)}.
+	Regexp string `json:"Regexp,omitempty"`
+
+	// RemoveEmptyTags removes the tags of the service which are empty once
+	// interpolated.
+	RemoveEmptyTags bool `json:"RemoveEmptyTags,omitempty"`
+}
+
+// A PreparedQueryFailoverTarget is a datacenter, or cluster peer, to fail over
+// to when there are no healthy instances of the service.
+type PreparedQueryFailoverTarget struct {
+	Datacenter string `json:"Datacenter,omitempty"`
+	Peer       string `json:"Peer,omitempty"`
+}
+
+// PreparedQueryFailover decides which other datacenters are queried when
+// there are no healthy instances of the service in the datacenter of the
+// query. NearestN is used first, followed by Datacenters or Targets.
+type PreparedQueryFailover struct {
+	// NearestN (optional) queries up to this many other datacenters, in
+	// order of estimated round trip time.
+	NearestN int `json:"NearestN,omitempty"`
+
+	// Datacenters (optional) are queried in order.
+	Datacenters []string `json:"Datacenters,omitempty"`
+
+	// Targets (optional) are queried in order, and may not be combined with
+	// Datacenters.
+	Targets []PreparedQueryFailoverTarget `json:"Targets,omitempty"`
+}
+
+// PreparedQueryService describes the instances of a service returned by a
+// prepared query.
+type PreparedQueryService struct {
+	// Service is the name of the service to query.
+	Service string `json:"Service"`
+
+	// Failover (optional) decides which other datacenters are queried.
+	Failover PreparedQueryFailover `json:"Failover"`
+
+	// OnlyPassing excludes instances with checks in the warning state.
+	// Instances with checks in the critical state are always excluded.
+	OnlyPassing bool `json:"OnlyPassing,omitempty"`
+
+	// IgnoreCheckIDs (optional) are checks whose state is ignored.
+	IgnoreCheckIDs []string `json:"IgnoreCheckIDs,omitempty"`
+
+	// Near (optional) sorts the instances by estimated round trip time from
+	// this node. The value "_agent" uses the node of the queried agent.
+	Near string `json:"Near,omitempty"`
+
+	// Tags (optional) filters the instances by tag. A tag starting with "!"
+	// excludes instances with that tag.
+	Tags []string `json:"Tags,omitempty"`
+
+	// NodeMeta (optional) filters the instances by node metadata.
+	NodeMeta map[string]string `json:"NodeMeta,omitempty"`
+
+	// ServiceMeta (optional) filters the instances by service metadata.
+	ServiceMeta map[string]string `json:"ServiceMeta,omitempty"`
+
+	// Connect returns the consul CONNECT capable instances (i.e. sidecar
+	// proxies and native services) which can be used to reach the service.
+	Connect bool `json:"Connect,omitempty"`
+}
+
+// A PreparedQuery is a query of the healthy instances of a service, which is
+// stored in consul, and executed by its ID or name.
+//
+// https://www.consul.io/api-docs/query
+type PreparedQuery struct {
+	// ID is set by consul when the query is created.
+	ID string
+
+	// Name (optional) is used to execute the query by name, instead of ID.
+	Name string
+
+	// Session (optional) ties the lifetime of the query to the session of
+	// this ID, so that the query is deleted when the session is invalidated.
+	Session string
+
+	// Token (optional) is used to execute the query, instead of the token
+	// of the request executing the query.
+	Token string
+
+	// Template (optional) turns the query into a template.
+	Template PreparedQueryTemplate
+
+	// Service describes the instances returned by the query.
+	Service PreparedQueryService
+
+	// DNSTTL (optional) is the TTL of DNS responses to the query.
+	DNSTTL time.Duration
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+type preparedQueryFormat struct {
+	ID          string                 `json:"ID,omitempty"`
+	Name        string                 `json:"Name,omitempty"`
+	Session     string                 `json:"Session,omitempty"`
+	Token       string                 `json:"Token,omitempty"`
+	Template    *PreparedQueryTemplate `json:"Template,omitempty"`
+	Service     PreparedQueryService   `json:"Service"`
+	DNS         *preparedQueryDNS      `json:"DNS,omitempty"`
+	CreateIndex uint64                 `json:"CreateIndex,omitempty"`
+	ModifyIndex uint64                 `json:"ModifyIndex,omitempty"`
+}
+
+type preparedQueryDNS struct {
+	TTL string `json:"TTL,omitempty"`
+}
+
+func internalizePreparedQuery(query PreparedQuery) preparedQueryFormat {
+	format := preparedQueryFormat{
+		ID:      query.ID,
+		Name:    query.Name,
+		Session: query.Session,
+		Token:   query.Token,
+		Service: query.Service,
+	}
+	if query.Template != (PreparedQueryTemplate{}) {
+		template := query.Template
+		format.Template = &template
+	}
+	if query.DNSTTL > 0 {
+		format.DNS = &preparedQueryDNS{TTL: query.DNSTTL.String()}
+	}
+	return format
+}
+
+func (format preparedQueryFormat) preparedQuery() (PreparedQuery, error) {
+	query := PreparedQuery{
+		ID:          format.ID,
+		Name:        format.Name,
+		Session:     format.Session,
+		Token:       format.Token,
+		Service:     format.Service,
+		CreateIndex: format.CreateIndex,
+		ModifyIndex: format.ModifyIndex,
+	}
+
+	if format.Template != nil {
+		query.Template = *format.Template
+	}
+
+	ttl, err := format.DNS.ttl()
+	if err != nil {
+		return PreparedQuery{}, err
+	}
+	query.DNSTTL = ttl
+
+	return query, nil
+}
+
+func (dns *preparedQueryDNS) ttl() (time.Duration, error) {
+	if dns == nil || dns.TTL == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(dns.TTL)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to parse prepared query dns ttl")
+	}
+	return ttl, nil
+}
+
+// PreparedQueryOptions is used to define values for each of the optional
+// parameters of the prepared query endpoints.
+type PreparedQueryOptions struct {
+	// DC indicates which dc to query.
+	//
+	// If blank, this will default to the dc that the queried agent is in.
+	DC string
+
+	// Token (optional) is used to authenticate the request, instead of the
+	// token of the client.
+	Token string
+}
+
+// PreparedQueryExecution is used to define values for each of the optional
+// parameters of executing a prepared query.
+type PreparedQueryExecution struct {
+	// DC indicates which dc to execute the query in.
+	//
+	// If blank, this will default to the dc that the queried agent is in.
+	DC string
+
+	// Near (optional) sorts the instances by estimated round trip time from
+	// this node, overriding the Near of the query. The value "_agent" uses
+	// the node of the queried agent.
+	Near string
+
+	// Limit (optional) is the maximum number of instances to return.
+	Limit int
+
+	// Connect returns the consul CONNECT capable instances (i.e. sidecar
+	// proxies and native services) which can be used to reach the service,
+	// as if Connect were set on the query.
+	Connect bool
+
+	// Token (optional) is used to authenticate the request, instead of the
+	// token of the client.
+	Token string
+}
+
+// PreparedQueryResult contains the instances of the service returned by
+// executing a prepared query.
+type PreparedQueryResult struct {
+	// Service is the name of the queried service.
+	Service string
+
+	// Datacenter is the datacenter the instances were found in, which is not
+	// the queried datacenter if the query failed over.
+	Datacenter string
+
+	// Failovers is how many other datacenters were queried before finding
+	// healthy instances.
+	Failovers int
+
+	// DNSTTL is the TTL of DNS responses to the query, if set.
+	DNSTTL time.Duration
+
+	// Instances are the healthy instances of the service, in the same form
+	// as returned by Catalog.Service.
+	Instances []Instance
+
+	// Checks are the health checks of each instance, such that Checks[i]
+	// are the checks of Instances[i].
+	Checks [][]HealthCheck
+}
+
+// Status returns the worst status among the checks of Instances[i].
+func (r PreparedQueryResult) Status(i int) CheckStatus {
+	return worstStatus(r.Checks[i])
+}
+
+type preparedQueryResultFormat struct {
+	Service    string                    `json:"Service"`
+	Nodes      []preparedQueryNodeFormat `json:"Nodes"`
+	DNS        *preparedQueryDNS         `json:"DNS"`
+	Datacenter string                    `json:"Datacenter"`
+	Failovers  int                       `json:"Failovers"`
+}
+
+type preparedQueryNodeFormat struct {
+	Node struct {
+		ID              string            `json:"ID"`
+		Node            string            `json:"Node"`
+		Address         string            `json:"Address"`
+		Datacenter      string            `json:"Datacenter"`
+		TaggedAddresses map[string]string `json:"TaggedAddresses"`
+		Meta            map[string]string `json:"Meta"`
+	} `json:"Node"`
+	Service AgentService  `json:"Service"`
+	Checks  []HealthCheck `json:"Checks"`
+}
+
+func (format preparedQueryResultFormat) result() (PreparedQueryResult, error) {
+	ttl, err := format.DNS.ttl()
+	if err != nil {
+		return PreparedQueryResult{}, err
+	}
+
+	result := PreparedQueryResult{
+		Service:    format.Service,
+		Datacenter: format.Datacenter,
+		Failovers:  format.Failovers,
+		DNSTTL:     ttl,
+		Instances:  make([]Instance, 0, len(format.Nodes)),
+		Checks:     make([][]HealthCheck, 0, len(format.Nodes)),
+	}
+
+	for _, node := range format.Nodes {
+		result.Instances = append(result.Instances, Instance{
+			ID:                       node.Node.ID,
+			Node:                     node.Node.Node,
+			Address:                  node.Node.Address,
+			Datacenter:               node.Node.Datacenter,
+			TaggedAddresses:          node.Node.TaggedAddresses,
+			NodeMeta:                 node.Node.Meta,
+			ServiceAddress:           node.Service.Address,
+			ServiceEnableTagOverride: node.Service.EnableTagOverride,
+			ServiceID:                node.Service.ID,
+			ServiceName:              node.Service.Name,
+			ServicePort:              node.Service.Port,
+			ServiceMeta:              node.Service.Meta,
+			ServiceTaggedAddresses:   node.Service.TaggedAddresses,
+			ServiceTags:              node.Service.Tags,
+			ServiceProxy:             node.Service.Proxy,
+			ServiceConnect:           node.Service.Connect,
+		})
+		result.Checks = append(result.Checks, node.Checks)
+	}
+
+	return result, nil
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PreparedQueries -s _mock.go
+
+// PreparedQueries is able to manage and execute the prepared queries of
+// consul, which are commonly used to fail over to the healthy instances of a
+// service in other datacenters.
+//
+// https://www.consul.io/api-docs/query
+type PreparedQueries interface {
+
+	// CreatePreparedQuery creates a new prepared query, and returns its ID.
+	//
+	// https://www.consul.io/api-docs/query#create-prepared-query
+	CreatePreparedQuery(Ctx, PreparedQuery, PreparedQueryOptions) (string, error)
+
+	// ListPreparedQueries lists every prepared query.
+	//
+	// https://www.consul.io/api-docs/query#read-prepared-query
+	ListPreparedQueries(Ctx, PreparedQueryOptions) ([]PreparedQuery, error)
+
+	// ReadPreparedQuery returns the prepared query of id.
+	//
+	// https://www.consul.io/api-docs/query#read-prepared-query-1
+	ReadPreparedQuery(ctx Ctx, id string, opts PreparedQueryOptions) (PreparedQuery, error)
+
+	// UpdatePreparedQuery replaces the prepared query of the ID of query.
+	//
+	// https://www.consul.io/api-docs/query#update-prepared-query
+	UpdatePreparedQuery(Ctx, PreparedQuery, PreparedQueryOptions) error
+
+	// DeletePreparedQuery deletes the prepared query of id.
+	//
+	// https://www.consul.io/api-docs/query#delete-prepared-query
+	DeletePreparedQuery(ctx Ctx, id string, opts PreparedQueryOptions) error
+
+	// ExecutePreparedQuery executes the prepared query of the ID or name,
+	// and returns the healthy instances of the service, along with their
+	// health checks. A name which matches no query may still be executed
+	// by a template.
+	//
+	// https://www.consul.io/api-docs/query#execute-prepared-query
+	ExecutePreparedQuery(ctx Ctx, idOrName string, execution PreparedQueryExecution) (PreparedQueryResult, error)
+
+	// ExplainPreparedQuery returns the prepared query which would be executed
+	// for the ID or name, with any template applied.
+	//
+	// https://www.consul.io/api-docs/query#explain-prepared-query
+	ExplainPreparedQuery(ctx Ctx, idOrName string, opts PreparedQueryOptions) (PreparedQuery, error)
+}
+
+// An assertion that client satisfies PreparedQueries
+var _ PreparedQueries = (*client)(nil)
+
+func preparedQueryPath(path string, dc string, params ...[2]string) string {
+	return fixup("/v1", path, append(params, param("dc", dc))...)
+}
+
+func (c *client) CreatePreparedQuery(ctx Ctx, query PreparedQuery, opts PreparedQueryOptions) (string, error) {
+	if query.Service.Service == "" {
+		return "", errors.New("prepared query service required")
+	}
+
+	bs, err := json.Marshal(internalizePreparedQuery(query))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create prepared query payload")
+	}
+
+	var created struct {
+		ID string `json:"ID"`
+	}
+	path := preparedQueryPath("query", opts.DC)
+	if err := c.post(withToken(ctx, opts.Token), path, string(bs), &created); err != nil {
+		return "", errors.Wrap(err, "failed to create prepared query")
+	}
+	return created.ID, nil
+}
+
+func (c *client) ListPreparedQueries(ctx Ctx, opts PreparedQueryOptions) ([]PreparedQuery, error) {
+	queries, err := c.readPreparedQueries(ctx, preparedQueryPath("query", opts.DC), opts.Token)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list prepared queries")
+	}
+	return queries, nil
+}
+
+func (c *client) ReadPreparedQuery(ctx Ctx, id string, opts PreparedQueryOptions) (PreparedQuery, error) {
+	if id == "" {
+		return PreparedQuery{}, errors.New("prepared query id required")
+	}
+
+	queries, err := c.readPreparedQueries(ctx, preparedQueryPath("query/"+id, opts.DC), opts.Token)
+	if err != nil {
+		return PreparedQuery{}, errors.Wrap(err, "failed to read prepared query")
+	}
+	if len(queries) != 1 {
+		return PreparedQuery{}, errors.Errorf("expected 1 prepared query, got %d", len(queries))
+	}
+	return queries[0], nil
+}
+
+// readPreparedQueries reads the list of prepared queries of path, which is
+// also how consul returns a single prepared query.
+func (c *client) readPreparedQueries(ctx Ctx, path, token string) ([]PreparedQuery, error) {
+	formats := make([]preparedQueryFormat, 0, 10)
+	if err := c.get(withToken(ctx, token), path, &formats); err != nil {
+		return nil, err
+	}
+
+	queries := make([]PreparedQuery, 0, len(formats))
+	for _, format := range formats {
+		query, err := format.preparedQuery()
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, query)
+	}
+	return queries, nil
+}
+
+func (c *client) UpdatePreparedQuery(ctx Ctx, query PreparedQuery, opts PreparedQueryOptions) error {
+	if query.ID == "" {
+		return errors.New("prepared query id required")
+	}
+
+	bs, err := json.Marshal(internalizePreparedQuery(query))
+	if err != nil {
+		return errors.Wrap(err, "unable to create prepared query payload")
+	}
+
+	path := preparedQueryPath("query/"+query.ID, opts.DC)
+	if err := c.put(withToken(ctx, opts.Token), path, string(bs), nil); err != nil {
+		return errors.Wrap(err, "failed to update prepared query")
+	}
+	return nil
+}
+
+func (c *client) DeletePreparedQuery(ctx Ctx, id string, opts PreparedQueryOptions) error {
+	if id == "" {
+		return errors.New("prepared query id required")
+	}
+
+	if err := c.delete(withToken(ctx, opts.Token), preparedQueryPath("query/"+id, opts.DC)); err != nil {
+		return errors.Wrap(err, "failed to delete prepared query")
+	}
+	return nil
+}
+
+func (c *client) ExecutePreparedQuery(ctx Ctx, idOrName string, execution PreparedQueryExecution) (PreparedQueryResult, error) {
+	if idOrName == "" {
+		return PreparedQueryResult{}, errors.New("prepared query id or name required")
+	}
+
+	params := [][2]string{param("near", execution.Near)}
+	if execution.Limit > 0 {
+		params = append(params, param("limit", strconv.Itoa(execution.Limit)))
+	}
+	if execution.Connect {
+		params = append(params, param("connect", "true"))
+	}
+
+	path := preparedQueryPath("query/"+idOrName+"/execute", execution.DC, params...)
+
+	var format preparedQueryResultFormat
+	if err := c.get(withToken(ctx, execution.Token), path, &format); err != nil {
+		return PreparedQueryResult{}, errors.Wrap(err, "failed to execute prepared query")
+	}
+	return format.result()
+}
+
+func (c *client) ExplainPreparedQuery(ctx Ctx, idOrName string, opts PreparedQueryOptions) (PreparedQuery, error) {
+	if idOrName == "" {
+		return PreparedQuery{}, errors.New("prepared query id or name required")
+	}
+
+	var explained struct {
+		Query preparedQueryFormat `json:"Query"`
+	}
+	path := preparedQueryPath("query/"+idOrName+"/explain", opts.DC)
+	if err := c.get(withToken(ctx, opts.Token), path, &explained); err != nil {
+		return PreparedQuery{}, errors.Wrap(err, "failed to explain prepared query")
+	}
+	return explained.Query.preparedQuery()
+}
@@ -0,0 +1,258 @@
+package consulapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PreparedQueries_crud(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `{"ID":"8f246b77-f3e1-ff88-5b48-8ec93abf3e05"}`,
+		hasPath:   "/v1/query",
+		hasMethod: http.MethodPost,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"Name":"postgres","Service":{"Service":"postgres",` +
+			`"Failover":{"NearestN":2,"Datacenters":["dc2","dc3"]},` +
+			`"OnlyPassing":true,"Near":"_agent","Tags":["primary","!experimental"],` +
+			`"NodeMeta":{"instance_type":"m5.large"},"ServiceMeta":{"version":"14"}},` +
+			`"DNS":{"TTL":"10s"}}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_query.json"),
+		hasPath:   "/v1/query/8f246b77-f3e1-ff88-5b48-8ec93abf3e05",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc": {"dc1"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/query/8f246b77-f3e1-ff88-5b48-8ec93abf3e05",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `{"ID":"8f246b77-f3e1-ff88-5b48-8ec93abf3e05","Name":"postgres","Token":"\u003chidden\u003e",` +
+			`"Service":{"Service":"postgres","Failover":{"NearestN":3,"Datacenters":["dc2","dc3"]},` +
+			`"OnlyPassing":true,"Near":"_agent","Tags":["primary","!experimental"],` +
+			`"NodeMeta":{"instance_type":"m5.large"},"ServiceMeta":{"version":"14"}},` +
+			`"DNS":{"TTL":"10s"}}`,
+		hasHeaders: map[string]string{
+			consulTokenHeader: "b1f4c10e-b61b-e1de-de95-218c9fefdd3e",
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "",
+		hasPath:   "/v1/query/8f246b77-f3e1-ff88-5b48-8ec93abf3e05",
+		hasMethod: http.MethodDelete,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	query := PreparedQuery{
+		Name: "postgres",
+		Service: PreparedQueryService{
+			Service: "postgres",
+			Failover: PreparedQueryFailover{
+				NearestN:    2,
+				Datacenters: []string{"dc2", "dc3"},
+			},
+			OnlyPassing: true,
+			Near:        "_agent",
+			Tags:        []string{"primary", "!experimental"},
+			NodeMeta:    map[string]string{"instance_type": "m5.large"},
+			ServiceMeta: map[string]string{"version": "14"},
+		},
+		DNSTTL: 10 * time.Second,
+	}
+
+	id, err := client.CreatePreparedQuery(ctx, query, PreparedQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "8f246b77-f3e1-ff88-5b48-8ec93abf3e05", id)
+
+	read, err := client.ReadPreparedQuery(ctx, id, PreparedQueryOptions{DC: "dc1"})
+	require.NoError(t, err)
+	query.ID = id
+	query.Token = "<hidden>"
+	query.CreateIndex = 23
+	query.ModifyIndex = 42
+	require.Equal(t, query, read)
+
+	read.Service.Failover.NearestN = 3
+	err = client.UpdatePreparedQuery(ctx, read, PreparedQueryOptions{
+		Token: "b1f4c10e-b61b-e1de-de95-218c9fefdd3e",
+	})
+	require.NoError(t, err)
+
+	err = client.DeletePreparedQuery(ctx, id, PreparedQueryOptions{})
+	require.NoError(t, err)
+}
+
+func Test_PreparedQueries_required(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t})
+	defer ts.Close()
+
+	_, err := client.CreatePreparedQuery(ctx, PreparedQuery{Name: "postgres"}, PreparedQueryOptions{})
+	require.EqualError(t, err, "prepared query service required")
+
+	_, err = client.ReadPreparedQuery(ctx, "", PreparedQueryOptions{})
+	require.EqualError(t, err, "prepared query id required")
+
+	err = client.UpdatePreparedQuery(ctx, PreparedQuery{}, PreparedQueryOptions{})
+	require.EqualError(t, err, "prepared query id required")
+
+	err = client.DeletePreparedQuery(ctx, "", PreparedQueryOptions{})
+	require.EqualError(t, err, "prepared query id required")
+
+	_, err = client.ExecutePreparedQuery(ctx, "", PreparedQueryExecution{})
+	require.EqualError(t, err, "prepared query id or name required")
+
+	_, err = client.ExplainPreparedQuery(ctx, "", PreparedQueryOptions{})
+	require.EqualError(t, err, "prepared query id or name required")
+}
+
+func Test_PreparedQueries_template(t *testing.T) {
+	ctx, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `{"ID":"5e1e24e5-1329-f86f-18c6-3d3734edb2cd"}`,
+		hasPath:   "/v1/query",
+		hasMethod: http.MethodPost,
+		hasQuery: map[string][]string{
+			"dc": {"dc1"},
+		},
+		hasBody: `{"Name":"geo-db",` +
+			`"Template":{"Type":"name_prefix_match","Regexp":"^geo-db-(.*?)-([^\\-]+?)$","RemoveEmptyTags":true},` +
+			`"Service":{"Service":"mysql-${match(1)}",` +
+			`"Failover":{"Targets":[{"Datacenter":"dc2"},{"Peer":"cluster-01"}]},` +
+			`"IgnoreCheckIDs":["service:mysql-maint"],"Tags":["${match(2)}"]}}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_query-template.json"),
+		hasPath:   "/v1/query",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_query_explain.json"),
+		hasPath:   "/v1/query/geo-db-customer-primary/explain",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	template := PreparedQuery{
+		Name: "geo-db",
+		Template: PreparedQueryTemplate{
+			Type:            PreparedQueryNamePrefixMatch,
+			Regexp:          `^geo-db-(.*?)-([^\-]+?)$`,
+			RemoveEmptyTags: true,
+		},
+		Service: PreparedQueryService{
+			Service: "mysql-${match(1)}",
+			Failover: PreparedQueryFailover{
+				Targets: []PreparedQueryFailoverTarget{
+					{Datacenter: "dc2"},
+					{Peer: "cluster-01"},
+				},
+			},
+			IgnoreCheckIDs: []string{"service:mysql-maint"},
+			Tags:           []string{"${match(2)}"},
+		},
+	}
+
+	id, err := client.CreatePreparedQuery(ctx, template, PreparedQueryOptions{DC: "dc1"})
+	require.NoError(t, err)
+	require.Equal(t, "5e1e24e5-1329-f86f-18c6-3d3734edb2cd", id)
+
+	queries, err := client.ListPreparedQueries(ctx, PreparedQueryOptions{})
+	require.NoError(t, err)
+	require.Len(t, queries, 1)
+	template.ID = id
+	template.CreateIndex = 17
+	template.ModifyIndex = 17
+	require.Equal(t, template, queries[0])
+
+	explained, err := client.ExplainPreparedQuery(ctx, "geo-db-customer-primary", PreparedQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "mysql-customer", explained.Service.Service)
+	require.Equal(t, []string{"primary"}, explained.Service.Tags)
+}
+
+func Test_PreparedQueries_ExecutePreparedQuery(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_query_execute.json"),
+		hasPath:   "/v1/query/postgres/execute",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"dc":      {"dc1"},
+			"near":    {"_agent"},
+			"limit":   {"2"},
+			"connect": {"true"},
+		},
+	})
+	defer ts.Close()
+
+	result, err := client.ExecutePreparedQuery(ctx, "postgres", PreparedQueryExecution{
+		DC:      "dc1",
+		Near:    "_agent",
+		Limit:   2,
+		Connect: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "postgres", result.Service)
+	require.Equal(t, "dc2", result.Datacenter)
+	require.Equal(t, 1, result.Failovers)
+	require.Equal(t, 10*time.Second, result.DNSTTL)
+
+	require.Len(t, result.Instances, 2)
+	require.Equal(t, Instance{
+		ID:         "40e4a748-2192-161a-0510-9bf59fe950b5",
+		Node:       "db-1",
+		Address:    "10.1.10.12",
+		Datacenter: "dc2",
+		TaggedAddresses: map[string]string{
+			"lan": "10.1.10.12",
+			"wan": "10.1.10.12",
+		},
+		NodeMeta:       map[string]string{"instance_type": "m5.large"},
+		ServiceAddress: "10.1.10.12",
+		ServiceID:      "postgres-1",
+		ServiceName:    "postgres",
+		ServicePort:    5432,
+		ServiceMeta:    map[string]string{"version": "14"},
+		ServiceTags:    []string{"primary"},
+	}, result.Instances[0])
+	require.Equal(t, "db-2", result.Instances[1].Node)
+
+	require.Len(t, result.Checks, 2)
+	require.Len(t, result.Checks[0], 2)
+	require.Equal(t, CheckWarning, result.Status(0))
+	require.Equal(t, CheckPassing, result.Status(1))
+}
+
+func Test_PreparedQueries_ExecutePreparedQuery_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusNotFound,
+		body:      "Query not found",
+		hasPath:   "/v1/query/missing/execute",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	_, err := client.ExecutePreparedQuery(ctx, "missing", PreparedQueryExecution{})
+	require.EqualError(t, err, "failed to execute prepared query: GET /v1/query/missing/execute: status code (404): Query not found")
+	require.True(t, IsNotFound(err))
+}
@@ -0,0 +1,100 @@
+package consulapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	headerIndex       = "X-Consul-Index"
+	headerKnownLeader = "X-Consul-KnownLeader"
+	headerLastContact = "X-Consul-LastContact"
+
+	// defaultWaitTime is how long consul will hold open a blocking query
+	// if no wait time is specified.
+	defaultWaitTime = 5 * time.Minute
+)
+
+// QueryMeta contains the metadata consul returns alongside the result of a
+// read. The LastIndex is what makes it possible to issue a follow-up blocking
+// query, which will only return once the underlying data has changed.
+//
+// https://www.consul.io/api/features/blocking.html
+type QueryMeta struct {
+	// LastIndex is the value of the X-Consul-Index header, which identifies
+	// the current state of the data that was read. Use it as the WaitIndex of
+	// a subsequent query to block until the data changes.
+	LastIndex uint64
+
+	// LastContact is the amount of time since the server answering the
+	// request last had contact with the leader. Always zero for consistent
+	// reads.
+	LastContact time.Duration
+
+	// KnownLeader indicates whether the cluster had a known leader at the
+	// time the request was answered.
+	KnownLeader bool
+}
+
+func parseQueryMeta(header http.Header) (QueryMeta, error) {
+	var meta QueryMeta
+
+	if index := header.Get(headerIndex); index != "" {
+		n, err := strconv.ParseUint(index, 10, 64)
+		if err != nil {
+			return QueryMeta{}, errors.Wrapf(err, "failed to parse %s", headerIndex)
+		}
+		meta.LastIndex = n
+	}
+
+	if contact := header.Get(headerLastContact); contact != "" {
+		ms, err := strconv.ParseUint(contact, 10, 64)
+		if err != nil {
+			return QueryMeta{}, errors.Wrapf(err, "failed to parse %s", headerLastContact)
+		}
+		meta.LastContact = time.Duration(ms) * time.Millisecond
+	}
+
+	if known := header.Get(headerKnownLeader); known != "" {
+		b, err := strconv.ParseBool(known)
+		if err != nil {
+			return QueryMeta{}, errors.Wrapf(err, "failed to parse %s", headerKnownLeader)
+		}
+		meta.KnownLeader = b
+	}
+
+	return meta, nil
+}
+
+// blocking returns the url params needed to turn a read into a blocking query,
+// along with the longest amount of time consul may keep the request open. If
+// index is zero the read is not a blocking query, and no params are returned.
+func blocking(index uint64, wait time.Duration) ([][2]string, time.Duration) {
+	if index == 0 {
+		return nil, 0
+	}
+
+	params := [][2]string{
+		{"index", strconv.FormatUint(index, 10)},
+	}
+
+	if wait > 0 {
+		params = append(params, [2]string{"wait", durationToMS(wait)})
+	} else {
+		wait = defaultWaitTime
+	}
+
+	// consul adds up to wait/16 of jitter to the wait time
+	return params, wait + wait/16
+}
+
+func durationToMS(d time.Duration) string {
+	ms := d / time.Millisecond
+	if d > 0 && ms == 0 {
+		ms = 1
+	}
+	return strconv.FormatInt(int64(ms), 10) + "ms"
+}
@@ -0,0 +1,52 @@
+package consulapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseQueryMeta(t *testing.T) {
+	header := make(http.Header)
+	header.Set("X-Consul-Index", "9001")
+	header.Set("X-Consul-KnownLeader", "false")
+	header.Set("X-Consul-LastContact", "150")
+
+	meta, err := parseQueryMeta(header)
+	require.NoError(t, err)
+	require.Equal(t, QueryMeta{
+		LastIndex:   9001,
+		LastContact: 150 * time.Millisecond,
+		KnownLeader: false,
+	}, meta)
+}
+
+func Test_parseQueryMeta_empty(t *testing.T) {
+	meta, err := parseQueryMeta(make(http.Header))
+	require.NoError(t, err)
+	require.Equal(t, QueryMeta{}, meta)
+}
+
+func Test_parseQueryMeta_bad_index(t *testing.T) {
+	header := make(http.Header)
+	header.Set("X-Consul-Index", "abc")
+
+	_, err := parseQueryMeta(header)
+	require.Error(t, err)
+}
+
+func Test_blocking(t *testing.T) {
+	params, wait := blocking(0, 10*time.Second)
+	require.Empty(t, params)
+	require.Zero(t, wait)
+
+	params, wait = blocking(5, 0)
+	require.Equal(t, [][2]string{{"index", "5"}}, params)
+	require.Equal(t, defaultWaitTime+defaultWaitTime/16, wait)
+
+	params, wait = blocking(5, 16*time.Second)
+	require.Equal(t, [][2]string{{"index", "5"}, {"wait", "16000ms"}}, params)
+	require.Equal(t, 17*time.Second, wait)
+}
@@ -0,0 +1,196 @@
+package consulapi
+
+import (
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 250 * time.Millisecond
+	defaultRetryMaxBackoff  = 10 * time.Second
+)
+
+// A RetryAttempt describes a failed attempt at making a request, which a
+// RetryPolicy uses to decide whether to try again.
+type RetryAttempt struct {
+	// Number is the number of attempts made so far, starting at 1.
+	Number int
+
+	// Method is the http method of the request.
+	Method string
+
+	// Path is the path and query of the request.
+	Path string
+
+	// Idempotent indicates whether the request is safe to repeat, i.e. making
+	// the request again has the same effect as making it once. Requests which
+	// are not idempotent include creating a session or an ACL object,
+	// acquiring or releasing a lock, check-and-set writes and transactions.
+	Idempotent bool
+
+	// StatusCode is the status code of the response, or 0 if no response was
+	// received.
+	StatusCode int
+
+	// RetryAfter is the value of the Retry-After header of the response, if
+	// any.
+	RetryAfter time.Duration
+
+	// Err is the error making the request, e.g. a connection error, if no
+	// response was received.
+	Err error
+}
+
+// A RetryPolicy decides whether a failed request is tried again.
+type RetryPolicy interface {
+	// Retry returns how long to wait before trying the request of attempt
+	// again, and false if the request should not be tried again.
+	Retry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy is a RetryPolicy which retries idempotent requests that
+// failed because of a connection error, or a response with status code 429
+// (too many requests) or 5xx, waiting exponentially longer between attempts.
+type BackoffRetryPolicy struct {
+	// MaxAttempts (optional) limits the number of attempts at each request,
+	// including the first. If not set, MaxAttempts defaults to 3.
+	MaxAttempts int
+
+	// MinBackoff (optional) is how long to wait after the first failed
+	// attempt. The wait doubles after each further failed attempt, up to
+	// MaxBackoff. If not set, MinBackoff defaults to 250 milliseconds.
+	MinBackoff time.Duration
+
+	// MaxBackoff (optional) limits how long to wait between attempts. If the
+	// server asks for a longer wait using the Retry-After header, the request
+	// is not tried again. If not set, MaxBackoff defaults to 10 seconds.
+	MaxBackoff time.Duration
+
+	// Jitter (optional) is the fraction by which each wait is randomly
+	// lengthened or shortened, e.g. a Jitter of 0.1 varies each wait by up to
+	// 10%. If not set, waits are not randomized.
+	Jitter float64
+
+	// RetryNonIdempotent (optional) makes requests which are not safe to repeat
+	// be tried again as well. Only set this if the application can cope with
+	// such a request taking effect more than once.
+	RetryNonIdempotent bool
+}
+
+func (p BackoffRetryPolicy) Retry(attempt RetryAttempt) (time.Duration, bool) {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultRetryMaxAttempts
+	}
+
+	min := p.MinBackoff
+	if min <= 0 {
+		min = defaultRetryMinBackoff
+	}
+
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+
+	switch {
+	case attempt.Number >= maxAttempts:
+		return 0, false
+	case !attempt.Idempotent && !p.RetryNonIdempotent:
+		return 0, false
+	case attempt.Err == nil && !retryableStatus(attempt.StatusCode):
+		return 0, false
+	case attempt.RetryAfter > max:
+		return 0, false
+	}
+
+	wait := backoff(min, max, attempt.Number)
+	if p.Jitter > 0 {
+		wait += time.Duration((2*rand.Float64() - 1) * p.Jitter * float64(wait))
+	}
+
+	if attempt.RetryAfter > wait {
+		wait = attempt.RetryAfter
+	}
+
+	return wait, true
+}
+
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds, or a http date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// idempotent indicates whether a request using method on path is safe to
+// repeat.
+func idempotent(method, path string) bool {
+	u, err := url.Parse(path)
+	if err != nil {
+		return false
+	}
+
+	query := u.Query()
+
+	switch method {
+	case http.MethodGet:
+		return true
+
+	case http.MethodDelete:
+		return query.Get("cas") == ""
+
+	case http.MethodPut:
+		switch {
+		case strings.HasPrefix(u.Path, "/v1/session/create"),
+			strings.HasPrefix(u.Path, "/v1/txn"),
+			aclCreate(u.Path):
+			return false
+		case query.Get("cas") != "",
+			query.Get("acquire") != "",
+			query.Get("release") != "":
+			return false
+		}
+		return true
+	}
+
+	return false
+}
+
+// aclCreate indicates whether path creates a new ACL object, which happens
+// again every time the request is repeated.
+func aclCreate(path string) bool {
+	switch path {
+	case "/v1/acl/bootstrap",
+		"/v1/acl/token",
+		"/v1/acl/policy",
+		"/v1/acl/role",
+		"/v1/acl/binding-rule",
+		"/v1/acl/auth-method":
+		return true
+	}
+	return strings.HasPrefix(path, "/v1/acl/token/") && strings.HasSuffix(path, "/clone")
+}
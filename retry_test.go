@@ -0,0 +1,242 @@
+package consulapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+func retryingClient(h http.Handler, policy RetryPolicy) (*httptest.Server, Client) {
+	ts := httptest.NewServer(h)
+	return ts, New(ClientOptions{
+		Address:     ts.URL,
+		Logger:      loggy.New("test-client"),
+		RetryPolicy: policy,
+	})
+}
+
+func Test_BackoffRetryPolicy_Retry(t *testing.T) {
+	policy := BackoffRetryPolicy{}
+
+	try := func(attempt RetryAttempt) (time.Duration, bool) {
+		attempt.Idempotent = true
+		return policy.Retry(attempt)
+	}
+
+	// connection errors, 5xx and 429 are retried
+	wait, retry := try(RetryAttempt{Number: 1, Err: errors.New("connection refused")})
+	require.True(t, retry)
+	require.Equal(t, 250*time.Millisecond, wait)
+
+	wait, retry = try(RetryAttempt{Number: 2, StatusCode: http.StatusServiceUnavailable})
+	require.True(t, retry)
+	require.Equal(t, 500*time.Millisecond, wait)
+
+	_, retry = try(RetryAttempt{Number: 1, StatusCode: http.StatusTooManyRequests})
+	require.True(t, retry)
+
+	// other errors are not
+	_, retry = try(RetryAttempt{Number: 1, StatusCode: http.StatusNotFound})
+	require.False(t, retry)
+
+	// up to the max attempts
+	_, retry = try(RetryAttempt{Number: 3, StatusCode: http.StatusInternalServerError})
+	require.False(t, retry)
+
+	// the server may ask for a longer wait, but not longer than the max
+	wait, retry = try(RetryAttempt{Number: 1, StatusCode: http.StatusTooManyRequests, RetryAfter: 2 * time.Second})
+	require.True(t, retry)
+	require.Equal(t, 2*time.Second, wait)
+
+	_, retry = try(RetryAttempt{Number: 1, StatusCode: http.StatusTooManyRequests, RetryAfter: time.Minute})
+	require.False(t, retry)
+
+	// requests which are not idempotent are not retried, unless allowed
+	_, retry = policy.Retry(RetryAttempt{Number: 1, Err: errors.New("connection refused")})
+	require.False(t, retry)
+
+	policy.RetryNonIdempotent = true
+	_, retry = policy.Retry(RetryAttempt{Number: 1, Err: errors.New("connection refused")})
+	require.True(t, retry)
+
+	policy = BackoffRetryPolicy{
+		MaxAttempts: 10,
+		MinBackoff:  100 * time.Millisecond,
+		MaxBackoff:  300 * time.Millisecond,
+		Jitter:      0.5,
+	}
+	for i := 0; i < 100; i++ {
+		wait, retry = try(RetryAttempt{Number: 9, StatusCode: http.StatusBadGateway})
+		require.True(t, retry)
+		require.True(t, wait >= 150*time.Millisecond && wait <= 450*time.Millisecond, "wait: %v", wait)
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+	require.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	require.Equal(t, time.Duration(0), parseRetryAfter("-3", now))
+	require.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	require.Equal(t, 3*time.Second, parseRetryAfter("3", now))
+	require.Equal(t, 10*time.Second, parseRetryAfter("Wed, 04 Mar 2020 05:06:17 GMT", now))
+	require.Equal(t, time.Duration(0), parseRetryAfter("Wed, 04 Mar 2020 05:06:00 GMT", now))
+}
+
+func Test_idempotent(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		exp    bool
+	}{
+		{method: http.MethodGet, path: "/v1/kv/a?index=3", exp: true},
+		{method: http.MethodDelete, path: "/v1/kv/a", exp: true},
+		{method: http.MethodDelete, path: "/v1/kv/a?cas=3", exp: false},
+		{method: http.MethodPut, path: "/v1/kv/a", exp: true},
+		{method: http.MethodPut, path: "/v1/kv/a?flags=1", exp: true},
+		{method: http.MethodPut, path: "/v1/kv/a?cas=3", exp: false},
+		{method: http.MethodPut, path: "/v1/kv/a?acquire=abc123", exp: false},
+		{method: http.MethodPut, path: "/v1/kv/a?release=abc123", exp: false},
+		{method: http.MethodPut, path: "/v1/session/create?dc=dc2", exp: false},
+		{method: http.MethodPut, path: "/v1/session/renew/abc123", exp: true},
+		{method: http.MethodPut, path: "/v1/txn", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/bootstrap", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/token?dc=dc2", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/token/6a1253d2/clone", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/token/6a1253d2", exp: true},
+		{method: http.MethodPut, path: "/v1/acl/policy", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/policy/e359bd81", exp: true},
+		{method: http.MethodPut, path: "/v1/acl/role", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/binding-rule", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/auth-method", exp: false},
+		{method: http.MethodPut, path: "/v1/acl/auth-method/minikube", exp: true},
+		{method: http.MethodPost, path: "/v1/kv/a", exp: false},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.exp, idempotent(test.method, test.path), "%s %s", test.method, test.path)
+	}
+}
+
+func Test_Client_retry(t *testing.T) {
+	ts, client := retryingClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusServiceUnavailable,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusTooManyRequests,
+		headers:   map[string]string{headerRetryAfter: "0"},
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		// a lock acquisition is not safe to repeat
+		t:         t,
+		code:      http.StatusServiceUnavailable,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"acquire": {"abc123"},
+		},
+		hasBody: "2",
+	}}}, BackoffRetryPolicy{
+		MinBackoff: 1 * time.Millisecond,
+	})
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	value, _, err := client.Get(ctx, "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+
+	_, err = client.Write(ctx, "config/a", "2", WriteQuery{Acquire: "abc123"})
+	require.EqualError(t, err, "PUT /v1/kv/config/a?acquire=abc123: status code (503)")
+}
+
+func Test_Client_retry_exhausted(t *testing.T) {
+	unavailable := &responder{
+		t:         t,
+		code:      http.StatusServiceUnavailable,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodDelete,
+		hasQuery:  map[string][]string{},
+	}
+
+	ts, client := retryingClient(&sequence{t: t, responders: []*responder{
+		unavailable, unavailable,
+	}}, BackoffRetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  1 * time.Millisecond,
+	})
+	defer ts.Close()
+
+	err := client.Delete(context.Background(), "config/a", Query{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "status code (503)")
+}
+
+func Test_Client_retry_acl_create(t *testing.T) {
+	// creating an acl object is not safe to repeat, nor to fail over
+	unavailable := httptest.NewServer(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusServiceUnavailable,
+		hasPath:   "/v1/acl/token",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{"Description":"web"}`,
+	}, {
+		t:         t,
+		code:      http.StatusServiceUnavailable,
+		hasPath:   "/v1/acl/token/6a1253d2-1785-24fd-91c2-f8e78c745511/clone",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `{}`,
+	}, {
+		t:         t,
+		code:      http.StatusServiceUnavailable,
+		hasPath:   "/v1/acl/bootstrap",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer unavailable.Close()
+
+	up := httptest.NewServer(&sequence{t: t})
+	defer up.Close()
+
+	// the unavailable address is re-admitted before each request
+	client := New(ClientOptions{
+		Address:        unavailable.URL,
+		Addresses:      []string{up.URL},
+		EjectionPeriod: 1 * time.Nanosecond,
+		Logger:         loggy.New("test-client"),
+		RetryPolicy:    BackoffRetryPolicy{MinBackoff: 1 * time.Millisecond},
+	})
+
+	ctx := context.Background()
+
+	_, err := client.CreateACLToken(ctx, ACLToken{Description: "web"}, ACLQuery{})
+	require.EqualError(t, err, "failed to create acl token: PUT /v1/acl/token: status code (503)")
+
+	_, err = client.CloneACLToken(ctx, "6a1253d2-1785-24fd-91c2-f8e78c745511", "", ACLQuery{})
+	require.EqualError(t, err, "failed to clone acl token: PUT /v1/acl/token/6a1253d2-1785-24fd-91c2-f8e78c745511/clone: status code (503)")
+
+	_, err = client.ACLBootstrap(ctx, ACLQuery{})
+	require.EqualError(t, err, "failed to bootstrap acls: PUT /v1/acl/bootstrap: status code (503)")
+}
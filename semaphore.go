@@ -0,0 +1,485 @@
+package consulapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// semaphoreLockKey is the name of the key under the prefix of a semaphore
+	// which tracks the current holders.
+	semaphoreLockKey = ".lock"
+
+	// semaphoreFlags marks the keys of a semaphore, and is the same value used
+	// by consul itself, so that the semaphore may be shared with processes
+	// using the consul lock command.
+	semaphoreFlags = 0xe0f69a2baa414de0
+)
+
+// SemaphoreConfig is used to configure a Semaphore.
+type SemaphoreConfig struct {
+	// Prefix is the path in the consul KV store under which the keys of the
+	// semaphore are stored. This must be set. Typically this value will look
+	// something like "service/<service name>/semaphore".
+	Prefix string
+
+	// Limit is the number of holders which may hold the semaphore at the same
+	// time. This must be set, and must be the same for every contender.
+	Limit int
+
+	// Value (optional) is stored in the contender key of each holder. It can
+	// be used to indicate which process is holding the semaphore.
+	Value string
+
+	// DC (optional) indicates the datacenter of the semaphore.
+	//
+	// If blank, this will default to the datacenter that the queried agent is in.
+	DC string
+
+	// Session (optional) is an existing session to acquire the semaphore
+	// with. The caller is responsible for keeping the session alive, and
+	// destroying it.
+	//
+	// If not set, a new session is created by each call to Acquire. The
+	// session is renewed while it is in use, and destroyed by Release.
+	Session SessionID
+
+	// SessionName (optional) is the name of the session created by Acquire.
+	// If not set, SessionName defaults to "default-lock-session".
+	SessionName string
+
+	// Node (optional) is the node to associate the session created by Acquire
+	// with. If not set, the node of the queried agent is used.
+	Node string
+
+	// TTL (optional) is the TTL of the session created by Acquire. If not
+	// set, TTL defaults to 15 seconds. See SessionConfig.TTL.
+	TTL time.Duration
+
+	// LockDelay (optional) is the lock delay of the session created by
+	// Acquire. See SessionConfig.LockDelay.
+	LockDelay time.Duration
+
+	// RetryInterval (optional) is how long to wait before trying to acquire
+	// the semaphore again after an error. If not set, RetryInterval defaults
+	// to 5 seconds.
+	RetryInterval time.Duration
+}
+
+func (sc SemaphoreConfig) session() lockSession {
+	return lockSession{
+		existing:  sc.Session,
+		dc:        sc.DC,
+		node:      sc.Node,
+		name:      sc.SessionName,
+		ttl:       sc.TTL,
+		lockDelay: sc.LockDelay,
+	}
+}
+
+func (sc SemaphoreConfig) retry() time.Duration {
+	if sc.RetryInterval <= 0 {
+		return defaultLockRetry
+	}
+	return sc.RetryInterval
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Semaphore -s _mock.go
+
+// A Semaphore is a distributed semaphore, which may be held by up to a limited
+// number of holders at the same time.
+//
+// Each contender creates a key under the prefix of the semaphore, which is
+// acquired using its session. The holders of the semaphore are tracked in the
+// .lock key under the prefix, which is only updated using check-and-set.
+// Holders whose session is invalidated are removed by the other contenders.
+//
+// https://learn.hashicorp.com/tutorials/consul/distributed-semaphore
+type Semaphore interface {
+	// Acquire blocks until the semaphore is acquired, or until ctx is done.
+	// Once the semaphore is acquired, the returned channel is closed when the
+	// semaphore is lost, which happens if the session is invalidated, if the
+	// session is removed from the holders of the semaphore, or once Release
+	// is called. The critical section must be abandoned as soon as the
+	// channel is closed.
+	Acquire(Ctx) (<-chan struct{}, error)
+
+	// Release releases the semaphore, and destroys the session created by
+	// Acquire.
+	Release(Ctx) error
+}
+
+// semaphoreLock is the content of the .lock key of a semaphore, which is the
+// same format used by consul itself.
+type semaphoreLock struct {
+	Limit   int                `json:"Limit"`
+	Holders map[SessionID]bool `json:"Holders"`
+}
+
+type semaphore struct {
+	client *client
+	config SemaphoreConfig
+
+	mutex   sync.Mutex
+	held    bool
+	session SessionID
+	stop    context.CancelFunc
+	destroy func() error
+	lost    *signal
+}
+
+func (c *client) NewSemaphore(config SemaphoreConfig) (Semaphore, error) {
+	config.Prefix = strings.Trim(config.Prefix, "/")
+	if config.Prefix == "" {
+		return nil, errors.New("semaphore prefix required")
+	}
+
+	if config.Limit < 1 {
+		return nil, errors.New("semaphore limit must be at least 1")
+	}
+
+	return &semaphore{
+		client: c,
+		config: config,
+	}, nil
+}
+
+func (s *semaphore) lockKey() string {
+	return s.config.Prefix + "/" + semaphoreLockKey
+}
+
+func (s *semaphore) contenderKey(session SessionID) string {
+	return s.config.Prefix + "/" + string(session)
+}
+
+func (s *semaphore) Acquire(ctx Ctx) (<-chan struct{}, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.held {
+		return nil, errors.New("semaphore is already held")
+	}
+
+	lost := newSignal()
+
+	session, destroy, err := s.client.establishLockSession(ctx, s.config.session(), lost)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to acquire semaphore")
+	}
+
+	if err := s.acquire(ctx, session, lost); err != nil {
+		s.abandon(session, destroy)
+		return nil, errors.Wrap(err, "failed to acquire semaphore")
+	}
+
+	bgCtx, stop := context.WithCancel(context.Background())
+
+	s.held = true
+	s.session = session
+	s.stop = stop
+	s.destroy = destroy
+	s.lost = lost
+
+	go s.monitor(bgCtx, session, lost)
+
+	return lost.C(), nil
+}
+
+func (s *semaphore) Release(ctx Ctx) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.held {
+		return errors.New("semaphore is not held")
+	}
+
+	s.held = false
+	s.stop()
+	s.lost.fire()
+
+	// remove the session from the holders even if the semaphore was lost,
+	// in case it was lost because the session was invalidated
+	releaseErr := s.removeHolder(ctx, s.session)
+
+	if err := s.client.Delete(ctx, s.contenderKey(s.session), Query{
+		DC: s.config.DC,
+	}); err != nil && releaseErr == nil {
+		releaseErr = err
+	}
+
+	if err := s.destroy(); err != nil && releaseErr == nil {
+		return errors.Wrap(err, "failed to destroy semaphore session")
+	}
+
+	if releaseErr != nil {
+		return errors.Wrap(releaseErr, "failed to release semaphore")
+	}
+
+	return nil
+}
+
+// abandon cleans up after a failed attempt to acquire the semaphore
+func (s *semaphore) abandon(session SessionID, destroy func() error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	if err := s.client.Delete(ctx, s.contenderKey(session), Query{
+		DC: s.config.DC,
+	}); err != nil {
+		s.client.log.Warnf("failed to delete semaphore contender key for session %s: %v", session, err)
+	}
+
+	if err := destroy(); err != nil {
+		s.client.log.Warnf("failed to destroy semaphore session %s: %v", session, err)
+	}
+}
+
+// acquire creates the contender key of session, then blocks until session
+// is added to the holders of the semaphore.
+func (s *semaphore) acquire(ctx Ctx, session SessionID, lost *signal) error {
+	created, err := s.client.Write(ctx, s.contenderKey(session), s.config.Value, WriteQuery{
+		DC:      s.config.DC,
+		Flags:   semaphoreFlags,
+		Acquire: session,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !created {
+		return errors.New("failed to create semaphore contender key")
+	}
+
+	index := uint64(0)
+
+	for {
+		entries, meta, err := s.client.entries(ctx, s.config.Prefix, Query{
+			DC:        s.config.DC,
+			WaitIndex: index,
+		}, true)
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err == nil {
+			lockEntry, state, decodeErr := s.decode(entries)
+			if decodeErr != nil {
+				// the semaphore is misconfigured, which retrying cannot fix
+				return decodeErr
+			}
+
+			var full bool
+			full, err = s.tryAcquire(ctx, session, entries, lockEntry, state)
+			switch {
+			case ctx.Err() != nil:
+				return ctx.Err()
+			case err == nil && !full:
+				return nil
+			case err == nil && full:
+				// wait for a holder to leave
+				index = meta.LastIndex
+				if index < 1 {
+					index = 1
+				}
+				continue
+			}
+
+			if _, conflict := err.(casConflict); conflict {
+				// someone else updated the holders, try again now
+				index = 0
+				continue
+			}
+		}
+
+		s.client.log.Warnf("failed to acquire semaphore %s, try again in %v: %v", s.config.Prefix, s.config.retry(), err)
+		index = 0
+
+		select {
+		case <-time.After(s.config.retry()):
+		case <-lost.C():
+			return errors.New("session was invalidated")
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// casConflict indicates a check-and-set write of the .lock key was not applied
+type casConflict struct{}
+
+func (casConflict) Error() string {
+	return "semaphore lock was modified concurrently"
+}
+
+// tryAcquire tries to add session to the holders of the semaphore, given the
+// current entries under the prefix of the semaphore, and the decoded .lock
+// key. The returned bool indicates the semaphore is already at its limit.
+func (s *semaphore) tryAcquire(ctx Ctx, session SessionID, entries []KVEntry, lockEntry *KVEntry, state semaphoreLock) (bool, error) {
+	// prune holders which no longer hold their contender key
+	alive := make(map[SessionID]bool, len(entries))
+	for _, entry := range entries {
+		if entry.Session != "" && entry.Key == s.contenderKey(entry.Session) {
+			alive[entry.Session] = true
+		}
+	}
+	for holder := range state.Holders {
+		if !alive[holder] {
+			delete(state.Holders, holder)
+		}
+	}
+
+	if !state.Holders[session] && len(state.Holders) >= state.Limit {
+		return true, nil
+	}
+
+	state.Holders[session] = true
+
+	if err := s.write(ctx, lockEntry, state); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// decode finds the .lock key among entries, returning the entry (if it
+// exists) and its content.
+func (s *semaphore) decode(entries []KVEntry) (*KVEntry, semaphoreLock, error) {
+	for i := range entries {
+		if entries[i].Key != s.lockKey() {
+			continue
+		}
+
+		entry := &entries[i]
+		if entry.Flags != semaphoreFlags {
+			return nil, semaphoreLock{}, errors.Errorf("key %q is not a semaphore lock", entry.Key)
+		}
+
+		var state semaphoreLock
+		if err := json.Unmarshal([]byte(entry.Value), &state); err != nil {
+			return nil, semaphoreLock{}, errors.Wrap(err, "failed to decode semaphore lock")
+		}
+
+		if state.Limit != s.config.Limit {
+			return nil, semaphoreLock{}, errors.Errorf("semaphore limit conflict (lock: %d, local: %d)", state.Limit, s.config.Limit)
+		}
+
+		if state.Holders == nil {
+			state.Holders = make(map[SessionID]bool)
+		}
+
+		return entry, state, nil
+	}
+
+	return nil, semaphoreLock{
+		Limit:   s.config.Limit,
+		Holders: make(map[SessionID]bool),
+	}, nil
+}
+
+// write updates the .lock key using check-and-set, returning casConflict if
+// the key was modified since lockEntry was read. If lockEntry is nil, the
+// key is only created if it does not exist.
+func (s *semaphore) write(ctx Ctx, lockEntry *KVEntry, state semaphoreLock) error {
+	bs, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	modifyIndex := uint64(0)
+	if lockEntry != nil {
+		modifyIndex = lockEntry.ModifyIndex
+	}
+
+	applied, err := s.client.Write(ctx, s.lockKey(), string(bs), WriteQuery{
+		DC:          s.config.DC,
+		Flags:       semaphoreFlags,
+		CAS:         true,
+		ModifyIndex: modifyIndex,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !applied {
+		return casConflict{}
+	}
+
+	return nil
+}
+
+// removeHolder removes session from the holders of the semaphore, retrying
+// until the check-and-set write is applied.
+func (s *semaphore) removeHolder(ctx Ctx, session SessionID) error {
+	for {
+		entries, _, err := s.client.entries(ctx, s.lockKey(), Query{
+			DC: s.config.DC,
+		}, false)
+		if err != nil {
+			return err
+		}
+
+		lockEntry, state, err := s.decode(entries)
+		if err != nil {
+			return err
+		}
+
+		if lockEntry == nil || !state.Holders[session] {
+			return nil
+		}
+
+		delete(state.Holders, session)
+
+		err = s.write(ctx, lockEntry, state)
+		if _, conflict := err.(casConflict); conflict {
+			continue
+		}
+		return err
+	}
+}
+
+// monitor watches the .lock key of the semaphore, firing lost once session
+// is no longer one of the holders.
+func (s *semaphore) monitor(ctx Ctx, session SessionID, lost *signal) {
+	index := uint64(0)
+
+	for {
+		entries, meta, err := s.client.entries(ctx, s.lockKey(), Query{
+			DC:        s.config.DC,
+			WaitIndex: index,
+		}, false)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		var state semaphoreLock
+		if err == nil {
+			_, state, err = s.decode(entries)
+		}
+
+		if err != nil {
+			s.client.log.Warnf("failed to monitor semaphore %s, try again in %v: %v", s.config.Prefix, s.config.retry(), err)
+			if !sleep(ctx, s.config.retry()) {
+				return
+			}
+			continue
+		}
+
+		if !state.Holders[session] {
+			s.client.log.Tracef("semaphore %s is no longer held by session %s", s.config.Prefix, session)
+			lost.fire()
+			return
+		}
+
+		index = meta.LastIndex
+		if index < 1 {
+			index = 1
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package consulapi
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSemaphoreFlags = "16210313421097356768"
+	testDeadSession    = "0c9e1a7e-5d8f-11ea-bc55-0242ac130003"
+)
+
+func semaphoreEntries(lockIndex, holders string, contenders ...string) string {
+	body := `[`
+	for _, contender := range contenders {
+		body += `{"Key":"jobs/workers/` + contender + `","Value":"","Session":"` + contender + `","Flags":` + testSemaphoreFlags + `},`
+	}
+	return body + semaphoreLockEntry(lockIndex, holders) + `]`
+}
+
+func semaphoreLockEntry(index, holders string) string {
+	value := base64.StdEncoding.EncodeToString([]byte(holders))
+	return `{"Key":"jobs/workers/.lock","Value":"` + value + `","ModifyIndex":` + index + `,"Flags":` + testSemaphoreFlags + `}`
+}
+
+func Test_Semaphore_NewSemaphore(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t})
+	defer ts.Close()
+
+	_, err := client.NewSemaphore(SemaphoreConfig{Prefix: "/", Limit: 1})
+	require.EqualError(t, err, "semaphore prefix required")
+
+	_, err = client.NewSemaphore(SemaphoreConfig{Prefix: "jobs/workers"})
+	require.EqualError(t, err, "semaphore limit must be at least 1")
+}
+
+func Test_Semaphore_acquire_release(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/" + testLockSession,
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"flags":   {testSemaphoreFlags},
+			"acquire": {testLockSession},
+		},
+		hasBody: "worker-1",
+	}, {
+		// the dead session no longer holds its contender key, and is pruned
+		t:       t,
+		code:    http.StatusOK,
+		body:    semaphoreEntries("9", `{"Limit":2,"Holders":{"`+testDeadSession+`":true,"`+testOtherSession+`":true}}`, testLockSession, testOtherSession),
+		headers: indexed("10"),
+		hasPath: "/v1/kv/jobs/workers",
+		hasQuery: map[string][]string{
+			"recurse": {"true"},
+		},
+		hasMethod: http.MethodGet,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"flags": {testSemaphoreFlags},
+			"cas":   {"9"},
+		},
+		hasBody: `{"Limit":2,"Holders":{"` + testLockSession + `":true,"` + testOtherSession + `":true}}`,
+	}, {
+		// monitor sees the semaphore is held
+		t:         t,
+		code:      http.StatusOK,
+		body:      "[" + semaphoreLockEntry("11", `{"Limit":2,"Holders":{"`+testLockSession+`":true,"`+testOtherSession+`":true}}`) + "]",
+		headers:   indexed("11"),
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, nil, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "[" + semaphoreLockEntry("11", `{"Limit":2,"Holders":{"`+testLockSession+`":true,"`+testOtherSession+`":true}}`) + "]",
+		headers:   indexed("11"),
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"flags": {testSemaphoreFlags},
+			"cas":   {"11"},
+		},
+		hasBody: `{"Limit":2,"Holders":{"` + testOtherSession + `":true}}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/" + testLockSession,
+		hasMethod: http.MethodDelete,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	s, err := client.NewSemaphore(SemaphoreConfig{
+		Prefix:  "/jobs/workers/",
+		Limit:   2,
+		Value:   "worker-1",
+		Session: testLockSession,
+	})
+	require.NoError(t, err)
+
+	lost, err := s.Acquire(ctx)
+	require.NoError(t, err)
+
+	_, err = s.Acquire(ctx)
+	require.EqualError(t, err, "semaphore is already held")
+
+	// wait for the monitor to start its blocking query, so the order of
+	// requests is deterministic
+	time.Sleep(100 * time.Millisecond)
+
+	err = s.Release(ctx)
+	require.NoError(t, err)
+
+	_, open := <-lost
+	require.False(t, open)
+
+	err = s.Release(ctx)
+	require.EqualError(t, err, "semaphore is not held")
+}
+
+func Test_Semaphore_wait_conflict_lost(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/" + testLockSession,
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"flags":   {testSemaphoreFlags},
+			"acquire": {testLockSession},
+		},
+	}, {
+		// the semaphore is full
+		t:         t,
+		code:      http.StatusOK,
+		body:      semaphoreEntries("15", `{"Limit":1,"Holders":{"`+testOtherSession+`":true}}`, testLockSession, testOtherSession),
+		headers:   indexed("20"),
+		hasPath:   "/v1/kv/jobs/workers",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"recurse": {"true"},
+		},
+	}, {
+		// the holder went away
+		t:         t,
+		code:      http.StatusOK,
+		body:      semaphoreEntries("15", `{"Limit":1,"Holders":{"`+testOtherSession+`":true}}`, testLockSession),
+		headers:   indexed("21"),
+		hasPath:   "/v1/kv/jobs/workers",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"recurse": {"true"},
+			"index":   {"20"},
+		},
+	}, {
+		// but another contender updated the holders first
+		t:         t,
+		code:      http.StatusOK,
+		body:      "false",
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"flags": {testSemaphoreFlags},
+			"cas":   {"15"},
+		},
+		hasBody: `{"Limit":1,"Holders":{"` + testLockSession + `":true}}`,
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      semaphoreEntries("22", `{"Limit":1,"Holders":{}}`, testLockSession),
+		headers:   indexed("22"),
+		hasPath:   "/v1/kv/jobs/workers",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"recurse": {"true"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"flags": {testSemaphoreFlags},
+			"cas":   {"22"},
+		},
+		hasBody: `{"Limit":1,"Holders":{"` + testLockSession + `":true}}`,
+	}, {
+		// monitor sees the semaphore is held
+		t:         t,
+		code:      http.StatusOK,
+		body:      "[" + semaphoreLockEntry("23", `{"Limit":1,"Holders":{"`+testLockSession+`":true}}`) + "]",
+		headers:   indexed("23"),
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		// monitor sees the session was removed from the holders
+		t:         t,
+		code:      http.StatusOK,
+		body:      "[" + semaphoreLockEntry("24", `{"Limit":1,"Holders":{}}`) + "]",
+		headers:   indexed("24"),
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"23"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "[" + semaphoreLockEntry("24", `{"Limit":1,"Holders":{}}`) + "]",
+		headers:   indexed("24"),
+		hasPath:   "/v1/kv/jobs/workers/.lock",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/" + testLockSession,
+		hasMethod: http.MethodDelete,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	s, err := client.NewSemaphore(SemaphoreConfig{
+		Prefix:  "jobs/workers",
+		Limit:   1,
+		Session: testLockSession,
+	})
+	require.NoError(t, err)
+
+	lost, err := s.Acquire(ctx)
+	require.NoError(t, err)
+
+	select {
+	case <-lost:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected semaphore to be lost")
+	}
+
+	err = s.Release(ctx)
+	require.NoError(t, err)
+}
+
+func Test_Semaphore_limit_conflict(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/" + testLockSession,
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"flags":   {testSemaphoreFlags},
+			"acquire": {testLockSession},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      semaphoreEntries("15", `{"Limit":3,"Holders":{}}`, testLockSession),
+		headers:   indexed("20"),
+		hasPath:   "/v1/kv/jobs/workers",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"recurse": {"true"},
+		},
+	}, {
+		// the contender key is cleaned up
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/kv/jobs/workers/" + testLockSession,
+		hasMethod: http.MethodDelete,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	s, err := client.NewSemaphore(SemaphoreConfig{
+		Prefix:  "jobs/workers",
+		Limit:   2,
+		Session: testLockSession,
+	})
+	require.NoError(t, err)
+
+	_, err = s.Acquire(context.Background())
+	require.EqualError(t, err, "failed to acquire semaphore: semaphore limit conflict (lock: 3, local: 2)")
+}
@@ -1,6 +1,7 @@
 package consulapi
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -19,8 +20,16 @@ const (
 
 	SessionMinimumLockDelay = 0 * time.Second
 	SessionMaximumLockDelay = 60 * time.Second
+
+	// sessionRenewRetry is how long to wait before retrying a failed renewal
+	// of a session, while the TTL of the session has not yet elapsed.
+	sessionRenewRetry = 1 * time.Second
 )
 
+// ErrSessionLost is returned by RenewPeriodic when the session no longer
+// exists, or could not be renewed before its TTL elapsed.
+var ErrSessionLost = errors.New("session was lost")
+
 type SessionConfig struct {
 	// The DC in which the node holding the session.
 	DC string `json:"-"` // not part of the official API
@@ -52,23 +61,59 @@ type SessionConfig struct {
 	// - SessionRelease: causes any held locks to be released.
 	// - SessionDelete: causes any held locks to be deleted.
 	Behavior SessionTerminationBehavior `json:"Behavior"`
+
+	// Checks (optional) are the IDs of health checks the session is
+	// associated with. The session is invalidated if any of the checks goes
+	// critical. If Checks, NodeChecks and ServiceChecks are all nil, consul
+	// associates the session with the serfHealth check of Node. A non-nil
+	// empty list is sent as is, e.g. NodeChecks set to []string{} creates a
+	// session without the serfHealth check.
+	//
+	// If set, Checks should typically include "serfHealth". Consul prefers
+	// NodeChecks and ServiceChecks, which were added in consul 1.7.
+	Checks []string `json:"Checks"`
+
+	// NodeChecks (optional) are the IDs of node health checks of Node the
+	// session is associated with. If set, NodeChecks should typically include
+	// "serfHealth".
+	NodeChecks []string `json:"NodeChecks"`
+
+	// ServiceChecks (optional) are service health checks of Node the session
+	// is associated with.
+	ServiceChecks []SessionServiceCheck `json:"ServiceChecks"`
+}
+
+// A SessionServiceCheck identifies a service health check, which a session
+// may be associated with.
+type SessionServiceCheck struct {
+	// ID is the ID of the health check.
+	ID string `json:"ID"`
+
+	// Namespace (optional, enterprise) is the namespace of the service.
+	Namespace string `json:"Namespace,omitempty"`
 }
 
 type sessionConfigFormat2 struct {
-	Node      string `json:"Node"`
-	Name      string `json:"Name"`
-	LockDelay string `json:"LockDelay"`
-	TTL       string `json:"TTL"`
-	Behavior  string `json:"Behavior"`
+	Node          string                 `json:"Node"`
+	Name          string                 `json:"Name"`
+	LockDelay     string                 `json:"LockDelay"`
+	TTL           string                 `json:"TTL"`
+	Behavior      string                 `json:"Behavior"`
+	Checks        *[]string              `json:"Checks,omitempty"`
+	NodeChecks    *[]string              `json:"NodeChecks,omitempty"`
+	ServiceChecks *[]SessionServiceCheck `json:"ServiceChecks,omitempty"`
 }
 
 type sessionConfigFormat3 struct {
-	ID        string  `json:"ID"`
-	Node      string  `json:"Node"`
-	Name      string  `json:"Name"`
-	LockDelay float64 `json:"LockDelay"`
-	TTL       string  `json:"TTL"`
-	Behavior  string  `json:"Behavior"`
+	ID            string                `json:"ID"`
+	Node          string                `json:"Node"`
+	Name          string                `json:"Name"`
+	LockDelay     float64               `json:"LockDelay"`
+	TTL           string                `json:"TTL"`
+	Behavior      string                `json:"Behavior"`
+	Checks        []string              `json:"Checks"`
+	NodeChecks    []string              `json:"NodeChecks"`
+	ServiceChecks []SessionServiceCheck `json:"ServiceChecks"`
 }
 
 // SessionQuery is used to define values for each of the optional parameters
@@ -81,6 +126,18 @@ type SessionQuery struct {
 	//
 	// If blank, this will default to the dc that the queried agent is in.
 	DC string
+
+	// WaitIndex turns a read into a blocking query. When set, consul will not
+	// respond until the index of the session is greater than WaitIndex, or
+	// until WaitTime has elapsed. Typically WaitIndex is set to the
+	// QueryMeta.LastIndex returned by a previous read.
+	//
+	// Only used by ReadSession.
+	WaitIndex uint64
+
+	// WaitTime limits how long a blocking query may wait for a change. If
+	// unset, consul uses a default of 5 minutes. Only used if WaitIndex is set.
+	WaitTime time.Duration
 }
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Session -s _mock.go
@@ -99,7 +156,7 @@ type Session interface {
 	// ReadSession will return the session information for id.
 	//
 	// https://www.consul.io/api/session.html#read-session
-	ReadSession(Ctx, SessionQuery) (SessionConfig, error)
+	ReadSession(Ctx, SessionQuery) (SessionConfig, QueryMeta, error)
 
 	// ListSessions will list every session on node.
 	//
@@ -110,6 +167,16 @@ type Session interface {
 	//
 	// https://www.consul.io/api/session.html#renew-session
 	RenewSession(Ctx, SessionQuery) (time.Duration, error)
+
+	// RenewPeriodic keeps the session of id alive by renewing it every ttl/2,
+	// until ctx is done, at which point the session is destroyed. If a renewal
+	// fails, it is retried until ttl has elapsed since the last successful
+	// renewal. RenewPeriodic blocks, and is typically run in a goroutine.
+	//
+	// If the session no longer exists, or could not be renewed in time,
+	// ErrSessionLost is returned. Otherwise, the result of destroying the
+	// session is returned.
+	RenewPeriodic(Ctx, SessionQuery, time.Duration) error
 }
 
 var _ Session = (*client)(nil)
@@ -142,6 +209,24 @@ func internalizeSessionConfig(config SessionConfig) (sessionConfigFormat2, error
 	}
 	isc.Behavior = string(config.Behavior)
 
+	for _, check := range config.ServiceChecks {
+		if check.ID == "" {
+			return isc, errors.New("session service check id required")
+		}
+	}
+
+	// nil lists are omitted, but empty lists are sent, because consul only
+	// leaves out the serfHealth check when given an explicitly empty list
+	if config.Checks != nil {
+		isc.Checks = &config.Checks
+	}
+	if config.NodeChecks != nil {
+		isc.NodeChecks = &config.NodeChecks
+	}
+	if config.ServiceChecks != nil {
+		isc.ServiceChecks = &config.ServiceChecks
+	}
+
 	return isc, nil
 }
 
@@ -171,18 +256,23 @@ func (c *client) CreateSession(ctx Ctx, config SessionConfig) (SessionID, error)
 	return response.ID, nil
 }
 
-func (c *client) ReadSession(ctx Ctx, query SessionQuery) (SessionConfig, error) {
+func (c *client) ReadSession(ctx Ctx, query SessionQuery) (SessionConfig, QueryMeta, error) {
 	id := query.ID
 	dc := query.DC
 
-	path := fixup("/v1/session/info", string(id), param("dc", dc))
+	params, wait := blocking(query.WaitIndex, query.WaitTime)
+	params = append(params, param("dc", dc))
+
+	path := fixup("/v1/session/info", string(id), params...)
 
 	var response []sessionConfigFormat3
-	if err := c.get(ctx, path, &response); err != nil {
-		return SessionConfig{}, errors.Wrap(err, "failed to read session")
+	meta, err := c.getMeta(ctx, path, wait, &response)
+	if err != nil {
+		return SessionConfig{}, meta, errors.Wrap(err, "failed to read session")
 	}
 
-	return sessionFromFormat3(response, dc)
+	session, err := sessionFromFormat3(response, dc)
+	return session, meta, err
 }
 
 func (c *client) RenewSession(ctx Ctx, query SessionQuery) (time.Duration, error) {
@@ -200,6 +290,74 @@ func (c *client) RenewSession(ctx Ctx, query SessionQuery) (time.Duration, error
 	return session.TTL, err
 }
 
+func (c *client) RenewPeriodic(ctx Ctx, query SessionQuery, ttl time.Duration) error {
+	return c.renewPeriodic(ctx, query, ttl, 0)
+}
+
+// renewPeriodic is like RenewPeriodic, but renews the session every period
+// instead. If period is not set, the session is renewed every ttl/2.
+func (c *client) renewPeriodic(ctx Ctx, query SessionQuery, ttl, period time.Duration) error {
+	if ttl <= 0 {
+		return errors.New("session ttl must be positive")
+	}
+
+	every := func(ttl time.Duration) time.Duration {
+		if period > 0 {
+			return period
+		}
+		return ttl / 2
+	}
+
+	interval := every(ttl)
+	lastRenew := time.Now()
+
+	for {
+		if !sleep(ctx, interval) {
+			return c.destroyRenewed(query)
+		}
+
+		renewed, err := c.RenewSession(ctx, query)
+		switch {
+		case ctx.Err() != nil:
+			return c.destroyRenewed(query)
+
+		case err == nil:
+			// consul may increase the ttl of the session
+			if renewed > 0 {
+				ttl = renewed
+			}
+			lastRenew = time.Now()
+			interval = every(ttl)
+
+		case IsNotFound(err):
+			c.log.Warnf("session %s no longer exists", query.ID)
+			return ErrSessionLost
+
+		default:
+			remaining := ttl - time.Since(lastRenew)
+			if remaining <= 0 {
+				c.log.Warnf("failed to renew session %s before ttl elapsed: %v", query.ID, err)
+				return ErrSessionLost
+			}
+
+			interval = sessionRenewRetry
+			if remaining < interval {
+				interval = remaining
+			}
+			c.log.Warnf("failed to renew session %s, try again in %v: %v", query.ID, interval, err)
+		}
+	}
+}
+
+// destroyRenewed destroys the session of query once the context used to
+// renew it is done, so a fresh context is used.
+func (c *client) destroyRenewed(query SessionQuery) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	return c.DeleteSession(ctx, query)
+}
+
 func sessionFromFormat3(response []sessionConfigFormat3, dc string) (SessionConfig, error) {
 	if len(response) < 1 {
 		return SessionConfig{}, errors.New("read session returned no sessions")
@@ -217,12 +375,15 @@ func sessionFromFormat3(response []sessionConfigFormat3, dc string) (SessionConf
 	}
 
 	return SessionConfig{
-		DC:        dc,
-		Node:      session.Node,
-		Name:      session.Name,
-		LockDelay: time.Duration(session.LockDelay),
-		TTL:       ttl,
-		Behavior:  SessionTerminationBehavior(session.Behavior),
+		DC:            dc,
+		Node:          session.Node,
+		Name:          session.Name,
+		LockDelay:     time.Duration(session.LockDelay),
+		TTL:           ttl,
+		Behavior:      SessionTerminationBehavior(session.Behavior),
+		Checks:        session.Checks,
+		NodeChecks:    session.NodeChecks,
+		ServiceChecks: session.ServiceChecks,
 	}, nil
 }
 
@@ -243,11 +404,14 @@ func (c *client) ListSessions(ctx Ctx, dc, node string) (map[SessionID]SessionCo
 		}
 
 		configs[SessionID(session.ID)] = SessionConfig{
-			Node:      session.Node,
-			Name:      session.Name,
-			LockDelay: time.Duration(session.LockDelay),
-			TTL:       ttl,
-			Behavior:  SessionTerminationBehavior(session.Behavior),
+			Node:          session.Node,
+			Name:          session.Name,
+			LockDelay:     time.Duration(session.LockDelay),
+			TTL:           ttl,
+			Behavior:      SessionTerminationBehavior(session.Behavior),
+			Checks:        session.Checks,
+			NodeChecks:    session.NodeChecks,
+			ServiceChecks: session.ServiceChecks,
 		}
 	}
 
@@ -33,12 +33,18 @@ type SessionMock struct {
 	beforeListSessionsCounter uint64
 	ListSessionsMock          mSessionMockListSessions
 
-	funcReadSession          func(c1 Ctx, s1 SessionQuery) (s2 SessionConfig, err error)
+	funcReadSession          func(c1 Ctx, s1 SessionQuery) (s2 SessionConfig, q1 QueryMeta, err error)
 	inspectFuncReadSession   func(c1 Ctx, s1 SessionQuery)
 	afterReadSessionCounter  uint64
 	beforeReadSessionCounter uint64
 	ReadSessionMock          mSessionMockReadSession
 
+	funcRenewPeriodic          func(c1 Ctx, s1 SessionQuery, d1 time.Duration) (err error)
+	inspectFuncRenewPeriodic   func(c1 Ctx, s1 SessionQuery, d1 time.Duration)
+	afterRenewPeriodicCounter  uint64
+	beforeRenewPeriodicCounter uint64
+	RenewPeriodicMock          mSessionMockRenewPeriodic
+
 	funcRenewSession          func(c1 Ctx, s1 SessionQuery) (d1 time.Duration, err error)
 	inspectFuncRenewSession   func(c1 Ctx, s1 SessionQuery)
 	afterRenewSessionCounter  uint64
@@ -65,6 +71,9 @@ func NewSessionMock(t minimock.Tester) *SessionMock {
 	m.ReadSessionMock = mSessionMockReadSession{mock: m}
 	m.ReadSessionMock.callArgs = []*SessionMockReadSessionParams{}
 
+	m.RenewPeriodicMock = mSessionMockRenewPeriodic{mock: m}
+	m.RenewPeriodicMock.callArgs = []*SessionMockRenewPeriodicParams{}
+
 	m.RenewSessionMock = mSessionMockRenewSession{mock: m}
 	m.RenewSessionMock.callArgs = []*SessionMockRenewSessionParams{}
 
@@ -748,6 +757,7 @@ type SessionMockReadSessionParams struct {
 // SessionMockReadSessionResults contains results of the Session.ReadSession
 type SessionMockReadSessionResults struct {
 	s2  SessionConfig
+	q1  QueryMeta
 	err error
 }
 
@@ -783,7 +793,7 @@ func (mmReadSession *mSessionMockReadSession) Inspect(f func(c1 Ctx, s1 SessionQ
 }
 
 // Return sets up results that will be returned by Session.ReadSession
-func (mmReadSession *mSessionMockReadSession) Return(s2 SessionConfig, err error) *SessionMock {
+func (mmReadSession *mSessionMockReadSession) Return(s2 SessionConfig, q1 QueryMeta, err error) *SessionMock {
 	if mmReadSession.mock.funcReadSession != nil {
 		mmReadSession.mock.t.Fatalf("SessionMock.ReadSession mock is already set by Set")
 	}
@@ -791,12 +801,12 @@ func (mmReadSession *mSessionMockReadSession) Return(s2 SessionConfig, err error
 	if mmReadSession.defaultExpectation == nil {
 		mmReadSession.defaultExpectation = &SessionMockReadSessionExpectation{mock: mmReadSession.mock}
 	}
-	mmReadSession.defaultExpectation.results = &SessionMockReadSessionResults{s2, err}
+	mmReadSession.defaultExpectation.results = &SessionMockReadSessionResults{s2, q1, err}
 	return mmReadSession.mock
 }
 
 //Set uses given function f to mock the Session.ReadSession method
-func (mmReadSession *mSessionMockReadSession) Set(f func(c1 Ctx, s1 SessionQuery) (s2 SessionConfig, err error)) *SessionMock {
+func (mmReadSession *mSessionMockReadSession) Set(f func(c1 Ctx, s1 SessionQuery) (s2 SessionConfig, q1 QueryMeta, err error)) *SessionMock {
 	if mmReadSession.defaultExpectation != nil {
 		mmReadSession.mock.t.Fatalf("Default expectation is already set for the Session.ReadSession method")
 	}
@@ -825,13 +835,13 @@ func (mmReadSession *mSessionMockReadSession) When(c1 Ctx, s1 SessionQuery) *Ses
 }
 
 // Then sets up Session.ReadSession return parameters for the expectation previously defined by the When method
-func (e *SessionMockReadSessionExpectation) Then(s2 SessionConfig, err error) *SessionMock {
-	e.results = &SessionMockReadSessionResults{s2, err}
+func (e *SessionMockReadSessionExpectation) Then(s2 SessionConfig, q1 QueryMeta, err error) *SessionMock {
+	e.results = &SessionMockReadSessionResults{s2, q1, err}
 	return e.mock
 }
 
 // ReadSession implements Session
-func (mmReadSession *SessionMock) ReadSession(c1 Ctx, s1 SessionQuery) (s2 SessionConfig, err error) {
+func (mmReadSession *SessionMock) ReadSession(c1 Ctx, s1 SessionQuery) (s2 SessionConfig, q1 QueryMeta, err error) {
 	mm_atomic.AddUint64(&mmReadSession.beforeReadSessionCounter, 1)
 	defer mm_atomic.AddUint64(&mmReadSession.afterReadSessionCounter, 1)
 
@@ -849,7 +859,7 @@ func (mmReadSession *SessionMock) ReadSession(c1 Ctx, s1 SessionQuery) (s2 Sessi
 	for _, e := range mmReadSession.ReadSessionMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s2, e.results.err
+			return e.results.s2, e.results.q1, e.results.err
 		}
 	}
 
@@ -865,7 +875,7 @@ func (mmReadSession *SessionMock) ReadSession(c1 Ctx, s1 SessionQuery) (s2 Sessi
 		if mm_results == nil {
 			mmReadSession.t.Fatal("No results are set for the SessionMock.ReadSession")
 		}
-		return (*mm_results).s2, (*mm_results).err
+		return (*mm_results).s2, (*mm_results).q1, (*mm_results).err
 	}
 	if mmReadSession.funcReadSession != nil {
 		return mmReadSession.funcReadSession(c1, s1)
@@ -939,6 +949,223 @@ func (m *SessionMock) MinimockReadSessionInspect() {
 	}
 }
 
+type mSessionMockRenewPeriodic struct {
+	mock               *SessionMock
+	defaultExpectation *SessionMockRenewPeriodicExpectation
+	expectations       []*SessionMockRenewPeriodicExpectation
+
+	callArgs []*SessionMockRenewPeriodicParams
+	mutex    sync.RWMutex
+}
+
+// SessionMockRenewPeriodicExpectation specifies expectation struct of the Session.RenewPeriodic
+type SessionMockRenewPeriodicExpectation struct {
+	mock    *SessionMock
+	params  *SessionMockRenewPeriodicParams
+	results *SessionMockRenewPeriodicResults
+	Counter uint64
+}
+
+// SessionMockRenewPeriodicParams contains parameters of the Session.RenewPeriodic
+type SessionMockRenewPeriodicParams struct {
+	c1 Ctx
+	s1 SessionQuery
+	d1 time.Duration
+}
+
+// SessionMockRenewPeriodicResults contains results of the Session.RenewPeriodic
+type SessionMockRenewPeriodicResults struct {
+	err error
+}
+
+// Expect sets up expected params for Session.RenewPeriodic
+func (mmRenewPeriodic *mSessionMockRenewPeriodic) Expect(c1 Ctx, s1 SessionQuery, d1 time.Duration) *mSessionMockRenewPeriodic {
+	if mmRenewPeriodic.mock.funcRenewPeriodic != nil {
+		mmRenewPeriodic.mock.t.Fatalf("SessionMock.RenewPeriodic mock is already set by Set")
+	}
+
+	if mmRenewPeriodic.defaultExpectation == nil {
+		mmRenewPeriodic.defaultExpectation = &SessionMockRenewPeriodicExpectation{}
+	}
+
+	mmRenewPeriodic.defaultExpectation.params = &SessionMockRenewPeriodicParams{c1, s1, d1}
+	for _, e := range mmRenewPeriodic.expectations {
+		if minimock.Equal(e.params, mmRenewPeriodic.defaultExpectation.params) {
+			mmRenewPeriodic.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRenewPeriodic.defaultExpectation.params)
+		}
+	}
+
+	return mmRenewPeriodic
+}
+
+// Inspect accepts an inspector function that has same arguments as the Session.RenewPeriodic
+func (mmRenewPeriodic *mSessionMockRenewPeriodic) Inspect(f func(c1 Ctx, s1 SessionQuery, d1 time.Duration)) *mSessionMockRenewPeriodic {
+	if mmRenewPeriodic.mock.inspectFuncRenewPeriodic != nil {
+		mmRenewPeriodic.mock.t.Fatalf("Inspect function is already set for SessionMock.RenewPeriodic")
+	}
+
+	mmRenewPeriodic.mock.inspectFuncRenewPeriodic = f
+
+	return mmRenewPeriodic
+}
+
+// Return sets up results that will be returned by Session.RenewPeriodic
+func (mmRenewPeriodic *mSessionMockRenewPeriodic) Return(err error) *SessionMock {
+	if mmRenewPeriodic.mock.funcRenewPeriodic != nil {
+		mmRenewPeriodic.mock.t.Fatalf("SessionMock.RenewPeriodic mock is already set by Set")
+	}
+
+	if mmRenewPeriodic.defaultExpectation == nil {
+		mmRenewPeriodic.defaultExpectation = &SessionMockRenewPeriodicExpectation{mock: mmRenewPeriodic.mock}
+	}
+	mmRenewPeriodic.defaultExpectation.results = &SessionMockRenewPeriodicResults{err}
+	return mmRenewPeriodic.mock
+}
+
+//Set uses given function f to mock the Session.RenewPeriodic method
+func (mmRenewPeriodic *mSessionMockRenewPeriodic) Set(f func(c1 Ctx, s1 SessionQuery, d1 time.Duration) (err error)) *SessionMock {
+	if mmRenewPeriodic.defaultExpectation != nil {
+		mmRenewPeriodic.mock.t.Fatalf("Default expectation is already set for the Session.RenewPeriodic method")
+	}
+
+	if len(mmRenewPeriodic.expectations) > 0 {
+		mmRenewPeriodic.mock.t.Fatalf("Some expectations are already set for the Session.RenewPeriodic method")
+	}
+
+	mmRenewPeriodic.mock.funcRenewPeriodic = f
+	return mmRenewPeriodic.mock
+}
+
+// When sets expectation for the Session.RenewPeriodic which will trigger the result defined by the following
+// Then helper
+func (mmRenewPeriodic *mSessionMockRenewPeriodic) When(c1 Ctx, s1 SessionQuery, d1 time.Duration) *SessionMockRenewPeriodicExpectation {
+	if mmRenewPeriodic.mock.funcRenewPeriodic != nil {
+		mmRenewPeriodic.mock.t.Fatalf("SessionMock.RenewPeriodic mock is already set by Set")
+	}
+
+	expectation := &SessionMockRenewPeriodicExpectation{
+		mock:   mmRenewPeriodic.mock,
+		params: &SessionMockRenewPeriodicParams{c1, s1, d1},
+	}
+	mmRenewPeriodic.expectations = append(mmRenewPeriodic.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Session.RenewPeriodic return parameters for the expectation previously defined by the When method
+func (e *SessionMockRenewPeriodicExpectation) Then(err error) *SessionMock {
+	e.results = &SessionMockRenewPeriodicResults{err}
+	return e.mock
+}
+
+// RenewPeriodic implements Session
+func (mmRenewPeriodic *SessionMock) RenewPeriodic(c1 Ctx, s1 SessionQuery, d1 time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmRenewPeriodic.beforeRenewPeriodicCounter, 1)
+	defer mm_atomic.AddUint64(&mmRenewPeriodic.afterRenewPeriodicCounter, 1)
+
+	if mmRenewPeriodic.inspectFuncRenewPeriodic != nil {
+		mmRenewPeriodic.inspectFuncRenewPeriodic(c1, s1, d1)
+	}
+
+	mm_params := &SessionMockRenewPeriodicParams{c1, s1, d1}
+
+	// Record call args
+	mmRenewPeriodic.RenewPeriodicMock.mutex.Lock()
+	mmRenewPeriodic.RenewPeriodicMock.callArgs = append(mmRenewPeriodic.RenewPeriodicMock.callArgs, mm_params)
+	mmRenewPeriodic.RenewPeriodicMock.mutex.Unlock()
+
+	for _, e := range mmRenewPeriodic.RenewPeriodicMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRenewPeriodic.RenewPeriodicMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRenewPeriodic.RenewPeriodicMock.defaultExpectation.Counter, 1)
+		mm_want := mmRenewPeriodic.RenewPeriodicMock.defaultExpectation.params
+		mm_got := SessionMockRenewPeriodicParams{c1, s1, d1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRenewPeriodic.t.Errorf("SessionMock.RenewPeriodic got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRenewPeriodic.RenewPeriodicMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRenewPeriodic.t.Fatal("No results are set for the SessionMock.RenewPeriodic")
+		}
+		return (*mm_results).err
+	}
+	if mmRenewPeriodic.funcRenewPeriodic != nil {
+		return mmRenewPeriodic.funcRenewPeriodic(c1, s1, d1)
+	}
+	mmRenewPeriodic.t.Fatalf("Unexpected call to SessionMock.RenewPeriodic. %v %v %v", c1, s1, d1)
+	return
+}
+
+// RenewPeriodicAfterCounter returns a count of finished SessionMock.RenewPeriodic invocations
+func (mmRenewPeriodic *SessionMock) RenewPeriodicAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenewPeriodic.afterRenewPeriodicCounter)
+}
+
+// RenewPeriodicBeforeCounter returns a count of SessionMock.RenewPeriodic invocations
+func (mmRenewPeriodic *SessionMock) RenewPeriodicBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRenewPeriodic.beforeRenewPeriodicCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionMock.RenewPeriodic.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRenewPeriodic *mSessionMockRenewPeriodic) Calls() []*SessionMockRenewPeriodicParams {
+	mmRenewPeriodic.mutex.RLock()
+
+	argCopy := make([]*SessionMockRenewPeriodicParams, len(mmRenewPeriodic.callArgs))
+	copy(argCopy, mmRenewPeriodic.callArgs)
+
+	mmRenewPeriodic.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRenewPeriodicDone returns true if the count of the RenewPeriodic invocations corresponds
+// the number of defined expectations
+func (m *SessionMock) MinimockRenewPeriodicDone() bool {
+	for _, e := range m.RenewPeriodicMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenewPeriodicMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRenewPeriodicCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenewPeriodic != nil && mm_atomic.LoadUint64(&m.afterRenewPeriodicCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRenewPeriodicInspect logs each unmet expectation
+func (m *SessionMock) MinimockRenewPeriodicInspect() {
+	for _, e := range m.RenewPeriodicMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionMock.RenewPeriodic with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RenewPeriodicMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRenewPeriodicCounter) < 1 {
+		if m.RenewPeriodicMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SessionMock.RenewPeriodic")
+		} else {
+			m.t.Errorf("Expected call to SessionMock.RenewPeriodic with params: %#v", *m.RenewPeriodicMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRenewPeriodic != nil && mm_atomic.LoadUint64(&m.afterRenewPeriodicCounter) < 1 {
+		m.t.Error("Expected call to SessionMock.RenewPeriodic")
+	}
+}
+
 type mSessionMockRenewSession struct {
 	mock               *SessionMock
 	defaultExpectation *SessionMockRenewSessionExpectation
@@ -1167,6 +1394,8 @@ func (m *SessionMock) MinimockFinish() {
 
 		m.MinimockReadSessionInspect()
 
+		m.MinimockRenewPeriodicInspect()
+
 		m.MinimockRenewSessionInspect()
 		m.t.FailNow()
 	}
@@ -1195,5 +1424,6 @@ func (m *SessionMock) minimockDone() bool {
 		m.MinimockDeleteSessionDone() &&
 		m.MinimockListSessionsDone() &&
 		m.MinimockReadSessionDone() &&
+		m.MinimockRenewPeriodicDone() &&
 		m.MinimockRenewSessionDone()
 }
@@ -1,6 +1,7 @@
 package consulapi
 
 import (
+	"context"
 	"net/http"
 	"testing"
 	"time"
@@ -35,6 +36,74 @@ func Test_Session_CreateSession(t *testing.T) {
 	require.Equal(t, expID, id)
 }
 
+func Test_Session_CreateSession_checks(t *testing.T) {
+	expPayload := `{"Node":"dc1-node1","Name":"mySession1","LockDelay":"0s","TTL":"10s","Behavior":"delete",` +
+		`"Checks":["serfHealth","service:web"],"NodeChecks":["serfHealth"],` +
+		`"ServiceChecks":[{"ID":"service:web"},{"ID":"service:api","Namespace":"ns1"}]}`
+
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_session_create.json"),
+		hasPath:   "/v1/session/create",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   expPayload,
+	})
+	defer ts.Close()
+
+	_, err := client.CreateSession(ctx, SessionConfig{
+		Node:       "dc1-node1",
+		Name:       "mySession1",
+		TTL:        10 * time.Second,
+		Behavior:   SessionDelete,
+		Checks:     []string{"serfHealth", "service:web"},
+		NodeChecks: []string{"serfHealth"},
+		ServiceChecks: []SessionServiceCheck{{
+			ID: "service:web",
+		}, {
+			ID:        "service:api",
+			Namespace: "ns1",
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = client.CreateSession(ctx, SessionConfig{
+		Node:          "dc1-node1",
+		Name:          "mySession1",
+		TTL:           10 * time.Second,
+		Behavior:      SessionDelete,
+		ServiceChecks: []SessionServiceCheck{{Namespace: "ns1"}},
+	})
+	require.EqualError(t, err, "session service check id required")
+}
+
+func Test_Session_CreateSession_no_checks(t *testing.T) {
+	expPayload := `{"Node":"dc1-node1","Name":"mySession1","LockDelay":"0s","TTL":"10s","Behavior":"delete",` +
+		`"NodeChecks":[]}`
+
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_session_create.json"),
+		hasPath:   "/v1/session/create",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   expPayload,
+	})
+	defer ts.Close()
+
+	// an empty list of node checks disassociates the session from serfHealth
+	_, err := client.CreateSession(ctx, SessionConfig{
+		Node:       "dc1-node1",
+		Name:       "mySession1",
+		TTL:        10 * time.Second,
+		Behavior:   SessionDelete,
+		NodeChecks: []string{},
+	})
+	require.NoError(t, err)
+}
+
 func Test_Session_CreateSession_dc(t *testing.T) {
 	expPayload := `{"Node":"dc2-node1","Name":"mySession1","LockDelay":"1s","TTL":"10s","Behavior":"release"}`
 	expID := SessionID("adf4238a-882b-9ddc-4a9d-5b6758e4159e")
@@ -85,7 +154,7 @@ func Test_Session_CreateSession_err(t *testing.T) {
 		TTL:       10 * time.Second,
 		Behavior:  SessionRelease,
 	})
-	require.EqualError(t, err, "failed to create session: status code (500)")
+	require.EqualError(t, err, "failed to create session: PUT /v1/session/create: status code (500): malfunction")
 }
 
 func Test_Session_DeleteSession(t *testing.T) {
@@ -138,7 +207,7 @@ func Test_Session_DeleteSession_err(t *testing.T) {
 	err := client.DeleteSession(ctx, SessionQuery{
 		ID: "abc123",
 	})
-	require.EqualError(t, err, "failed to destroy session: status code (500)")
+	require.EqualError(t, err, "failed to destroy session: PUT /v1/session/destroy/abc123: status code (500): malfunction")
 }
 
 func Test_Session_ReadSession(t *testing.T) {
@@ -151,12 +220,13 @@ func Test_Session_ReadSession(t *testing.T) {
 	})
 	defer ts.Client()
 
-	config, err := client.ReadSession(ctx, SessionQuery{
+	config, _, err := client.ReadSession(ctx, SessionQuery{
 		// No DC
 		ID: "abc123",
 	})
 	require.NoError(t, err)
 	require.Equal(t, "test-session", config.Name)
+	require.Equal(t, []string{"serfHealth"}, config.Checks)
 }
 
 func Test_Session_ReadSession_dc(t *testing.T) {
@@ -172,7 +242,7 @@ func Test_Session_ReadSession_dc(t *testing.T) {
 	})
 	defer ts.Client()
 
-	config, err := client.ReadSession(ctx, SessionQuery{
+	config, _, err := client.ReadSession(ctx, SessionQuery{
 		DC: "dc2",
 		ID: "abc123",
 	})
@@ -181,6 +251,33 @@ func Test_Session_ReadSession_dc(t *testing.T) {
 	require.Equal(t, "dc2", config.DC)
 }
 
+func Test_Session_ReadSession_blocking(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:    t,
+		code: http.StatusOK,
+		body: load(t, "v1_session_info.json"),
+		headers: map[string]string{
+			"X-Consul-Index": "12",
+		},
+		hasPath:   "/v1/session/info/abc123",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"11"},
+			"wait":  {"1000ms"},
+		},
+	})
+	defer ts.Close()
+
+	config, meta, err := client.ReadSession(ctx, SessionQuery{
+		ID:        "abc123",
+		WaitIndex: 11,
+		WaitTime:  1 * time.Second,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test-session", config.Name)
+	require.Equal(t, uint64(12), meta.LastIndex)
+}
+
 func Test_Session_ReadSession_err(t *testing.T) {
 	ctx, ts, client := testClient(&responder{
 		t:         t,
@@ -191,10 +288,10 @@ func Test_Session_ReadSession_err(t *testing.T) {
 	})
 	defer ts.Client()
 
-	_, err := client.ReadSession(ctx, SessionQuery{
+	_, _, err := client.ReadSession(ctx, SessionQuery{
 		ID: "abc123",
 	})
-	require.EqualError(t, err, "failed to read session: status code (500)")
+	require.EqualError(t, err, "failed to read session: GET /v1/session/info/abc123: status code (500): malfunction")
 }
 
 func Test_Session_RenewSession(t *testing.T) {
@@ -249,7 +346,123 @@ func Test_Session_RenewSession_err(t *testing.T) {
 	_, err := client.RenewSession(ctx, SessionQuery{
 		ID: "abc123",
 	})
-	require.EqualError(t, err, "failed to renew session: status code (500)")
+	require.EqualError(t, err, "failed to renew session: PUT /v1/session/renew/abc123: status code (500): malfunction")
+}
+
+func Test_Session_RenewPeriodic(t *testing.T) {
+	renewed := `[{"ID":"abc123","Name":"test-session","Node":"node1","Behavior":"release","TTL":"400ms"}]`
+
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      renewed,
+		hasPath:   "/v1/session/renew/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}, {
+		// a failure is retried within the ttl
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/session/renew/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      renewed,
+		hasPath:   "/v1/session/renew/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}, {
+		// destroyed once the context is done
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/session/destroy/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	// renews at 200ms, fails at 400ms, retries at 600ms, is done at 700ms
+	ctx, cancel := context.WithTimeout(context.Background(), 700*time.Millisecond)
+	defer cancel()
+
+	err := client.RenewPeriodic(ctx, SessionQuery{ID: "abc123"}, 400*time.Millisecond)
+	require.NoError(t, err)
+}
+
+func Test_Session_renewPeriodic_period(t *testing.T) {
+	renewed := `[{"ID":"abc123","Name":"test-session","Node":"node1","Behavior":"release","TTL":"10s"}]`
+	renew := &responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      renewed,
+		hasPath:   "/v1/session/renew/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}
+
+	_, ts, c := testClient(&sequence{t: t, responders: []*responder{renew, renew, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      "true",
+		hasPath:   "/v1/session/destroy/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	// renews at 100ms and 200ms, rather than every 5s, and is done at 250ms
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+
+	err := c.(*client).renewPeriodic(ctx, SessionQuery{ID: "abc123"}, 10*time.Second, 100*time.Millisecond)
+	require.NoError(t, err)
+}
+
+func Test_Session_RenewPeriodic_not_found(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusNotFound,
+		body:      "Session id 'abc123' not found",
+		hasPath:   "/v1/session/renew/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}}})
+	defer ts.Close()
+
+	err := client.RenewPeriodic(context.Background(), SessionQuery{ID: "abc123"}, 20*time.Millisecond)
+	require.Equal(t, ErrSessionLost, err)
+}
+
+func Test_Session_RenewPeriodic_expired(t *testing.T) {
+	malfunction := &responder{
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/session/renew/abc123",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+	}
+
+	// fails at 10ms, retries at 20ms
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{
+		malfunction, malfunction,
+	}})
+	defer ts.Close()
+
+	err := client.RenewPeriodic(context.Background(), SessionQuery{ID: "abc123"}, 20*time.Millisecond)
+	require.Equal(t, ErrSessionLost, err)
+}
+
+func Test_Session_RenewPeriodic_invalid(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t})
+	defer ts.Close()
+
+	err := client.RenewPeriodic(context.Background(), SessionQuery{ID: "abc123"}, 0)
+	require.EqualError(t, err, "session ttl must be positive")
 }
 
 func Test_Session_ListSession(t *testing.T) {
@@ -10,13 +10,21 @@ type Address struct {
 	Port    int    `json:"port"`
 }
 
+type Upstream struct {
+	DestinationType  string `json:"DestinationType,omitempty"`
+	DestinationName  string `json:"DestinationName"`
+	Datacenter       string `json:"Datacenter,omitempty"`
+	LocalBindAddress string `json:"LocalBindAddress,omitempty"`
+	LocalBindPort    int    `json:"LocalBindPort"`
+}
+
 type Proxy struct {
-	DestinationServiceName string `json:"DestinationServiceName"`
-	DestinationServiceID   string `json:"DestinationServiceID"`
-	LocalServiceAddress    string `json:"LocalServiceAddress"`
-	LocalServicePort       int    `json:"LocalServicePort"`
-	// Config
-	// Upstreams
+	DestinationServiceName string                 `json:"DestinationServiceName"`
+	DestinationServiceID   string                 `json:"DestinationServiceID"`
+	LocalServiceAddress    string                 `json:"LocalServiceAddress"`
+	LocalServicePort       int                    `json:"LocalServicePort"`
+	Config                 map[string]interface{} `json:"Config"`
+	Upstreams              []Upstream             `json:"Upstreams"`
 }
 
 type ServiceConnect struct {
@@ -43,3 +51,27 @@ type Instance struct {
 	ServiceProxy             Proxy              `json:"ServiceProxy"`
 	ServiceConnect           ServiceConnect     `json:"ServiceConnect"`
 }
+
+type Weights struct {
+	Passing int `json:"Passing"`
+	Warning int `json:"Warning"`
+}
+
+// An AgentService is the definition of a service instance, as known by the
+// agent on which the service is registered.
+type AgentService struct {
+	Kind              string             `json:"Kind"`
+	ID                string             `json:"ID"`
+	Name              string             `json:"Service"`
+	Tags              []string           `json:"Tags"`
+	Meta              map[string]string  `json:"Meta"`
+	Port              int                `json:"Port"`
+	Address           string             `json:"Address"`
+	TaggedAddresses   map[string]Address `json:"TaggedAddresses"`
+	Weights           Weights            `json:"Weights"`
+	EnableTagOverride bool               `json:"EnableTagOverride"`
+	Proxy             Proxy              `json:"Proxy"`
+	Connect           ServiceConnect     `json:"Connect"`
+	CreateIndex       uint64             `json:"CreateIndex"`
+	ModifyIndex       uint64             `json:"ModifyIndex"`
+}
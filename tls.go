@@ -0,0 +1,271 @@
+package consulapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+// TLSConfig is used to configure how a client communicates with a consul
+// agent using TLS, including mutual TLS where the agent verifies the
+// certificate of the client (i.e. verify_incoming).
+//
+// The certificates are read from disk when a connection is made, and read
+// again whenever the files change, so that certificates which are rotated
+// (e.g. by Vault agent) are picked up without creating a new Client.
+//
+// https://www.consul.io/docs/security/encryption#rpc-encryption-with-tls
+type TLSConfig struct {
+	// CAFile (optional) is the path to a PEM encoded CA certificate used to
+	// verify the certificate of the consul agent.
+	CAFile string
+
+	// CAPath (optional) is the path to a directory of PEM encoded CA
+	// certificates used to verify the certificate of the consul agent.
+	//
+	// If neither CAFile nor CAPath are set, the CA certificates of the system
+	// are used.
+	CAPath string
+
+	// CertFile (optional) is the path to the PEM encoded certificate of the
+	// client, presented to the consul agent. Requires KeyFile.
+	CertFile string
+
+	// KeyFile (optional) is the path to the PEM encoded private key of the
+	// client certificate. Requires CertFile.
+	KeyFile string
+
+	// ServerName (optional) is the name used to verify the certificate of the
+	// consul agent, if different from the host of the address.
+	ServerName string
+
+	// InsecureSkipVerify (optional) disables verification of the certificate
+	// of the consul agent. This should only be used for testing.
+	InsecureSkipVerify bool
+}
+
+// check loads the certificates of tc, returning any error doing so.
+func (tc *TLSConfig) check() error {
+	if tc.CAFile != "" || tc.CAPath != "" {
+		if _, err := loadCAs(tc.caFiles()); err != nil {
+			return err
+		}
+	}
+
+	if tc.CertFile != "" || tc.KeyFile != "" {
+		if _, err := loadKeyPair(tc.CertFile, tc.KeyFile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transport configures t to communicate using tc. The certificates of tc are
+// loaded when a connection is made, and loaded again when the files change.
+// Errors loading the certificates are returned when making a connection.
+func (tc *TLSConfig) transport(t *http.Transport, log loggy.Logger) *http.Transport {
+	t.TLSClientConfig = &tls.Config{
+		ServerName:         tc.ServerName,
+		InsecureSkipVerify: tc.InsecureSkipVerify,
+	}
+
+	if tc.CertFile != "" || tc.KeyFile != "" {
+		cert := &reloader{
+			name:  "client certificate",
+			files: func() []string { return []string{tc.CertFile, tc.KeyFile} },
+			load: func() (interface{}, error) {
+				return loadKeyPair(tc.CertFile, tc.KeyFile)
+			},
+			log: log,
+		}
+		t.TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			value, err := cert.get()
+			if err != nil {
+				return nil, err
+			}
+			return value.(*tls.Certificate), nil
+		}
+	}
+
+	if (tc.CAFile != "" || tc.CAPath != "") && !tc.InsecureSkipVerify {
+		cas := &reloader{
+			name:  "CA certificates",
+			files: tc.caFiles,
+			load: func() (interface{}, error) {
+				return loadCAs(tc.caFiles())
+			},
+			log: log,
+		}
+
+		// the CAs of a tls.Config are fixed, so make the TLS connections with
+		// a copy of the config using the current CAs, which keeps the
+		// standard verification of the certificate of the server
+		dial := t.DialContext
+		if dial == nil {
+			dial = (&net.Dialer{}).DialContext
+		}
+		t.DialTLSContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+			value, err := cas.get()
+			if err != nil {
+				return nil, err
+			}
+
+			config := t.TLSClientConfig.Clone()
+			config.RootCAs = value.(*x509.CertPool)
+			if config.ServerName == "" {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return nil, err
+				}
+				config.ServerName = host
+			}
+
+			conn, err := dial(ctx, network, address)
+			if err != nil {
+				return nil, err
+			}
+			return handshake(ctx, tls.Client(conn, config))
+		}
+	}
+
+	return t
+}
+
+// handshake completes the TLS handshake of conn before the deadline of ctx,
+// if any, or closes conn if the handshake fails.
+func handshake(ctx context.Context, conn *tls.Conn) (net.Conn, error) {
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	if err := conn.Handshake(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// caFiles returns the CAFile, and the files in the CAPath directory.
+func (tc *TLSConfig) caFiles() []string {
+	var files []string
+	if tc.CAFile != "" {
+		files = append(files, tc.CAFile)
+	}
+
+	if tc.CAPath != "" {
+		infos, err := ioutil.ReadDir(tc.CAPath)
+		if err != nil {
+			// reported when the files are loaded
+			return append(files, tc.CAPath)
+		}
+		for _, info := range infos {
+			if !info.IsDir() {
+				files = append(files, filepath.Join(tc.CAPath, info.Name()))
+			}
+		}
+	}
+
+	return files
+}
+
+func loadKeyPair(certFile, keyFile string) (*tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load client certificate")
+	}
+	return &cert, nil
+}
+
+// loadCAs creates a pool of the PEM encoded certificates in files.
+func loadCAs(files []string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, file := range files {
+		bs, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read CA certificate")
+		}
+		if !pool.AppendCertsFromPEM(bs) {
+			return nil, errors.Errorf("no CA certificates in %s", file)
+		}
+	}
+	return pool, nil
+}
+
+// A reloader caches the value loaded from a set of files, and loads the
+// value again whenever the files change. If loading the value again fails,
+// the previous value continues to be used.
+type reloader struct {
+	name  string
+	files func() []string
+	load  func() (interface{}, error)
+	log   loggy.Logger
+
+	lock    sync.Mutex
+	version string
+	value   interface{}
+}
+
+func (r *reloader) get() (interface{}, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	version := fileVersion(r.files())
+	if r.value != nil && version == r.version {
+		return r.value, nil
+	}
+
+	value, err := r.load()
+	if err != nil {
+		if r.value != nil {
+			r.log.Warnf("failed to reload %s, continue using previous: %v", r.name, err)
+			return r.value, nil
+		}
+		return nil, err
+	}
+
+	if r.value != nil {
+		r.log.Tracef("reloaded %s", r.name)
+	}
+
+	r.version = version
+	r.value = value
+	return value, nil
+}
+
+// fileVersion describes the names, sizes and modification times of files,
+// which changes whenever one of the files is replaced or modified.
+func fileVersion(files []string) string {
+	versions := make([]string, 0, len(files))
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			versions = append(versions, file+":missing")
+			continue
+		}
+		versions = append(versions, fmt.Sprintf("%s:%d:%d", file, info.Size(), info.ModTime().UnixNano()))
+	}
+	sort.Strings(versions)
+	return strings.Join(versions, ",")
+}
@@ -0,0 +1,319 @@
+package consulapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+// certificate is a certificate and its private key, for testing
+type certificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newCertificate creates a certificate for name and ips, signed by parent, or
+// self signed as a CA if parent is nil.
+func newCertificate(t *testing.T, name string, parent *certificate, ips ...net.IP) *certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{name},
+		IPAddresses:  ips,
+	}
+
+	signer := &certificate{cert: template, key: key}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer = parent
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer.cert, &key.PublicKey, signer.key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &certificate{cert: cert, key: key}
+}
+
+func (c *certificate) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+}
+
+func (c *certificate) keyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (c *certificate) tls(t *testing.T) tls.Certificate {
+	pair, err := tls.X509KeyPair(c.certPEM(), c.keyPEM(t))
+	require.NoError(t, err)
+	return pair
+}
+
+// write writes the certificate and key of c into dir, returning their paths.
+func (c *certificate) write(t *testing.T, dir, name string) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, ioutil.WriteFile(certFile, c.certPEM(), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, c.keyPEM(t), 0600))
+	return certFile, keyFile
+}
+
+// mtlsServer starts a server which requires a client certificate signed by
+// ca, and presents a server certificate signed by ca.
+func mtlsServer(t *testing.T, ca *certificate, h http.Handler) *httptest.Server {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	ts := httptest.NewUnstartedServer(h)
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{newCertificate(t, "server.dc1.consul", ca, net.ParseIP("127.0.0.1")).tls(t)},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}
+	ts.StartTLS()
+	return ts
+}
+
+func Test_TLSConfig_mtls(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ca := newCertificate(t, "ca", nil)
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caFile, ca.certPEM(), 0600))
+
+	get := &responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}
+
+	ts := mtlsServer(t, ca, get)
+	defer ts.Close()
+
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+
+	c := New(ClientOptions{
+		Address: ts.URL,
+		TLSConfig: &TLSConfig{
+			CAFile:     caFile,
+			CertFile:   certFile,
+			KeyFile:    keyFile,
+			ServerName: "server.dc1.consul",
+		},
+		Logger: loggy.New("test-client"),
+	})
+
+	// the client certificate does not exist yet
+	_, _, err = c.Get(context.Background(), "config/a", Query{})
+	require.Error(t, err)
+
+	// once it does, it is loaded
+	newCertificate(t, "client", ca).write(t, dir, "client")
+
+	value, _, err := c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+
+	// the server name must match the server certificate
+	c = New(ClientOptions{
+		Address: ts.URL,
+		TLSConfig: &TLSConfig{
+			CAFile:     caFile,
+			CertFile:   certFile,
+			KeyFile:    keyFile,
+			ServerName: "server.dc2.consul",
+		},
+	})
+
+	_, _, err = c.Get(context.Background(), "config/a", Query{})
+	require.Error(t, err)
+}
+
+func Test_TLSConfig_hostname(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ca := newCertificate(t, "ca", nil)
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caFile, ca.certPEM(), 0600))
+
+	// the server certificate is signed by the CA, but not valid for 127.0.0.1
+	ts := httptest.NewUnstartedServer(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{newCertificate(t, "evil.example", ca).tls(t)},
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	c := New(ClientOptions{
+		Address:   ts.URL,
+		TLSConfig: &TLSConfig{CAFile: caFile},
+		Logger:    loggy.New("test-client"),
+	})
+
+	_, _, err = c.Get(context.Background(), "config/a", Query{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "127.0.0.1")
+
+	// unless the server name is set to the name of the certificate
+	c = New(ClientOptions{
+		Address:   ts.URL,
+		TLSConfig: &TLSConfig{CAFile: caFile, ServerName: "evil.example"},
+		Logger:    loggy.New("test-client"),
+	})
+
+	value, _, err := c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+}
+
+func Test_TLSConfig_rotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	caDir := filepath.Join(dir, "ca")
+	require.NoError(t, os.Mkdir(caDir, 0700))
+
+	ca1 := newCertificate(t, "ca1", nil)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(caDir, "ca1.pem"), ca1.certPEM(), 0600))
+	certFile, keyFile := newCertificate(t, "client", ca1).write(t, dir, "client")
+
+	get := &responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}
+
+	ca2 := newCertificate(t, "ca2", nil)
+	ts1 := mtlsServer(t, ca1, get)
+	ts2 := mtlsServer(t, ca2, get)
+	defer ts2.Close()
+
+	c := New(ClientOptions{
+		Address:   ts1.URL,
+		Addresses: []string{ts2.URL},
+		TLSConfig: &TLSConfig{
+			CAPath:   caDir,
+			CertFile: certFile,
+			KeyFile:  keyFile,
+		},
+		Logger: loggy.New("test-client"),
+	})
+
+	_, _, err = c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+
+	// rotate to a certificate signed by a new CA, making sure the modification
+	// times change, and fail over to a server which only trusts the new CA
+	require.NoError(t, ioutil.WriteFile(filepath.Join(caDir, "ca2.pem"), ca2.certPEM(), 0600))
+	newCertificate(t, "client", ca2).write(t, dir, "client")
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+	require.NoError(t, os.Chtimes(keyFile, later, later))
+	ts1.Close()
+
+	_, _, err = c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, ts2.URL, c.ActiveAddress())
+}
+
+func Test_reloader_get(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	file := filepath.Join(dir, "value")
+	loads := 0
+
+	r := &reloader{
+		name:  "value",
+		files: func() []string { return []string{file} },
+		load: func() (interface{}, error) {
+			loads++
+			bs, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			return string(bs), nil
+		},
+		log: loggy.New("test-reloader"),
+	}
+
+	// not loaded, the error is returned
+	_, err = r.get()
+	require.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("one"), 0600))
+	value, err := r.get()
+	require.NoError(t, err)
+	require.Equal(t, "one", value)
+
+	// not loaded again until the file changes
+	value, err = r.get()
+	require.NoError(t, err)
+	require.Equal(t, "one", value)
+	require.Equal(t, 2, loads)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("two!"), 0600))
+	value, err = r.get()
+	require.NoError(t, err)
+	require.Equal(t, "two!", value)
+
+	// a failed reload keeps the previous value
+	require.NoError(t, os.Remove(file))
+	value, err = r.get()
+	require.NoError(t, err)
+	require.Equal(t, "two!", value)
+}
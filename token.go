@@ -0,0 +1,65 @@
+package consulapi
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+// A TokenSource provides the token used to authenticate requests to consul,
+// which may change over time, e.g. because it is rotated.
+type TokenSource interface {
+	// Token returns the current token. It is called for every request, and
+	// should cache the token if getting it is expensive.
+	Token() (string, error)
+}
+
+// TokenFunc is a TokenSource which calls the function to get the token.
+type TokenFunc func() (string, error)
+
+func (f TokenFunc) Token() (string, error) {
+	return f()
+}
+
+// StaticTokenSource is a TokenSource which always provides the same token.
+type StaticTokenSource string
+
+func (s StaticTokenSource) Token() (string, error) {
+	return string(s), nil
+}
+
+// NewFileTokenSource creates a TokenSource which reads the token from the file
+// at path, and reads it again whenever the file changes. Leading and trailing
+// whitespace is removed from the token. If reading the file again fails, the
+// previous token continues to be used.
+func NewFileTokenSource(path string) TokenSource {
+	return &fileTokenSource{
+		reloader: &reloader{
+			name:  "token file " + path,
+			files: func() []string { return []string{path} },
+			load: func() (interface{}, error) {
+				bs, err := ioutil.ReadFile(path)
+				if err != nil {
+					return nil, errors.Wrap(err, "failed to read token file")
+				}
+				return strings.TrimSpace(string(bs)), nil
+			},
+			log: loggy.Discard(),
+		},
+	}
+}
+
+type fileTokenSource struct {
+	reloader *reloader
+}
+
+func (s *fileTokenSource) Token() (string, error) {
+	value, err := s.reloader.get()
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
+}
@@ -0,0 +1,117 @@
+package consulapi
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+func Test_TokenFunc(t *testing.T) {
+	token, err := TokenFunc(func() (string, error) {
+		return "abc123", nil
+	}).Token()
+	require.NoError(t, err)
+	require.Equal(t, "abc123", token)
+}
+
+func Test_NewFileTokenSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	file := filepath.Join(dir, "token")
+
+	source := NewFileTokenSource(file)
+	_, err = source.Token()
+	require.Error(t, err)
+
+	err = ioutil.WriteFile(file, []byte("abc123\n"), 0600)
+	require.NoError(t, err)
+
+	token, err := source.Token()
+	require.NoError(t, err)
+	require.Equal(t, "abc123", token)
+
+	// the token is read again once the file changes
+	err = ioutil.WriteFile(file, []byte("  def4567\n"), 0600)
+	require.NoError(t, err)
+
+	token, err = source.Token()
+	require.NoError(t, err)
+	require.Equal(t, "def4567", token)
+
+	// the previous token is kept if the file goes missing
+	err = os.Remove(file)
+	require.NoError(t, err)
+
+	token, err = source.Token()
+	require.NoError(t, err)
+	require.Equal(t, "def4567", token)
+}
+
+func Test_Client_TokenSource(t *testing.T) {
+	tokens := []string{"abc123", "def456"}
+
+	ts := httptest.NewServer(&sequence{t: t, responders: []*responder{{
+		t:          t,
+		code:       http.StatusOK,
+		body:       "",
+		hasPath:    "/v1/agent/reload",
+		hasMethod:  http.MethodPut,
+		hasHeaders: map[string]string{consulTokenHeader: "abc123"},
+	}, {
+		t:          t,
+		code:       http.StatusOK,
+		body:       "",
+		hasPath:    "/v1/agent/reload",
+		hasMethod:  http.MethodPut,
+		hasHeaders: map[string]string{consulTokenHeader: "def456"},
+	}}})
+	defer ts.Close()
+
+	client := New(ClientOptions{
+		Address: ts.URL,
+		Token:   "ignored",
+		TokenSource: TokenFunc(func() (string, error) {
+			token := tokens[0]
+			tokens = tokens[1:]
+			return token, nil
+		}),
+		Logger: loggy.New("test-client"),
+	})
+
+	for i := 0; i < 2; i++ {
+		err := client.Reload(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+func Test_Client_TokenSource_err(t *testing.T) {
+	ts := httptest.NewServer(&sequence{t: t})
+	defer ts.Close()
+
+	client := New(ClientOptions{
+		Address: ts.URL,
+		TokenSource: TokenFunc(func() (string, error) {
+			return "", errors.New("vault is sealed")
+		}),
+		Logger: loggy.New("test-client"),
+	})
+
+	err := client.Reload(context.Background())
+	require.EqualError(t, err, "failed to get token: vault is sealed")
+
+	// failing to get the token is not the fault of the address
+	statuses := client.AddressStatuses()
+	require.Len(t, statuses, 1)
+	require.True(t, statuses[0].EjectedUntil.IsZero())
+}
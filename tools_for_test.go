@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,8 +21,9 @@ import (
 type responder struct {
 	t *testing.T // our test controller
 
-	code int    // respond with http status code
-	body string // respond with this body
+	code    int               // respond with http status code
+	body    string            // respond with this body
+	headers map[string]string // respond with these headers
 
 	hasMethod  string              // assert request has this HTTP method type
 	hasPath    string              // assert request has this path
@@ -51,6 +53,9 @@ func (rs *responder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// 6) okay now we can write the response
 	w.Header().Set(headerContentType, mimeJSON)
+	for key, value := range rs.headers {
+		w.Header().Set(key, value)
+	}
 	w.WriteHeader(rs.code)
 	_, _ = w.Write([]byte(rs.body))
 }
@@ -120,6 +125,40 @@ func (rs *responder) checkBody(r *http.Request) {
 	r.Body = ioutil.NopCloser(bytes.NewReader(bs))
 }
 
+// sequence responds to each request with the next responder. A nil responder
+// blocks until the request is cancelled, like a blocking query which never
+// sees a change.
+type sequence struct {
+	t          *testing.T
+	lock       sync.Mutex
+	responders []*responder
+}
+
+func (s *sequence) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	if len(s.responders) == 0 {
+		s.lock.Unlock()
+		s.t.Errorf("sequence: unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	next := s.responders[0]
+	s.responders = s.responders[1:]
+	s.lock.Unlock()
+
+	if next == nil {
+		<-r.Context().Done()
+		return
+	}
+
+	next.ServeHTTP(w, r)
+}
+
+// indexed creates response headers with the given consul index
+func indexed(index string) map[string]string {
+	return map[string]string{headerIndex: index}
+}
+
 func load(t *testing.T, file string) string {
 	filePath := filepath.Join("hack/resources", file)
 	bs, err := ioutil.ReadFile(filePath)
@@ -0,0 +1,369 @@
+package consulapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A TxnVerb is the kind of operation to apply to an object in a transaction.
+// Not every verb is valid for every kind of object.
+//
+// https://www.consul.io/api/txn.html#tables-of-operations
+type TxnVerb string
+
+const (
+	// TxnSet sets the object (KV, Node, Service, Check).
+	TxnSet TxnVerb = "set"
+
+	// TxnCAS sets the object, only if its ModifyIndex matches the given index
+	// (KV, Node, Service, Check).
+	TxnCAS TxnVerb = "cas"
+
+	// TxnGet reads the object, failing the transaction if it does not exist
+	// (KV, Node, Service, Check).
+	TxnGet TxnVerb = "get"
+
+	// TxnGetTree reads every key under the given prefix (KV).
+	TxnGetTree TxnVerb = "get-tree"
+
+	// TxnCheckIndex fails the transaction if the ModifyIndex of the key does
+	// not match the given index (KV).
+	TxnCheckIndex TxnVerb = "check-index"
+
+	// TxnCheckSession fails the transaction if the key is not locked by the
+	// given session (KV).
+	TxnCheckSession TxnVerb = "check-session"
+
+	// TxnCheckNotExists fails the transaction if the key exists (KV).
+	TxnCheckNotExists TxnVerb = "check-not-exists"
+
+	// TxnDelete deletes the object (KV, Node, Service, Check).
+	TxnDelete TxnVerb = "delete"
+
+	// TxnDeleteTree deletes every key under the given prefix (KV).
+	TxnDeleteTree TxnVerb = "delete-tree"
+
+	// TxnDeleteCAS deletes the object, only if its ModifyIndex matches the
+	// given index (KV, Node, Service, Check).
+	TxnDeleteCAS TxnVerb = "delete-cas"
+
+	// TxnLock sets the key, and locks it with the given session (KV).
+	TxnLock TxnVerb = "lock"
+
+	// TxnUnlock sets the key, and unlocks it from the given session (KV).
+	TxnUnlock TxnVerb = "unlock"
+)
+
+// A TxnOp is one operation of a transaction. Exactly one of KV, Node, Service,
+// or Check must be set.
+type TxnOp struct {
+	KV      *KVTxnOp
+	Node    *NodeTxnOp
+	Service *ServiceTxnOp
+	Check   *CheckTxnOp
+}
+
+// verb returns the verb of whichever kind of operation op is.
+func (op TxnOp) verb() TxnVerb {
+	switch {
+	case op.KV != nil:
+		return op.KV.Verb
+	case op.Node != nil:
+		return op.Node.Verb
+	case op.Service != nil:
+		return op.Service.Verb
+	case op.Check != nil:
+		return op.Check.Verb
+	}
+	return ""
+}
+
+// A KVTxnOp is an operation on the KV store.
+type KVTxnOp struct {
+	Verb    TxnVerb
+	Key     string
+	Value   string
+	Flags   uint64
+	Index   uint64
+	Session SessionID
+}
+
+// A NodeTxnOp is an operation on a node in the catalog.
+type NodeTxnOp struct {
+	Verb TxnVerb
+	Node Node
+
+	// Index is the ModifyIndex to match for the cas and delete-cas verbs.
+	Index uint64
+}
+
+// A ServiceTxnOp is an operation on a service of a node in the catalog.
+type ServiceTxnOp struct {
+	Verb    TxnVerb
+	Node    string
+	Service AgentService
+
+	// Index is the ModifyIndex to match for the cas and delete-cas verbs. If
+	// set, it replaces the ModifyIndex of Service.
+	Index uint64
+}
+
+// A CheckTxnOp is an operation on a health check in the catalog.
+type CheckTxnOp struct {
+	Verb  TxnVerb
+	Check HealthCheck
+
+	// Index is the ModifyIndex to match for the cas and delete-cas verbs. If
+	// set, it replaces the ModifyIndex of Check.
+	Index uint64
+}
+
+// A TxnResult is the result of one operation of a transaction. At most one of
+// KV, Node, Service, or Check is set, depending on the kind of operation.
+//
+// Consul only returns results for operations that produce a result (e.g. not
+// for deletes), so results do not necessarily line up with operations.
+type TxnResult struct {
+	KV      *KVEntry
+	Node    *Node
+	Service *AgentService
+	Check   *HealthCheck
+}
+
+// A TxnError describes why a transaction was rolled back.
+type TxnError struct {
+	// OpIndex is the index of the operation which caused the failure.
+	OpIndex int `json:"OpIndex"`
+
+	// What describes the failure.
+	What string `json:"What"`
+
+	// verb is the verb of the operation which caused the failure, if known.
+	verb TxnVerb
+}
+
+func (te TxnError) Error() string {
+	return fmt.Sprintf("op %d: %s", te.OpIndex, te.What)
+}
+
+// TxnErrors is the error returned when consul rolls back a transaction, which
+// contains the reason each failed operation did not succeed.
+type TxnErrors []TxnError
+
+func (tes TxnErrors) Error() string {
+	if len(tes) == 0 {
+		return "transaction rolled back"
+	}
+
+	reasons := make([]string, 0, len(tes))
+	for _, te := range tes {
+		reasons = append(reasons, te.Error())
+	}
+	return "transaction rolled back: " + strings.Join(reasons, ", ")
+}
+
+// Is indicates whether target is ErrCASConflict, and any of the failed
+// operations compares the ModifyIndex of an object (i.e. uses the cas,
+// check-index, or delete-cas verb), or failed because of a stale index.
+func (tes TxnErrors) Is(target error) bool {
+	if target != ErrCASConflict {
+		return false
+	}
+
+	for _, te := range tes {
+		switch te.verb {
+		case TxnCAS, TxnCheckIndex, TxnDeleteCAS:
+			return true
+		}
+		if strings.Contains(te.What, "index is stale") {
+			return true
+		}
+	}
+	return false
+}
+
+// TxnQuery is used to define values for each of the optional parameters
+// of the transaction endpoint.
+type TxnQuery struct {
+	// DC indicates the datacenter in which to apply the transaction.
+	//
+	// If blank, this will default to the datacenter that the queried agent is in.
+	DC string
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Txn -s _mock.go
+
+// A Txn can apply multiple operations to the KV store and the catalog in a
+// single atomic transaction. Either every operation is applied, or none are.
+//
+// https://www.consul.io/api/txn.html
+type Txn interface {
+
+	// Transaction applies ops as a single atomic transaction, in dc. If the
+	// transaction is rolled back, the returned error is of type TxnErrors.
+	//
+	// https://www.consul.io/api/txn.html#create-transaction
+	Transaction(Ctx, []TxnOp, TxnQuery) ([]TxnResult, error)
+}
+
+// An assertion that client satisfies Txn
+var _ Txn = (*client)(nil)
+
+type txnOpFormat struct {
+	KV      *kvTxnFormat      `json:"KV,omitempty"`
+	Node    *nodeTxnFormat    `json:"Node,omitempty"`
+	Service *serviceTxnFormat `json:"Service,omitempty"`
+	Check   *checkTxnFormat   `json:"Check,omitempty"`
+}
+
+type kvTxnFormat struct {
+	Verb    string    `json:"Verb"`
+	Key     string    `json:"Key"`
+	Value   string    `json:"Value,omitempty"`
+	Flags   uint64    `json:"Flags,omitempty"`
+	Index   uint64    `json:"Index,omitempty"`
+	Session SessionID `json:"Session,omitempty"`
+}
+
+type nodeTxnFormat struct {
+	Verb string `json:"Verb"`
+	Node struct {
+		Node
+		ModifyIndex uint64 `json:"ModifyIndex,omitempty"`
+	} `json:"Node"`
+}
+
+type serviceTxnFormat struct {
+	Verb    string       `json:"Verb"`
+	Node    string       `json:"Node"`
+	Service AgentService `json:"Service"`
+}
+
+type checkTxnFormat struct {
+	Verb  string      `json:"Verb"`
+	Check HealthCheck `json:"Check"`
+}
+
+type txnResponse struct {
+	Results []struct {
+		KV      *kvEntryFormat `json:"KV"`
+		Node    *Node          `json:"Node"`
+		Service *AgentService  `json:"Service"`
+		Check   *HealthCheck   `json:"Check"`
+	} `json:"Results"`
+	Errors TxnErrors `json:"Errors"`
+}
+
+func internalizeTxnOps(ops []TxnOp) ([]txnOpFormat, error) {
+	if len(ops) == 0 {
+		return nil, errors.New("transaction requires at least one operation")
+	}
+
+	formats := make([]txnOpFormat, 0, len(ops))
+
+	for i, op := range ops {
+		var format txnOpFormat
+		kinds := 0
+
+		if op.KV != nil {
+			kinds++
+			format.KV = &kvTxnFormat{
+				Verb:    string(op.KV.Verb),
+				Key:     op.KV.Key,
+				Value:   base64.StdEncoding.EncodeToString([]byte(op.KV.Value)),
+				Flags:   op.KV.Flags,
+				Index:   op.KV.Index,
+				Session: op.KV.Session,
+			}
+		}
+
+		if op.Node != nil {
+			kinds++
+			format.Node = &nodeTxnFormat{Verb: string(op.Node.Verb)}
+			format.Node.Node.Node = op.Node.Node
+			format.Node.Node.ModifyIndex = op.Node.Index
+		}
+
+		if op.Service != nil {
+			kinds++
+			format.Service = &serviceTxnFormat{
+				Verb:    string(op.Service.Verb),
+				Node:    op.Service.Node,
+				Service: op.Service.Service,
+			}
+			if op.Service.Index != 0 {
+				format.Service.Service.ModifyIndex = op.Service.Index
+			}
+		}
+
+		if op.Check != nil {
+			kinds++
+			format.Check = &checkTxnFormat{
+				Verb:  string(op.Check.Verb),
+				Check: op.Check.Check,
+			}
+			if op.Check.Index != 0 {
+				format.Check.Check.ModifyIndex = op.Check.Index
+			}
+		}
+
+		if kinds != 1 {
+			return nil, errors.Errorf("transaction op %d must be exactly one of KV, Node, Service, or Check", i)
+		}
+
+		formats = append(formats, format)
+	}
+
+	return formats, nil
+}
+
+func (c *client) Transaction(ctx Ctx, ops []TxnOp, query TxnQuery) ([]TxnResult, error) {
+	formats, err := internalizeTxnOps(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := json.Marshal(formats)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create transaction payload")
+	}
+
+	path := fixup("/v1", "/txn", param("dc", query.DC))
+
+	var response txnResponse
+	rolledBack, err := c.putConflict(ctx, path, string(bs), &response, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to apply transaction")
+	}
+
+	if rolledBack || len(response.Errors) > 0 {
+		for i, te := range response.Errors {
+			if te.OpIndex >= 0 && te.OpIndex < len(ops) {
+				response.Errors[i].verb = ops[te.OpIndex].verb()
+			}
+		}
+		return nil, response.Errors
+	}
+
+	results := make([]TxnResult, 0, len(response.Results))
+	for _, result := range response.Results {
+		var r TxnResult
+		if result.KV != nil {
+			entry, err := result.KV.entry()
+			if err != nil {
+				return nil, err
+			}
+			r.KV = &entry
+		}
+		r.Node = result.Node
+		r.Service = result.Service
+		r.Check = result.Check
+		results = append(results, r)
+	}
+
+	return results, nil
+}
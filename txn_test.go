@@ -0,0 +1,166 @@
+package consulapi
+
+import (
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Txn_Transaction(t *testing.T) {
+	expPayload := `[{"KV":{"Verb":"set","Key":"config/app/a","Value":"MQ=="}},` +
+		`{"KV":{"Verb":"cas","Key":"config/app/b","Value":"Mg==","Index":1990}},` +
+		`{"KV":{"Verb":"delete-tree","Key":"config/app/old/"}},` +
+		`{"Service":{"Verb":"get","Node":"dc1-node1","Service":{"Kind":"","ID":"myapp-1",` +
+		`"Service":"","Tags":null,"Meta":null,"Port":0,"Address":"","TaggedAddresses":null,` +
+		`"Weights":{"Passing":0,"Warning":0},"EnableTagOverride":false,"Proxy":{` +
+		`"DestinationServiceName":"","DestinationServiceID":"","LocalServiceAddress":"",` +
+		`"LocalServicePort":0,"Config":null,"Upstreams":null},"Connect":{"Native":false},` +
+		`"CreateIndex":0,"ModifyIndex":0}}}]`
+
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      load(t, "v1_txn.json"),
+		hasPath:   "/v1/txn",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   expPayload,
+	})
+	defer ts.Close()
+
+	results, err := client.Transaction(ctx, []TxnOp{
+		{KV: &KVTxnOp{Verb: TxnSet, Key: "config/app/a", Value: "1"}},
+		{KV: &KVTxnOp{Verb: TxnCAS, Key: "config/app/b", Value: "2", Index: 1990}},
+		{KV: &KVTxnOp{Verb: TxnDeleteTree, Key: "config/app/old/"}},
+		{Service: &ServiceTxnOp{Verb: TxnGet, Node: "dc1-node1", Service: AgentService{ID: "myapp-1"}}},
+	}, TxnQuery{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(results))
+	require.Equal(t, "config/app/a", results[0].KV.Key)
+	require.Equal(t, "2", results[1].KV.Value)
+	require.Equal(t, uint64(1995), results[1].KV.ModifyIndex)
+	require.Nil(t, results[1].Service)
+	require.Equal(t, "myapp", results[2].Service.Name)
+}
+
+func Test_internalizeTxnOps_cas(t *testing.T) {
+	formats, err := internalizeTxnOps([]TxnOp{
+		{Node: &NodeTxnOp{Verb: TxnCAS, Node: Node{Name: "dc1-node1"}, Index: 10}},
+		{Service: &ServiceTxnOp{Verb: TxnCAS, Node: "dc1-node1", Service: AgentService{ID: "myapp-1"}, Index: 11}},
+		{Service: &ServiceTxnOp{Verb: TxnDeleteCAS, Node: "dc1-node1", Service: AgentService{ID: "myapp-2", ModifyIndex: 12}}},
+		{Check: &CheckTxnOp{Verb: TxnCAS, Check: HealthCheck{CheckID: "myapp-1-http"}, Index: 13}},
+		{Check: &CheckTxnOp{Verb: TxnDeleteCAS, Check: HealthCheck{CheckID: "myapp-2-http", ModifyIndex: 14}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, formats, 5)
+	require.Equal(t, uint64(10), formats[0].Node.Node.ModifyIndex)
+	require.Equal(t, uint64(11), formats[1].Service.Service.ModifyIndex)
+	require.Equal(t, uint64(12), formats[2].Service.Service.ModifyIndex)
+	require.Equal(t, uint64(13), formats[3].Check.Check.ModifyIndex)
+	require.Equal(t, uint64(14), formats[4].Check.Check.ModifyIndex)
+}
+
+func Test_Txn_Transaction_dc(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `{"Results":[],"Errors":null}`,
+		hasPath:   "/v1/txn",
+		hasMethod: http.MethodPut,
+		hasQuery: map[string][]string{
+			"dc": {"dc2"},
+		},
+		hasBody: `[{"KV":{"Verb":"check-session","Key":"lock","Session":"abc123"}}]`,
+	})
+	defer ts.Close()
+
+	results, err := client.Transaction(ctx, []TxnOp{
+		{KV: &KVTxnOp{Verb: TxnCheckSession, Key: "lock", Session: "abc123"}},
+	}, TxnQuery{DC: "dc2"})
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func Test_Txn_Transaction_rollback(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusConflict,
+		body:      load(t, "v1_txn-rollback.json"),
+		hasPath:   "/v1/txn",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `[{"KV":{"Verb":"set","Key":"config/app/a","Value":"MQ=="}},` +
+			`{"KV":{"Verb":"cas","Key":"config/app/b","Value":"Mg==","Index":1990}}]`,
+	})
+	defer ts.Close()
+
+	_, err := client.Transaction(ctx, []TxnOp{
+		{KV: &KVTxnOp{Verb: TxnSet, Key: "config/app/a", Value: "1"}},
+		{KV: &KVTxnOp{Verb: TxnCAS, Key: "config/app/b", Value: "2", Index: 1990}},
+	}, TxnQuery{})
+	require.EqualError(t, err, `transaction rolled back: op 1: failed to set key "config/app/b", index is stale`)
+	require.True(t, IsCASConflict(err))
+
+	var txnErrors TxnErrors
+	require.True(t, stderrors.As(err, &txnErrors))
+	require.Equal(t, TxnErrors{{
+		OpIndex: 1,
+		What:    `failed to set key "config/app/b", index is stale`,
+		verb:    TxnCAS,
+	}}, txnErrors)
+}
+
+func Test_Txn_Transaction_rollback_check_index(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusConflict,
+		body:      `{"Results":null,"Errors":[{"OpIndex":0,"What":"current modify index 2001 for key \"config/app/a\" doesn't match 1990"}]}`,
+		hasPath:   "/v1/txn",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody: `[{"KV":{"Verb":"check-index","Key":"config/app/a","Index":1990}},` +
+			`{"KV":{"Verb":"set","Key":"config/app/b","Value":"Mg=="}}]`,
+	})
+	defer ts.Close()
+
+	_, err := client.Transaction(ctx, []TxnOp{
+		{KV: &KVTxnOp{Verb: TxnCheckIndex, Key: "config/app/a", Index: 1990}},
+		{KV: &KVTxnOp{Verb: TxnSet, Key: "config/app/b", Value: "2"}},
+	}, TxnQuery{})
+	require.EqualError(t, err, `transaction rolled back: op 0: current modify index 2001 for key "config/app/a" doesn't match 1990`)
+	require.True(t, IsCASConflict(err))
+}
+
+func Test_Txn_Transaction_err(t *testing.T) {
+	ctx, ts, client := testClient(&responder{
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/txn",
+		hasMethod: http.MethodPut,
+		hasQuery:  map[string][]string{},
+		hasBody:   `[{"KV":{"Verb":"get","Key":"config/app/a"}}]`,
+	})
+	defer ts.Close()
+
+	_, err := client.Transaction(ctx, []TxnOp{
+		{KV: &KVTxnOp{Verb: TxnGet, Key: "config/app/a"}},
+	}, TxnQuery{})
+	require.EqualError(t, err, "failed to apply transaction: PUT /v1/txn: status code (500): malfunction")
+}
+
+func Test_Txn_Transaction_invalid(t *testing.T) {
+	ctx, ts, client := testClient(&responder{t: t})
+	defer ts.Close()
+
+	_, err := client.Transaction(ctx, nil, TxnQuery{})
+	require.EqualError(t, err, "transaction requires at least one operation")
+
+	_, err = client.Transaction(ctx, []TxnOp{{
+		KV:   &KVTxnOp{Verb: TxnGet, Key: "a"},
+		Node: &NodeTxnOp{Verb: TxnGet, Node: Node{Name: "dc1-node1"}},
+	}}, TxnQuery{})
+	require.EqualError(t, err, "transaction op 0 must be exactly one of KV, Node, Service, or Check")
+}
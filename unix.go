@@ -0,0 +1,74 @@
+package consulapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+// unixSockets maps the placeholder hosts of the request urls of unix socket
+// addresses to the paths of the sockets. Requests are made to the placeholder
+// hosts as usual, and the transport of the client connects to the socket
+// instead.
+type unixSockets map[string]string
+
+// add returns the base url of requests to address, which is a placeholder for
+// a unix:// address, or address itself otherwise.
+func (u unixSockets) add(address string) string {
+	socket := strings.TrimPrefix(address, schemeUnix)
+	if socket == address {
+		return address
+	}
+
+	host := fmt.Sprintf("unix-socket-%d", len(u))
+	u[host] = socket
+	return schemeHTTP + host
+}
+
+// client returns a copy of c which connects to the unix sockets of u, if there
+// are any. Only a client using a http.Transport can be made to connect to
+// unix sockets.
+func (u unixSockets) client(c *http.Client, log loggy.Logger) *http.Client {
+	if len(u) == 0 {
+		return c
+	}
+
+	transport := http.DefaultTransport
+	if c.Transport != nil {
+		transport = c.Transport
+	}
+
+	t, ok := transport.(*http.Transport)
+	if !ok {
+		log.Warnf("cannot connect to unix sockets with transport of type %T", transport)
+		return c
+	}
+
+	copied := *c
+	copied.Transport = u.transport(t)
+	return &copied
+}
+
+// transport returns a copy of t which connects to the unix sockets of u in
+// place of their placeholder hosts.
+func (u unixSockets) transport(t *http.Transport) *http.Transport {
+	t = t.Clone()
+
+	dial := t.DialContext
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+
+	t.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(address)
+		if socket, exists := u[host]; err == nil && exists {
+			return dial(ctx, "unix", socket)
+		}
+		return dial(ctx, network, address)
+	}
+	return t
+}
@@ -0,0 +1,108 @@
+package consulapi
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gophers.dev/pkgs/loggy"
+)
+
+// unixServer starts a server listening on a unix socket in dir.
+func unixServer(t *testing.T, dir, name string, h http.Handler) (*httptest.Server, string) {
+	socket := filepath.Join(dir, name)
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	ts := httptest.NewUnstartedServer(h)
+	ts.Listener = listener
+	ts.Start()
+	return ts, "unix://" + socket
+}
+
+func Test_unixSockets_add(t *testing.T) {
+	sockets := make(unixSockets)
+	require.Equal(t, "http://consul:8500", sockets.add("http://consul:8500"))
+	require.Equal(t, "http://unix-socket-0", sockets.add("unix:///var/run/consul.sock"))
+	require.Equal(t, "http://unix-socket-1", sockets.add("unix:///tmp/consul.sock"))
+	require.Equal(t, unixSockets{
+		"unix-socket-0": "/var/run/consul.sock",
+		"unix-socket-1": "/tmp/consul.sock",
+	}, sockets)
+}
+
+func Test_Client_unix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	get := &responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}
+
+	ts1, address1 := unixServer(t, dir, "consul1.sock", get)
+	ts2, address2 := unixServer(t, dir, "consul2.sock", get)
+	defer ts2.Close()
+
+	c := New(ClientOptions{
+		Address:   address1,
+		Addresses: []string{address2},
+		Logger:    loggy.New("test-client"),
+	})
+
+	value, _, err := c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+	require.Equal(t, address1, c.ActiveAddress())
+
+	// fail over to the other socket
+	ts1.Close()
+
+	value, _, err = c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+	require.Equal(t, address2, c.ActiveAddress())
+}
+
+func Test_Client_unix_HTTPClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consulapi")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ts, address := unixServer(t, dir, "consul.sock", &responder{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ=="}]`,
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Timeout: 1 * time.Second}
+
+	// the provided client is not modified, and a trailing slash is ignored
+	c := New(ClientOptions{
+		Address:    address + "/",
+		HTTPClient: httpClient,
+	})
+	require.Nil(t, httpClient.Transport)
+
+	value, _, err := c.Get(context.Background(), "config/a", Query{})
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+	require.Equal(t, address, c.ActiveAddress())
+}
@@ -0,0 +1,268 @@
+package consulapi
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	defaultWatchMinBackoff = 1 * time.Second
+	defaultWatchMaxBackoff = 1 * time.Minute
+)
+
+// A WatchEvent describes the state of the watched data, each time the
+// underlying blocking query indicates the data may have changed. Exactly one
+// of KV, Entries, Services, Instances, or Nodes is relevant, depending on
+// which kind of watch produced the event.
+type WatchEvent struct {
+	// Meta is the metadata of the query which produced the event.
+	Meta QueryMeta
+
+	// KV is the entry of a WatchKey, or nil if the key does not exist.
+	KV *KVEntry
+
+	// Entries are the entries of a WatchPrefix, sorted by key.
+	Entries []KVEntry
+
+	// Services are the services and their tags of a WatchServices.
+	Services map[string][]string
+
+	// Instances are the instances of a WatchService.
+	Instances []Instance
+
+	// Nodes are the nodes of a WatchNodes.
+	Nodes []Node
+}
+
+// A WatchHandler is called with each event of a watch.
+type WatchHandler func(WatchEvent)
+
+// WatchOptions are used to configure how a watch delivers events and
+// recovers from errors.
+type WatchOptions struct {
+	// Handler (optional) is called with each event of the watch, in the
+	// goroutine of the watch. When set, events are not sent on the channel
+	// returned by the watch, which is only closed when the watch stops.
+	Handler WatchHandler
+
+	// MinBackoff (optional) is how long to wait before retrying a query
+	// after the first consecutive error. If not set, 1 second is used.
+	MinBackoff time.Duration
+
+	// MaxBackoff (optional) is the most time to wait before retrying a query,
+	// as the backoff is doubled after each consecutive error. If not set,
+	// 1 minute is used.
+	MaxBackoff time.Duration
+}
+
+func (wo WatchOptions) backoff(failures int) time.Duration {
+	min := wo.MinBackoff
+	if min <= 0 {
+		min = defaultWatchMinBackoff
+	}
+
+	max := wo.MaxBackoff
+	if max <= 0 {
+		max = defaultWatchMaxBackoff
+	}
+
+	return backoff(min, max, failures)
+}
+
+// backoff returns min doubled for each consecutive failure after the first,
+// limited to max.
+func backoff(min, max time.Duration, failures int) time.Duration {
+	wait := min
+	for i := 1; i < failures && wait < max; i++ {
+		wait *= 2
+	}
+
+	if wait > max {
+		wait = max
+	}
+	return wait
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Watcher -s _mock.go
+
+// A Watcher is able to watch data in consul for changes, similar to what
+// the consul watch command does. Each watch repeatedly issues a blocking
+// query in a goroutine, until the context is cancelled.
+//
+// The first event of a watch contains the current state of the data, unless
+// the WaitIndex of the query is set, in which case the first event is only
+// delivered once the data changes after that index. Subsequent events are
+// delivered whenever the index of the data changes, which consul does not
+// guarantee to mean the data itself has changed.
+//
+// Errors are logged and the query is retried with an exponential backoff.
+// If the index of the data ever moves backwards (e.g. because the cluster
+// state was restored from a snapshot), the watch is reset and delivers the
+// current state of the data.
+//
+// Each method returns a channel on which events are sent. The channel is
+// closed when the watch stops.
+//
+// https://www.consul.io/docs/dynamic-app-config/watches
+type Watcher interface {
+
+	// WatchKey watches the key at path in the KV store.
+	WatchKey(Ctx, string, Query, WatchOptions) <-chan WatchEvent
+
+	// WatchPrefix watches every key under path in the KV store.
+	WatchPrefix(Ctx, string, Query, WatchOptions) <-chan WatchEvent
+
+	// WatchServices watches the list of services in the catalog.
+	WatchServices(Ctx, ServicesQuery, WatchOptions) <-chan WatchEvent
+
+	// WatchService watches the instances of the named service in the catalog.
+	WatchService(Ctx, string, ServiceQuery, WatchOptions) <-chan WatchEvent
+
+	// WatchNodes watches the list of nodes in the catalog.
+	WatchNodes(Ctx, NodesQuery, WatchOptions) <-chan WatchEvent
+}
+
+// An assertion that client satisfies Watcher
+var _ Watcher = (*client)(nil)
+
+// A watchFunc issues one query of a watch, blocking on the given index.
+type watchFunc func(ctx Ctx, index uint64) (WatchEvent, error)
+
+func (c *client) WatchKey(ctx Ctx, path string, query Query, opts WatchOptions) <-chan WatchEvent {
+	return c.watch(ctx, "key "+path, query.WaitIndex, opts, func(ctx Ctx, index uint64) (WatchEvent, error) {
+		query.WaitIndex = index
+		entries, meta, err := c.entries(ctx, path, query, false)
+		if err != nil {
+			return WatchEvent{}, err
+		}
+
+		event := WatchEvent{Meta: meta}
+		if len(entries) > 0 {
+			event.KV = &entries[0]
+		}
+		return event, nil
+	})
+}
+
+func (c *client) WatchPrefix(ctx Ctx, path string, query Query, opts WatchOptions) <-chan WatchEvent {
+	return c.watch(ctx, "prefix "+path, query.WaitIndex, opts, func(ctx Ctx, index uint64) (WatchEvent, error) {
+		query.WaitIndex = index
+		entries, meta, err := c.entries(ctx, path, query, true)
+		if err != nil {
+			return WatchEvent{}, err
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Key < entries[j].Key
+		})
+
+		return WatchEvent{Meta: meta, Entries: entries}, nil
+	})
+}
+
+func (c *client) WatchServices(ctx Ctx, query ServicesQuery, opts WatchOptions) <-chan WatchEvent {
+	return c.watch(ctx, "services", query.WaitIndex, opts, func(ctx Ctx, index uint64) (WatchEvent, error) {
+		query.WaitIndex = index
+		services, meta, err := c.Services(ctx, query)
+		if err != nil {
+			return WatchEvent{}, err
+		}
+		return WatchEvent{Meta: meta, Services: services}, nil
+	})
+}
+
+func (c *client) WatchService(ctx Ctx, service string, query ServiceQuery, opts WatchOptions) <-chan WatchEvent {
+	return c.watch(ctx, "service "+service, query.WaitIndex, opts, func(ctx Ctx, index uint64) (WatchEvent, error) {
+		query.WaitIndex = index
+		instances, meta, err := c.Service(ctx, service, query)
+		if err != nil {
+			return WatchEvent{}, err
+		}
+		return WatchEvent{Meta: meta, Instances: instances}, nil
+	})
+}
+
+func (c *client) WatchNodes(ctx Ctx, query NodesQuery, opts WatchOptions) <-chan WatchEvent {
+	return c.watch(ctx, "nodes", query.WaitIndex, opts, func(ctx Ctx, index uint64) (WatchEvent, error) {
+		query.WaitIndex = index
+		nodes, meta, err := c.Nodes(ctx, query)
+		if err != nil {
+			return WatchEvent{}, err
+		}
+		return WatchEvent{Meta: meta, Nodes: nodes}, nil
+	})
+}
+
+func (c *client) watch(ctx Ctx, name string, index uint64, opts WatchOptions, f watchFunc) <-chan WatchEvent {
+	events := make(chan WatchEvent)
+
+	go func() {
+		defer close(events)
+
+		failures := 0
+
+		for {
+			event, err := f(ctx, index)
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				failures++
+				backoff := opts.backoff(failures)
+				c.log.Warnf("watch of %s failed, try again in %v: %v", name, backoff, err)
+				if !sleep(ctx, backoff) {
+					return
+				}
+				continue
+			}
+			failures = 0
+
+			lastIndex := event.Meta.LastIndex
+
+			// the query timed out without the data changing
+			if index > 0 && lastIndex == index {
+				continue
+			}
+
+			// the index moved backwards, reset the watch and deliver whatever
+			// the current state of the data is
+			if lastIndex < index {
+				c.log.Tracef("index of watch of %s moved backwards from %d to %d", name, index, lastIndex)
+			}
+
+			// an index of 0 would turn the next query into a non-blocking one
+			index = lastIndex
+			if index < 1 {
+				index = 1
+			}
+
+			if opts.Handler != nil {
+				opts.Handler(event)
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// sleep waits for d to elapse, returning false if ctx is cancelled first.
+func sleep(ctx Ctx, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,173 @@
+package consulapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Watch_backoff(t *testing.T) {
+	opts := WatchOptions{}
+	require.Equal(t, 1*time.Second, opts.backoff(1))
+	require.Equal(t, 2*time.Second, opts.backoff(2))
+	require.Equal(t, 32*time.Second, opts.backoff(6))
+	require.Equal(t, 1*time.Minute, opts.backoff(7))
+	require.Equal(t, 1*time.Minute, opts.backoff(100))
+
+	opts = WatchOptions{MinBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	require.Equal(t, 10*time.Millisecond, opts.backoff(1))
+	require.Equal(t, 40*time.Millisecond, opts.backoff(3))
+	require.Equal(t, 50*time.Millisecond, opts.backoff(4))
+}
+
+func Test_Watch_WatchKey(t *testing.T) {
+	bq := func(index string) map[string][]string {
+		return map[string][]string{
+			"index": {index},
+			"wait":  {"500ms"},
+		}
+	}
+
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		// initial state of the key
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ==","CreateIndex":8,"ModifyIndex":10}]`,
+		headers:   indexed("10"),
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  map[string][]string{},
+	}, {
+		// wait time elapsed without a change
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"MQ==","CreateIndex":8,"ModifyIndex":10}]`,
+		headers:   indexed("10"),
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  bq("10"),
+	}, {
+		// the key was deleted
+		t:         t,
+		code:      http.StatusNotFound,
+		headers:   indexed("12"),
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  bq("10"),
+	}, {
+		// an error, which is retried
+		t:         t,
+		code:      http.StatusInternalServerError,
+		body:      "malfunction",
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  bq("12"),
+	}, {
+		// the index moved backwards
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"Mg==","CreateIndex":4,"ModifyIndex":5}]`,
+		headers:   indexed("5"),
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  bq("12"),
+	}, {
+		// the watch continues from the reset index
+		t:         t,
+		code:      http.StatusOK,
+		body:      `[{"Key":"config/a","Value":"Mw==","CreateIndex":4,"ModifyIndex":6}]`,
+		headers:   indexed("6"),
+		hasPath:   "/v1/kv/config/a",
+		hasMethod: http.MethodGet,
+		hasQuery:  bq("5"),
+	}, nil}})
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := client.WatchKey(ctx, "config/a", Query{
+		WaitTime: 500 * time.Millisecond,
+	}, WatchOptions{
+		MinBackoff: 1 * time.Millisecond,
+	})
+
+	event := <-events
+	require.Equal(t, uint64(10), event.Meta.LastIndex)
+	require.Equal(t, "1", event.KV.Value)
+
+	event = <-events
+	require.Equal(t, uint64(12), event.Meta.LastIndex)
+	require.Nil(t, event.KV)
+
+	event = <-events
+	require.Equal(t, uint64(5), event.Meta.LastIndex)
+	require.Equal(t, "2", event.KV.Value)
+
+	event = <-events
+	require.Equal(t, uint64(6), event.Meta.LastIndex)
+	require.Equal(t, "3", event.KV.Value)
+
+	cancel()
+
+	_, open := <-events
+	require.False(t, open)
+}
+
+func Test_Watch_WatchServices_handler(t *testing.T) {
+	_, ts, client := testClient(&sequence{t: t, responders: []*responder{{
+		t:         t,
+		code:      http.StatusOK,
+		body:      `{"consul":[],"nomad":["http","rpc"]}`,
+		headers:   indexed("100"),
+		hasPath:   "/v1/catalog/services",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"90"},
+		},
+	}, {
+		t:         t,
+		code:      http.StatusOK,
+		body:      `{"consul":[]}`,
+		headers:   indexed("101"),
+		hasPath:   "/v1/catalog/services",
+		hasMethod: http.MethodGet,
+		hasQuery: map[string][]string{
+			"index": {"100"},
+		},
+	}, nil}})
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var handled []WatchEvent
+
+	done := client.WatchServices(ctx, ServicesQuery{
+		WaitIndex: 90,
+	}, WatchOptions{
+		Handler: func(event WatchEvent) {
+			handled = append(handled, event)
+			if len(handled) == 2 {
+				cancel()
+			}
+		},
+	})
+
+	// no events are sent on the channel, which closes once the watch stops
+	_, open := <-done
+	require.False(t, open)
+
+	require.Equal(t, 2, len(handled))
+	require.Equal(t, map[string][]string{
+		"consul": {},
+		"nomad":  {"http", "rpc"},
+	}, handled[0].Services)
+	require.Equal(t, uint64(101), handled[1].Meta.LastIndex)
+	require.Equal(t, map[string][]string{
+		"consul": {},
+	}, handled[1].Services)
+}